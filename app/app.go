@@ -1,6 +1,7 @@
 package app
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -132,6 +133,7 @@ var (
 		tokenfactorytypes.EnableForceTransfer,
 		tokenfactorytypes.EnableSetMetadata,
 		tokenfactorytypes.EnableCommunityPoolFeeFunding,
+		tokenfactorytypes.EnableBeforeSendHook,
 	}
 )
 
@@ -192,6 +194,7 @@ type TokenFactoryApp struct {
 
 	// keys to access the substores
 	keys    map[string]*storetypes.KVStoreKey
+	tkeys   map[string]*storetypes.TransientStoreKey
 	memKeys map[string]*storetypes.MemoryStoreKey
 
 	// keepers
@@ -275,6 +278,7 @@ func NewApp(
 		wasmtypes.StoreKey, icahosttypes.StoreKey,
 		icacontrollertypes.StoreKey, tokenfactorytypes.StoreKey,
 	)
+	tkeys := storetypes.NewTransientStoreKeys(tokenfactorytypes.TStoreKey)
 
 	// register streaming services
 	if err := bApp.RegisterStreamingServices(appOpts, keys); err != nil {
@@ -288,6 +292,7 @@ func NewApp(
 		txConfig:          txConfig,
 		interfaceRegistry: interfaceRegistry,
 		keys:              keys,
+		tkeys:             tkeys,
 	}
 
 	govModAddress := authtypes.NewModuleAddress(govtypes.ModuleName).String()
@@ -446,6 +451,7 @@ func NewApp(
 	app.TokenFactoryKeeper = tokenfactorykeeper.NewKeeper(
 		appCodec,
 		app.keys[tokenfactorytypes.StoreKey],
+		app.tkeys[tokenfactorytypes.TStoreKey],
 		maccPerms,
 		app.AccountKeeper,
 		app.BankKeeper,
@@ -454,6 +460,7 @@ func NewApp(
 		govModAddress,
 	)
 	wasmOpts = append(wasmOpts, bindings.RegisterCustomPlugins(app.BankKeeper, &app.TokenFactoryKeeper)...)
+	wasmOpts = append(wasmOpts, bindings.RegisterGrpcQueries(app.GRPCQueryRouter(), appCodec)...)
 
 	// // IBC Fee Module keeper
 	// app.IBCFeeKeeper = ibcfeekeeper.NewKeeper(
@@ -530,6 +537,16 @@ func NewApp(
 		wasmOpts...,
 	)
 
+	// The tokenfactory keeper calls the denom hook contracts through the wasm keeper
+	app.TokenFactoryKeeper.SetContractKeeper(tokenFactoryContractKeeper{
+		PermissionedKeeper: wasmkeeper.NewDefaultPermissionKeeper(app.WasmKeeper),
+		wasmKeeper:         app.WasmKeeper,
+	})
+	// The restriction is a method value, so it must be registered after the contract keeper is set
+	app.BankKeeper.AppendSendRestriction(app.TokenFactoryKeeper.BeforeSendRestriction)
+	// The vesting restriction keeps the tokens locked by the tokenfactory vesting schedules
+	app.BankKeeper.AppendSendRestriction(app.TokenFactoryKeeper.VestingSendRestriction)
+
 	// Create fee enabled wasm ibc Stack
 	wasmStackIBCHandler := wasm.NewIBCHandler(app.WasmKeeper, app.IBCKeeper.ChannelKeeper, app.TransferKeeper, app.IBCKeeper.ChannelKeeper)
 
@@ -728,6 +745,7 @@ func NewApp(
 
 	// initialize stores
 	app.MountKVStores(keys)
+	app.MountTransientStores(tkeys)
 
 	// initialize BaseApp
 	app.SetInitChainer(app.InitChainer)
@@ -797,6 +815,18 @@ func NewApp(
 	return app
 }
 
+// tokenFactoryContractKeeper is the contract keeper of the tokenfactory module. It calls the
+// contracts through the permissioned wasm keeper, and looks up whether they exist in the wasm
+// keeper, which the permissioned keeper does not expose.
+type tokenFactoryContractKeeper struct {
+	*wasmkeeper.PermissionedKeeper
+	wasmKeeper wasmkeeper.Keeper
+}
+
+func (k tokenFactoryContractKeeper) HasContractInfo(ctx context.Context, contractAddress sdk.AccAddress) bool {
+	return k.wasmKeeper.HasContractInfo(ctx, contractAddress)
+}
+
 func (app *TokenFactoryApp) setAnteHandler(txConfig client.TxConfig, nodeConfig wasmtypes.NodeConfig, txCounterStoreKey *storetypes.KVStoreKey) {
 	anteHandler, err := NewAnteHandler(
 		HandlerOptions{
@@ -947,6 +977,13 @@ func (app *TokenFactoryApp) GetStoreKeys() []storetypes.StoreKey {
 	return keys
 }
 
+// GetTKey returns the TransientStoreKey for the provided store key.
+//
+// NOTE: This is solely to be used for testing purposes.
+func (app *TokenFactoryApp) GetTKey(storeKey string) *storetypes.TransientStoreKey {
+	return app.tkeys[storeKey]
+}
+
 // GetMemKey returns the MemStoreKey for the provided mem key.
 //
 // NOTE: This is solely used for testing purposes.
@@ -4,8 +4,9 @@ use std::fs::create_dir_all;
 use cosmwasm_schema::{export_schema, remove_schemas, schema_for};
 
 use token_bindings::{
-    AdminResponse, DenomsByCreatorResponse, FullDenomResponse, MetadataResponse, ParamsResponse,
-    TokenFactoryMsg, TokenFactoryQuery,
+    AdminResponse, CapabilitiesResponse, DenomSupplyResponse, DenomsByCreatorResponse,
+    DenomsFromAdminResponse, FullDenomResponse, MetadataResponse, ParamsResponse, TokenFactoryMsg,
+    TokenFactoryQuery,
 };
 
 fn main() {
@@ -18,6 +19,9 @@ fn main() {
     export_schema(&schema_for!(TokenFactoryQuery), &out_dir);
     export_schema(&schema_for!(AdminResponse), &out_dir);
     export_schema(&schema_for!(DenomsByCreatorResponse), &out_dir);
+    export_schema(&schema_for!(DenomsFromAdminResponse), &out_dir);
+    export_schema(&schema_for!(DenomSupplyResponse), &out_dir);
+    export_schema(&schema_for!(CapabilitiesResponse), &out_dir);
     export_schema(&schema_for!(FullDenomResponse), &out_dir);
     export_schema(&schema_for!(MetadataResponse), &out_dir);
     export_schema(&schema_for!(ParamsResponse), &out_dir);
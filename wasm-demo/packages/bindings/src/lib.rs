@@ -6,7 +6,8 @@ mod types;
 pub use msg::{CreateDenomResponse, TokenFactoryMsg};
 pub use querier::TokenQuerier;
 pub use query::{
-    AdminResponse, DenomsByCreatorResponse, FullDenomResponse, MetadataResponse, ParamsResponse,
+    AdminResponse, CapabilitiesResponse, DenomSupplyResponse, DenomsByCreatorResponse,
+    DenomsFromAdminResponse, FullDenomResponse, MetadataResponse, ParamsResponse,
     TokenFactoryQuery,
 };
-pub use types::{DenomUnit, Metadata, Params};
+pub use types::{DenomUnit, Metadata, MultiBurnInput, MultiMintOutput, Params};
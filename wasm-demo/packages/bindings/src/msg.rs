@@ -1,4 +1,4 @@
-use crate::types::Metadata;
+use crate::types::{Metadata, MultiBurnInput, MultiMintOutput};
 use cosmwasm_schema::cw_serde;
 use cosmwasm_std::{Binary, CosmosMsg, CustomMsg, StdResult, Uint128};
 
@@ -56,6 +56,20 @@ pub enum TokenFactoryMsg {
         denom: String,
         metadata: Metadata,
     },
+    /// Contracts can mint native tokens for an existing factory denom
+    /// that they are the admin of to several addresses at once.
+    /// An address can only be listed once.
+    MultiMint {
+        denom: String,
+        outputs: Vec<MultiMintOutput>,
+    },
+    /// Contracts can burn native tokens for an existing factory denom
+    /// that they are the admin of from several addresses at once.
+    /// Requires the EnableBurnFrom capability.
+    MultiBurn {
+        denom: String,
+        inputs: Vec<MultiBurnInput>,
+    },
 }
 
 impl TokenFactoryMsg {
@@ -98,6 +112,16 @@ impl TokenFactoryMsg {
             to_address,
         }
     }
+
+    /// Mints tokens to several addresses in a single message
+    pub fn multi_mint_tokens(denom: String, outputs: Vec<MultiMintOutput>) -> Self {
+        TokenFactoryMsg::MultiMint { denom, outputs }
+    }
+
+    /// Burns tokens from several addresses (requires EnableBurnFrom capability)
+    pub fn multi_burn_tokens(denom: String, inputs: Vec<MultiBurnInput>) -> Self {
+        TokenFactoryMsg::MultiBurn { denom, inputs }
+    }
 }
 
 impl From<TokenFactoryMsg> for CosmosMsg<TokenFactoryMsg> {
@@ -1,6 +1,6 @@
 use crate::types::{Metadata, Params};
 use cosmwasm_schema::{cw_serde, QueryResponses};
-use cosmwasm_std::CustomQuery;
+use cosmwasm_std::{Coin, CustomQuery};
 
 #[cw_serde]
 #[derive(QueryResponses)]
@@ -26,8 +26,28 @@ pub enum TokenFactoryQuery {
     /// List all denoms that were created by the given creator.
     /// This does not imply all tokens currently managed by the creator.
     /// (Admin may have changed)
+    /// Every visited denom is charged with the `wasm_query_gas_per_item` param.
     #[returns(DenomsByCreatorResponse)]
-    DenomsByCreator { creator: String },
+    DenomsByCreator {
+        creator: String,
+        /// Maximum number of denoms to return, all of them if unset.
+        limit: Option<u32>,
+    },
+    /// List all denoms for which the given address is currently the admin.
+    /// Every visited denom is charged with the `wasm_query_gas_per_item` param.
+    #[returns(DenomsFromAdminResponse)]
+    DenomsFromAdmin {
+        admin: String,
+        /// Maximum number of denoms to return, all of them if unset.
+        limit: Option<u32>,
+    },
+    /// Returns the total supply of the given denom.
+    #[returns(DenomSupplyResponse)]
+    DenomSupply { denom: String },
+    /// Returns the token factory capabilities enabled on the chain
+    /// (eg. "force_transfer", "burn_from", "set_metadata").
+    #[returns(CapabilitiesResponse)]
+    Capabilities {},
     /// Returns configuration params for TokenFactory modules
     #[returns(ParamsResponse)]
     Params {},
@@ -56,6 +76,21 @@ pub struct DenomsByCreatorResponse {
     pub denoms: Vec<String>,
 }
 
+#[cw_serde]
+pub struct DenomsFromAdminResponse {
+    pub denoms: Vec<String>,
+}
+
+#[cw_serde]
+pub struct DenomSupplyResponse {
+    pub amount: Coin,
+}
+
+#[cw_serde]
+pub struct CapabilitiesResponse {
+    pub capabilities: Vec<String>,
+}
+
 #[cw_serde]
 pub struct ParamsResponse {
     pub params: Params,
@@ -1,5 +1,5 @@
 use cosmwasm_schema::cw_serde;
-use cosmwasm_std::Coin;
+use cosmwasm_std::{Coin, Uint128};
 
 /// This maps to cosmos.bank.v1beta1.Metadata protobuf struct
 #[cw_serde]
@@ -38,4 +38,26 @@ pub struct DenomUnit {
 pub struct Params {
     /// TODO: verify semantics - does it charge all of these or one of these?
     pub denom_creation_fee: Vec<Coin>,
+    /// Gas consumed when creating a denom, charged in addition to the fee.
+    pub denom_creation_gas_consume: u64,
+    /// Gas consumed for every item visited by the list-returning custom queries.
+    pub wasm_query_gas_per_item: u64,
+    /// Maximum gas a denom hook contract may consume per sudo call.
+    pub denom_hook_gas_limit: u64,
+    /// Maximum gas a before send hook contract may consume per transfer.
+    pub before_send_hook_gas_limit: u64,
+}
+
+/// This maps to osmosis.tokenfactory.v1beta1.MultiMintOutput protobuf struct
+#[cw_serde]
+pub struct MultiMintOutput {
+    pub address: String,
+    pub amount: Uint128,
+}
+
+/// This maps to osmosis.tokenfactory.v1beta1.MultiBurnInput protobuf struct
+#[cw_serde]
+pub struct MultiBurnInput {
+    pub address: String,
+    pub amount: Uint128,
 }
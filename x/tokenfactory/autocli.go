@@ -0,0 +1,375 @@
+package tokenfactory
+
+import (
+	"fmt"
+
+	"github.com/cosmos/tokenfactory/x/tokenfactory/types"
+
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+// AutoCLIOptions implements the autocli.HasAutoCLIConfig interface.
+func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
+	return &autocliv1.ModuleOptions{
+		Query: &autocliv1.ServiceCommandDescriptor{
+			Service: types.Query_serviceDesc.ServiceName,
+			// get-metadata, which prints the bank metadata of a denom, is a custom command in client/cli.
+			EnhanceCustomCommand: true,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "Params",
+					Use:       "params",
+					Short:     "Get the params for the x/tokenfactory module",
+				},
+				{
+					RpcMethod:      "DenomAuthorityMetadata",
+					Use:            "denom-authority-metadata [denom]",
+					Short:          "Get the authority metadata for a specific denom",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}},
+				},
+				{
+					RpcMethod:      "DenomsFromCreator",
+					Use:            "denoms-from-creator [creator-address]",
+					Short:          "Returns a list of all tokens created by a specific creator address",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "creator"}},
+				},
+				{
+					RpcMethod:      "DenomsFromAdmin",
+					Use:            "denoms-from-admin [admin-address]",
+					Short:          "Returns a list of all tokens owned by a specific admin address",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "admin"}},
+				},
+				{
+					RpcMethod:      "DenomHook",
+					Use:            "denom-hook [denom]",
+					Short:          "Get the hook contract for a specific denom",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}},
+				},
+				{
+					RpcMethod:      "BeforeSendHookAddress",
+					Use:            "before-send-hook [denom]",
+					Short:          "Get the before send hook contract for a specific denom",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}},
+				},
+				{
+					RpcMethod:      "ClaimCampaign",
+					Use:            "claim-campaign [campaign-id]",
+					Short:          "Get a claim campaign and its status",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "campaign_id"}},
+				},
+				{
+					RpcMethod: "ClaimStatus",
+					Use:       "claim-status [campaign-id] [address]",
+					Short:     "Get whether an address has claimed in a claim campaign, and the claimed amount",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "campaign_id"},
+						{ProtoField: "address"},
+					},
+				},
+				{
+					RpcMethod:      "VoucherSigner",
+					Use:            "voucher-signer [denom]",
+					Short:          "Get the public key signing the mint vouchers of a denom",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}},
+				},
+				{
+					RpcMethod: "VoucherNonce",
+					Use:       "voucher-nonce [denom] [nonce]",
+					Short:     "Get whether a mint voucher nonce of a denom has been redeemed",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "denom"},
+						{ProtoField: "nonce"},
+					},
+				},
+				{
+					RpcMethod: "ReferenceID",
+					Use:       "reference-id [denom] [reference-id]",
+					Short:     "Get the height of the block where a reference id of a denom was used by a mint or a burn",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "denom"},
+						{ProtoField: "reference_id"},
+					},
+				},
+				{
+					RpcMethod:      "ReserveAttestor",
+					Use:            "reserve-attestor [denom]",
+					Short:          "Get the reserve attestor of a denom and its latest reserve attestation",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}},
+				},
+				{
+					RpcMethod:      "ReserveAttestations",
+					Use:            "reserve-attestations [denom]",
+					Short:          "Get the history of the reserve attestations of a denom",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}},
+				},
+				{
+					RpcMethod: "Redemption",
+					Use:       "redemption [denom] [id]",
+					Short:     "Get a redemption request of a denom, whatever its status",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "denom"},
+						{ProtoField: "id"},
+					},
+				},
+				{
+					RpcMethod:      "OpenRedemptions",
+					Use:            "open-redemptions [denom]",
+					Short:          "Get the open redemption requests of a denom",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}},
+				},
+				{
+					RpcMethod:      "Vault",
+					Use:            "vault [denom]",
+					Short:          "Get the vault of a denom and the address of its escrow account",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}},
+				},
+				{
+					RpcMethod: "Vaults",
+					Use:       "vaults",
+					Short:     "Get all the vaults",
+				},
+				{
+					RpcMethod:      "CW20Bridge",
+					Use:            "cw20-bridge [denom]",
+					Short:          "Get the cw20 contract a denom is bound to and the bridge address to send its tokens to",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}},
+				},
+				{
+					RpcMethod: "CW20Bridges",
+					Use:       "cw20-bridges",
+					Short:     "Get all the cw20 bridges",
+				},
+				{
+					RpcMethod:      "Basket",
+					Use:            "basket [denom]",
+					Short:          "Get the basket of a denom and the address of the account holding its components",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}},
+				},
+				{
+					RpcMethod: "Baskets",
+					Use:       "baskets",
+					Short:     "Get all the baskets",
+				},
+				{
+					RpcMethod: "VestingSchedule",
+					Use:       "vesting-schedule [denom] [address]",
+					Short:     "Get the vesting schedule of a holder of a denom, with its vested and locked amounts",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "denom"},
+						{ProtoField: "address"},
+					},
+				},
+				{
+					RpcMethod:      "VestingSchedules",
+					Use:            "vesting-schedules [denom]",
+					Short:          "Get the vesting schedules of a denom",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}},
+				},
+			},
+		},
+		Tx: &autocliv1.ServiceCommandDescriptor{
+			Service: types.Msg_serviceDesc.ServiceName,
+			// The messages with a nested message, or a coin that autocli cannot merge into the
+			// gogoproto messages, have custom commands in client/cli.
+			EnhanceCustomCommand: true,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "CreateDenom",
+					Skip:      true,
+				},
+				{
+					RpcMethod: "Mint",
+					Skip:      true,
+				},
+				{
+					RpcMethod: "Burn",
+					Skip:      true,
+				},
+				{
+					RpcMethod: "ChangeAdmin",
+					Use:       "change-admin [denom] [new-admin-address]",
+					Short:     "Changes the admin address for a factory-created denom. Must have admin authority to do so.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "denom"},
+						{ProtoField: "new_admin"},
+					},
+				},
+				{
+					RpcMethod: "SetDenomMetadata",
+					Skip:      true,
+				},
+				{
+					RpcMethod: "ForceTransfer",
+					Skip:      true,
+				},
+				{
+					RpcMethod: "MultiMint",
+					Skip:      true,
+				},
+				{
+					RpcMethod: "MultiBurn",
+					Skip:      true,
+				},
+				{
+					RpcMethod: "CreateClaimCampaign",
+					Skip:      true,
+				},
+				{
+					RpcMethod: "Claim",
+					Skip:      true,
+				},
+				{
+					RpcMethod: "CloseClaimCampaign",
+					Use:       "close-claim-campaign [campaign-id]",
+					Short:     "Closes a claim campaign, no more claims are accepted. Must have admin authority of its denom to do so.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "campaign_id"},
+					},
+				},
+				{
+					RpcMethod: "SetVoucherSigner",
+					Skip:      true,
+				},
+				{
+					RpcMethod: "RedeemMintVoucher",
+					Skip:      true,
+				},
+				{
+					RpcMethod: "SetReserveAttestor",
+					Skip:      true,
+				},
+				{
+					RpcMethod: "AttestReserve",
+					Skip:      true,
+				},
+				{
+					RpcMethod: "RequestRedemption",
+					Skip:      true,
+				},
+				{
+					RpcMethod: "FulfillRedemption",
+					Use:       "fulfill-redemption [denom] [id] [settlement-reference]",
+					Short:     "Burns the escrowed tokens of a redemption request paid out off-chain. Must have admin authority to do so.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "denom"},
+						{ProtoField: "id"},
+						{ProtoField: "settlement_reference"},
+					},
+				},
+				{
+					RpcMethod: "RejectRedemption",
+					Use:       "reject-redemption [denom] [id] [reason]",
+					Short:     "Returns the escrowed tokens of a redemption request to its holder. Must have admin authority to do so.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "denom"},
+						{ProtoField: "id"},
+						{ProtoField: "reason", Optional: true},
+					},
+				},
+				{
+					RpcMethod: "CreateVault",
+					Use:       "create-vault [subdenom] [backing-denom] [ratio]",
+					Short:     "Create a new denom minted by depositing a backing denom, ratio tokens per backing unit. The denom creation fee is charged.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "subdenom"},
+						{ProtoField: "backing_denom"},
+						{ProtoField: "ratio"},
+					},
+				},
+				{
+					RpcMethod: "VaultDeposit",
+					Use:       "vault-deposit [denom] [amount]",
+					Short:     "Deposit an amount of the backing denom of a vault, and mint the amount times its ratio of the vault denom",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "denom"},
+						{ProtoField: "amount"},
+					},
+				},
+				{
+					RpcMethod: "VaultWithdraw",
+					Use:       "vault-withdraw [denom] [amount]",
+					Short:     "Withdraw an amount of the backing denom of a vault, and burn the amount times its ratio of the vault denom",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "denom"},
+						{ProtoField: "amount"},
+					},
+				},
+				{
+					RpcMethod: "RegisterCW20",
+					Use:       "register-cw20 [denom] [contract-address]",
+					Short:     "Bind a denom without supply to a cw20 contract, minting it for the cw20 tokens sent to the bridge. Must have admin authority to do so.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "denom"},
+						{ProtoField: "contract_address"},
+					},
+				},
+				{
+					RpcMethod: "WithdrawCW20",
+					Skip:      true,
+				},
+				{
+					RpcMethod: "CreateBasket",
+					Skip:      true,
+				},
+				{
+					RpcMethod: "BasketMint",
+					Use:       "basket-mint [denom] [amount]",
+					Short:     "Deposit the components of an amount of a basket denom, and mint the amount",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "denom"},
+						{ProtoField: "amount"},
+					},
+				},
+				{
+					RpcMethod: "BasketRedeem",
+					Use:       "basket-redeem [denom] [amount]",
+					Short:     "Burn an amount of a basket denom, and receive its share of the components held by the basket",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "denom"},
+						{ProtoField: "amount"},
+					},
+				},
+				{
+					RpcMethod: "SetBasketComposition",
+					Skip:      true,
+				},
+				{
+					RpcMethod: "MintVesting",
+					Skip:      true,
+				},
+				{
+					RpcMethod: "ClawbackVesting",
+					Use:       "clawback-vesting [denom] [holder]",
+					Short:     "Claw back the unvested tokens of a vesting schedule of a holder. Must have admin authority to do so.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "denom"},
+						{ProtoField: "holder"},
+					},
+				},
+				{
+					RpcMethod: "SetDenomHook",
+					Skip:      true,
+				},
+				{
+					RpcMethod: "SetBeforeSendHook",
+					Use:       "set-before-send-hook [denom] [cosmwasm-address]",
+					Short:     "Sets the contract called before every transfer of a denom. Must have admin authority to do so.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "denom"},
+						{ProtoField: "cosmwasm_address"},
+					},
+				},
+				{
+					RpcMethod:      "UpdateParams",
+					Use:            "update-params-proposal [params]",
+					Short:          "Submit a proposal to update the x/tokenfactory module params. Note: the entire params must be provided.",
+					Example:        fmt.Sprintf(`%s tx %s update-params-proposal '{ "denom_creation_fee": [{"denom": "stake", "amount": "10000000"}] }'`, version.AppName, types.ModuleName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "params"}},
+					GovProposal:    true,
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,36 @@
+package tokenfactory_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"github.com/cosmos/tokenfactory/x/tokenfactory"
+	"github.com/cosmos/tokenfactory/x/tokenfactory/types"
+
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+)
+
+// TestAutoCLIOptionsCoverServices makes sure every new RPC gets an explicit autocli decision.
+func TestAutoCLIOptionsCoverServices(t *testing.T) {
+	opts := tokenfactory.AppModule{}.AutoCLIOptions()
+
+	for _, tc := range []struct {
+		desc    grpc.ServiceDesc
+		service *autocliv1.ServiceCommandDescriptor
+	}{
+		{types.Query_serviceDesc, opts.Query},
+		{types.Msg_serviceDesc, opts.Tx},
+	} {
+		require.Equal(t, tc.desc.ServiceName, tc.service.Service)
+
+		covered := make(map[string]bool, len(tc.service.RpcCommandOptions))
+		for _, rpc := range tc.service.RpcCommandOptions {
+			covered[rpc.RpcMethod] = true
+		}
+		for _, method := range tc.desc.Methods {
+			require.True(t, covered[method.MethodName], "%s/%s has no autocli options", tc.desc.ServiceName, method.MethodName)
+		}
+	}
+}
@@ -0,0 +1,99 @@
+package bindings
+
+import (
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	tokenfactorytypes "github.com/cosmos/tokenfactory/x/tokenfactory/types"
+
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// AcceptedQueries returns the gRPC queries contracts can run with `QueryRequest::Grpc`
+// and `QueryRequest::Stargate`, mapped to their response types. It holds the
+// tokenfactory Query service methods looking up a single entry and the bank queries
+// about a single denom.
+//
+// Only queries with deterministic responses must be added here, see the warning on
+// wasmkeeper.AcceptListStargateQuerier.
+//
+// Unlike the other Query service methods, the list queries DenomsFromCreator, DenomsFromAdmin,
+// ReserveAttestations, OpenRedemptions, Vaults, CW20Bridges, Baskets and VestingSchedules are
+// not accepted, as their iteration is not charged with the wasm query gas per item param.
+// Contracts list the denoms of a creator or an admin with the custom bindings, which charge
+// every visited denom and take a limit.
+func AcceptedQueries() wasmkeeper.AcceptedQueries {
+	return wasmkeeper.AcceptedQueries{
+		// tokenfactory
+		"/osmosis.tokenfactory.v1beta1.Query/Params": func() proto.Message {
+			return &tokenfactorytypes.QueryParamsResponse{}
+		},
+		"/osmosis.tokenfactory.v1beta1.Query/DenomAuthorityMetadata": func() proto.Message {
+			return &tokenfactorytypes.QueryDenomAuthorityMetadataResponse{}
+		},
+		"/osmosis.tokenfactory.v1beta1.Query/DenomHook": func() proto.Message {
+			return &tokenfactorytypes.QueryDenomHookResponse{}
+		},
+		"/osmosis.tokenfactory.v1beta1.Query/BeforeSendHookAddress": func() proto.Message {
+			return &tokenfactorytypes.QueryBeforeSendHookAddressResponse{}
+		},
+		"/osmosis.tokenfactory.v1beta1.Query/ClaimCampaign": func() proto.Message {
+			return &tokenfactorytypes.QueryClaimCampaignResponse{}
+		},
+		"/osmosis.tokenfactory.v1beta1.Query/ClaimStatus": func() proto.Message {
+			return &tokenfactorytypes.QueryClaimStatusResponse{}
+		},
+		"/osmosis.tokenfactory.v1beta1.Query/VoucherSigner": func() proto.Message {
+			return &tokenfactorytypes.QueryVoucherSignerResponse{}
+		},
+		"/osmosis.tokenfactory.v1beta1.Query/VoucherNonce": func() proto.Message {
+			return &tokenfactorytypes.QueryVoucherNonceResponse{}
+		},
+		"/osmosis.tokenfactory.v1beta1.Query/ReferenceID": func() proto.Message {
+			return &tokenfactorytypes.QueryReferenceIDResponse{}
+		},
+		"/osmosis.tokenfactory.v1beta1.Query/ReserveAttestor": func() proto.Message {
+			return &tokenfactorytypes.QueryReserveAttestorResponse{}
+		},
+		"/osmosis.tokenfactory.v1beta1.Query/Redemption": func() proto.Message {
+			return &tokenfactorytypes.QueryRedemptionResponse{}
+		},
+		"/osmosis.tokenfactory.v1beta1.Query/Vault": func() proto.Message {
+			return &tokenfactorytypes.QueryVaultResponse{}
+		},
+		"/osmosis.tokenfactory.v1beta1.Query/CW20Bridge": func() proto.Message {
+			return &tokenfactorytypes.QueryCW20BridgeResponse{}
+		},
+		"/osmosis.tokenfactory.v1beta1.Query/Basket": func() proto.Message {
+			return &tokenfactorytypes.QueryBasketResponse{}
+		},
+		"/osmosis.tokenfactory.v1beta1.Query/VestingSchedule": func() proto.Message {
+			return &tokenfactorytypes.QueryVestingScheduleResponse{}
+		},
+
+		// bank
+		"/cosmos.bank.v1beta1.Query/DenomMetadata": func() proto.Message {
+			return &banktypes.QueryDenomMetadataResponse{}
+		},
+		"/cosmos.bank.v1beta1.Query/DenomMetadataByQueryString": func() proto.Message {
+			return &banktypes.QueryDenomMetadataByQueryStringResponse{}
+		},
+		"/cosmos.bank.v1beta1.Query/SupplyOf": func() proto.Message {
+			return &banktypes.QuerySupplyOfResponse{}
+		},
+	}
+}
+
+// RegisterGrpcQueries returns the wasm options that let contracts run the AcceptedQueries
+// through the Grpc and Stargate query plugins.
+func RegisterGrpcQueries(queryRouter wasmkeeper.GRPCQueryRouter, cdc codec.Codec) []wasmkeeper.Option {
+	acceptList := AcceptedQueries()
+
+	return []wasmkeeper.Option{
+		wasmkeeper.WithQueryPlugins(&wasmkeeper.QueryPlugins{
+			Grpc:     wasmkeeper.AcceptListGrpcQuerier(acceptList, queryRouter, cdc),
+			Stargate: wasmkeeper.AcceptListStargateQuerier(acceptList, queryRouter, cdc),
+		}),
+	}
+}
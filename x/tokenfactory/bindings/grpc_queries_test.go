@@ -0,0 +1,159 @@
+package bindings_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v3/types"
+	wasmbinding "github.com/cosmos/tokenfactory/x/tokenfactory/bindings"
+	"github.com/cosmos/tokenfactory/x/tokenfactory/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/cosmos/gogoproto/proto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// listQueries are the tokenfactory queries iterating over several entries, which are not
+// accepted from contracts.
+var listQueries = map[string]bool{
+	"/osmosis.tokenfactory.v1beta1.Query/DenomsFromCreator":   true,
+	"/osmosis.tokenfactory.v1beta1.Query/DenomsFromAdmin":     true,
+	"/osmosis.tokenfactory.v1beta1.Query/ReserveAttestations": true,
+	"/osmosis.tokenfactory.v1beta1.Query/OpenRedemptions":     true,
+	"/osmosis.tokenfactory.v1beta1.Query/Vaults":              true,
+	"/osmosis.tokenfactory.v1beta1.Query/CW20Bridges":         true,
+	"/osmosis.tokenfactory.v1beta1.Query/Baskets":             true,
+	"/osmosis.tokenfactory.v1beta1.Query/VestingSchedules":    true,
+}
+
+func TestAcceptedQueriesCoverQueryService(t *testing.T) {
+	desc, err := proto.HybridResolver.FindDescriptorByName("osmosis.tokenfactory.v1beta1.Query")
+	require.NoError(t, err)
+	methods := desc.(protoreflect.ServiceDescriptor).Methods()
+	require.NotZero(t, methods.Len())
+
+	acceptList := wasmbinding.AcceptedQueries()
+	for i := 0; i < methods.Len(); i++ {
+		method := methods.Get(i)
+		path := fmt.Sprintf("/%s/%s", desc.FullName(), method.Name())
+
+		responseFn, ok := acceptList[path]
+		if listQueries[path] {
+			require.False(t, ok, "%s is accepted", path)
+			continue
+		}
+		require.True(t, ok, "%s is not accepted", path)
+		require.Equal(t, string(method.Output().FullName()), proto.MessageName(responseFn()), path)
+	}
+}
+
+func TestGrpcQueries(t *testing.T) {
+	creator := RandomAccountAddress()
+	app, ctx := SetupCustomApp(t, creator)
+
+	fundAccount(t, ctx, app, creator, sdk.NewCoins(sdk.NewCoin(types.DefaultParams().DenomCreationFee[0].Denom, types.DefaultParams().DenomCreationFee[0].Amount.MulRaw(100))))
+	denom, err := app.TokenFactoryKeeper.CreateDenom(ctx, creator.String(), "sun")
+	require.NoError(t, err)
+
+	acceptList := wasmbinding.AcceptedQueries()
+	grpcQuerier := wasmkeeper.AcceptListGrpcQuerier(acceptList, app.GRPCQueryRouter(), app.AppCodec())
+	stargateQuerier := wasmkeeper.AcceptListStargateQuerier(acceptList, app.GRPCQueryRouter(), app.AppCodec())
+
+	specs := map[string]struct {
+		path   string
+		req    proto.Message
+		expRes proto.Message
+	}{
+		"denom authority metadata": {
+			path:   "/osmosis.tokenfactory.v1beta1.Query/DenomAuthorityMetadata",
+			req:    &types.QueryDenomAuthorityMetadataRequest{Denom: denom},
+			expRes: &types.QueryDenomAuthorityMetadataResponse{AuthorityMetadata: types.DenomAuthorityMetadata{Admin: creator.String()}},
+		},
+		"before send hook address": {
+			path:   "/osmosis.tokenfactory.v1beta1.Query/BeforeSendHookAddress",
+			req:    &types.QueryBeforeSendHookAddressRequest{Denom: denom},
+			expRes: &types.QueryBeforeSendHookAddressResponse{},
+		},
+		"params": {
+			path:   "/osmosis.tokenfactory.v1beta1.Query/Params",
+			req:    &types.QueryParamsRequest{},
+			expRes: &types.QueryParamsResponse{Params: app.TokenFactoryKeeper.GetParams(ctx)},
+		},
+		"bank supply of": {
+			path:   "/cosmos.bank.v1beta1.Query/SupplyOf",
+			req:    &banktypes.QuerySupplyOfRequest{Denom: denom},
+			expRes: &banktypes.QuerySupplyOfResponse{Amount: sdk.NewInt64Coin(denom, 0)},
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			reqBz, err := proto.Marshal(spec.req)
+			require.NoError(t, err)
+
+			// grpc returns the protobuf response
+			gotRes, err := grpcQuerier(ctx, &wasmvmtypes.GrpcQuery{Path: spec.path, Data: reqBz})
+			require.NoError(t, err)
+			require.Equal(t, spec.expRes.String(), gotRes.String())
+
+			// stargate returns the JSON response, which must not change between calls
+			gotBz, err := stargateQuerier(ctx, &wasmvmtypes.StargateQuery{Path: spec.path, Data: reqBz})
+			require.NoError(t, err)
+			expBz, err := app.AppCodec().MarshalJSON(spec.expRes)
+			require.NoError(t, err)
+			require.JSONEq(t, string(expBz), string(gotBz))
+
+			againBz, err := stargateQuerier(ctx, &wasmvmtypes.StargateQuery{Path: spec.path, Data: reqBz})
+			require.NoError(t, err)
+			require.Equal(t, gotBz, againBz)
+		})
+	}
+
+	// queries outside of the accept list, including the unmetered list queries, are rejected
+	for path, req := range map[string]proto.Message{
+		"/cosmos.bank.v1beta1.Query/AllBalances":              &banktypes.QueryAllBalancesRequest{Address: creator.String()},
+		"/osmosis.tokenfactory.v1beta1.Query/DenomsFromAdmin": &types.QueryDenomsFromAdminRequest{Admin: creator.String()},
+	} {
+		reqBz, err := proto.Marshal(req)
+		require.NoError(t, err)
+		_, err = grpcQuerier(ctx, &wasmvmtypes.GrpcQuery{Path: path, Data: reqBz})
+		require.ErrorAs(t, err, &wasmvmtypes.UnsupportedRequest{}, path)
+		_, err = stargateQuerier(ctx, &wasmvmtypes.StargateQuery{Path: path, Data: reqBz})
+		require.ErrorAs(t, err, &wasmvmtypes.UnsupportedRequest{}, path)
+	}
+}
+
+func TestStargateQueryFromContract(t *testing.T) {
+	creator := RandomAccountAddress()
+	app, ctx := SetupCustomApp(t, creator)
+
+	reflect := instantiateReflectContract(t, ctx, app, creator)
+	fundAccount(t, ctx, app, creator, sdk.NewCoins(sdk.NewCoin(types.DefaultParams().DenomCreationFee[0].Denom, types.DefaultParams().DenomCreationFee[0].Amount.MulRaw(100))))
+	denom, err := app.TokenFactoryKeeper.CreateDenom(ctx, creator.String(), "sun")
+	require.NoError(t, err)
+
+	reqBz, err := proto.Marshal(&types.QueryDenomAuthorityMetadataRequest{Denom: denom})
+	require.NoError(t, err)
+	queryBz, err := json.Marshal(ReflectQuery{
+		Chain: &ChainRequest{
+			Request: wasmvmtypes.QueryRequest{Stargate: &wasmvmtypes.StargateQuery{
+				Path: "/osmosis.tokenfactory.v1beta1.Query/DenomAuthorityMetadata",
+				Data: reqBz,
+			}},
+		},
+	})
+	require.NoError(t, err)
+
+	resBz, err := app.WasmKeeper.QuerySmart(ctx, reflect, queryBz)
+	require.NoError(t, err)
+	var resp ChainResponse
+	require.NoError(t, json.Unmarshal(resBz, &resp))
+
+	var got types.QueryDenomAuthorityMetadataResponse
+	require.NoError(t, app.AppCodec().UnmarshalJSON(resp.Data, &got))
+	require.Equal(t, creator.String(), got.AuthorityMetadata.Admin)
+}
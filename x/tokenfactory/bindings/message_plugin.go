@@ -13,6 +13,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 )
@@ -30,6 +31,16 @@ func CustomMessageDecorator(bank bankkeeper.Keeper, tokenFactory *tokenfactoryke
 
 var emptyMsgResp = [][]*types.Any{}
 
+// dispatchResult converts the result of a Perform* helper into the values
+// returned from DispatchMsg, the same way wasmd does for SDK messages.
+func dispatchResult(res *sdk.Result) ([]sdk.Event, [][]byte, [][]*types.Any, error) {
+	events := make([]sdk.Event, len(res.Events))
+	for i, e := range res.Events {
+		events[i] = sdk.Event(e)
+	}
+	return events, [][]byte{res.Data}, [][]*types.Any{res.MsgResponses}, nil
+}
+
 type CustomMessenger struct {
 	wrapped      wasmkeeper.Messenger
 	bank         bankkeeper.Keeper
@@ -48,6 +59,10 @@ func (m *CustomMessenger) DispatchMsg(ctx sdk.Context, contractAddr sdk.AccAddre
 			return nil, nil, emptyMsgResp, errorsmod.Wrap(err, "token factory msg")
 		}
 
+		// the helpers run with a fresh event manager so that only their own
+		// events are returned, like the SDK msg service router does
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+
 		if contractMsg.CreateDenom != nil {
 			return m.createDenom(ctx, contractAddr, contractMsg.CreateDenom)
 		}
@@ -66,21 +81,37 @@ func (m *CustomMessenger) DispatchMsg(ctx sdk.Context, contractAddr sdk.AccAddre
 		if contractMsg.ForceTransfer != nil {
 			return m.forceTransfer(ctx, contractAddr, contractMsg.ForceTransfer)
 		}
+		if contractMsg.MultiMint != nil {
+			return m.multiMint(ctx, contractAddr, contractMsg.MultiMint)
+		}
+		if contractMsg.MultiBurn != nil {
+			return m.multiBurn(ctx, contractAddr, contractMsg.MultiBurn)
+		}
+		if contractMsg.RegisterCW20 != nil {
+			return m.registerCW20(ctx, contractAddr, contractMsg.RegisterCW20)
+		}
+	}
+	// no contract lives at the cw20 bridge address, the send hooks of the
+	// cw20 contracts targeting it are handled here
+	if msg.Wasm != nil && msg.Wasm.Execute != nil && msg.Wasm.Execute.ContractAddr == tokenfactorytypes.CW20BridgeAddress.String() {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+		return m.receiveCW20(ctx, contractAddr, msg.Wasm.Execute)
 	}
 	return m.wrapped.DispatchMsg(ctx, contractAddr, contractIBCPortID, msg)
 }
 
 // createDenom creates a new token denom
 func (m *CustomMessenger) createDenom(ctx sdk.Context, contractAddr sdk.AccAddress, createDenom *bindingstypes.CreateDenom) ([]sdk.Event, [][]byte, [][]*types.Any, error) {
-	bz, err := PerformCreateDenom(m.tokenFactory, m.bank, ctx, contractAddr, createDenom)
+	res, err := PerformCreateDenom(m.tokenFactory, m.bank, ctx, contractAddr, createDenom)
 	if err != nil {
 		return nil, nil, emptyMsgResp, errorsmod.Wrap(err, "perform create denom")
 	}
-	return nil, [][]byte{bz}, emptyMsgResp, nil
+	return dispatchResult(res)
 }
 
 // PerformCreateDenom is used with createDenom to create a token denom; validates the msgCreateDenom.
-func PerformCreateDenom(f *tokenfactorykeeper.Keeper, b bankkeeper.Keeper, ctx sdk.Context, contractAddr sdk.AccAddress, createDenom *bindingstypes.CreateDenom) ([]byte, error) {
+// The returned result holds the MsgCreateDenomResponse and the events emitted into ctx.
+func PerformCreateDenom(f *tokenfactorykeeper.Keeper, b bankkeeper.Keeper, ctx sdk.Context, contractAddr sdk.AccAddress, createDenom *bindingstypes.CreateDenom) (*sdk.Result, error) {
 	if createDenom == nil {
 		return nil, wasmvmtypes.InvalidRequest{Err: "create denom null create denom"}
 	}
@@ -104,104 +135,111 @@ func PerformCreateDenom(f *tokenfactorykeeper.Keeper, b bankkeeper.Keeper, ctx s
 
 	if createDenom.Metadata != nil {
 		newDenom := resp.NewTokenDenom
-		err := PerformSetMetadata(f, b, ctx, contractAddr, newDenom, *createDenom.Metadata)
+		_, err := PerformSetMetadata(f, b, ctx, contractAddr, newDenom, *createDenom.Metadata)
 		if err != nil {
 			return nil, errorsmod.Wrap(err, "setting metadata")
 		}
 	}
 
-	return resp.Marshal()
+	return sdk.WrapServiceResult(ctx, resp, nil)
 }
 
 // mintTokens mints tokens of a specified denom to an address.
 func (m *CustomMessenger) mintTokens(ctx sdk.Context, contractAddr sdk.AccAddress, mint *bindingstypes.MintTokens) ([]sdk.Event, [][]byte, [][]*types.Any, error) {
-	err := PerformMint(m.tokenFactory, m.bank, ctx, contractAddr, mint)
+	res, err := PerformMint(m.tokenFactory, m.bank, ctx, contractAddr, mint)
 	if err != nil {
 		return nil, nil, emptyMsgResp, errorsmod.Wrap(err, "perform mint")
 	}
-	return nil, nil, emptyMsgResp, nil
+	return dispatchResult(res)
 }
 
 // PerformMint used with mintTokens to validate the mint message and mint through token factory.
-func PerformMint(f *tokenfactorykeeper.Keeper, b bankkeeper.Keeper, ctx sdk.Context, contractAddr sdk.AccAddress, mint *bindingstypes.MintTokens) error {
+// The returned result holds the MsgMintResponse, with the balance of the final recipient.
+func PerformMint(f *tokenfactorykeeper.Keeper, b bankkeeper.Keeper, ctx sdk.Context, contractAddr sdk.AccAddress, mint *bindingstypes.MintTokens) (*sdk.Result, error) {
 	if mint == nil {
-		return wasmvmtypes.InvalidRequest{Err: "mint token null mint"}
+		return nil, wasmvmtypes.InvalidRequest{Err: "mint token null mint"}
 	}
 	rcpt, err := parseAddress(mint.MintToAddress)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	coin := sdk.Coin{Denom: mint.Denom, Amount: mint.Amount}
 	sdkMsg := tokenfactorytypes.NewMsgMint(contractAddr.String(), coin)
 
 	if err = sdkMsg.ValidateBasic(); err != nil {
-		return err
+		return nil, err
 	}
 
 	// Mint through token factory / message server
 	msgServer := tokenfactorykeeper.NewMsgServerImpl(*f)
-	_, err = msgServer.Mint(ctx, sdkMsg)
+	resp, err := msgServer.Mint(ctx, sdkMsg)
 	if err != nil {
-		return errorsmod.Wrap(err, "minting coins from message")
+		return nil, errorsmod.Wrap(err, "minting coins from message")
 	}
 
 	if b.BlockedAddr(rcpt) {
-		return errorsmod.Wrapf(err, "minting coins to blocked address %s", rcpt.String())
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "minting coins to blocked address %s", rcpt.String())
 	}
 
 	err = b.SendCoins(ctx, contractAddr, rcpt, sdk.NewCoins(coin))
 	if err != nil {
-		return errorsmod.Wrap(err, "sending newly minted coins from message")
+		return nil, errorsmod.Wrap(err, "sending newly minted coins from message")
 	}
-	return nil
+
+	// the coins are minted to the contract first, report the balance of the actual recipient
+	resp.MintToBalance = b.GetBalance(ctx, rcpt, coin.Denom)
+
+	return sdk.WrapServiceResult(ctx, resp, nil)
 }
 
 // changeAdmin changes the admin.
 func (m *CustomMessenger) changeAdmin(ctx sdk.Context, contractAddr sdk.AccAddress, changeAdmin *bindingstypes.ChangeAdmin) ([]sdk.Event, [][]byte, [][]*types.Any, error) {
-	err := ChangeAdmin(m.tokenFactory, ctx, contractAddr, changeAdmin)
+	res, err := ChangeAdmin(m.tokenFactory, ctx, contractAddr, changeAdmin)
 	if err != nil {
 		return nil, nil, emptyMsgResp, errorsmod.Wrap(err, "failed to change admin")
 	}
-	return nil, nil, emptyMsgResp, nil
+	return dispatchResult(res)
 }
 
 // ChangeAdmin is used with changeAdmin to validate changeAdmin messages and to dispatch.
-func ChangeAdmin(f *tokenfactorykeeper.Keeper, ctx sdk.Context, contractAddr sdk.AccAddress, changeAdmin *bindingstypes.ChangeAdmin) error {
+// The returned result holds the MsgChangeAdminResponse.
+func ChangeAdmin(f *tokenfactorykeeper.Keeper, ctx sdk.Context, contractAddr sdk.AccAddress, changeAdmin *bindingstypes.ChangeAdmin) (*sdk.Result, error) {
 	if changeAdmin == nil {
-		return wasmvmtypes.InvalidRequest{Err: "changeAdmin is nil"}
+		return nil, wasmvmtypes.InvalidRequest{Err: "changeAdmin is nil"}
 	}
 	newAdminAddr, err := parseAddress(changeAdmin.NewAdminAddress)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	changeAdminMsg := tokenfactorytypes.NewMsgChangeAdmin(contractAddr.String(), changeAdmin.Denom, newAdminAddr.String())
 	if err := changeAdminMsg.ValidateBasic(); err != nil {
-		return err
+		return nil, err
 	}
 
 	msgServer := tokenfactorykeeper.NewMsgServerImpl(*f)
-	_, err = msgServer.ChangeAdmin(ctx, changeAdminMsg)
+	resp, err := msgServer.ChangeAdmin(ctx, changeAdminMsg)
 	if err != nil {
-		return errorsmod.Wrap(err, "failed changing admin from message")
+		return nil, errorsmod.Wrap(err, "failed changing admin from message")
 	}
-	return nil
+	return sdk.WrapServiceResult(ctx, resp, nil)
 }
 
 // burnTokens burns tokens.
 func (m *CustomMessenger) burnTokens(ctx sdk.Context, contractAddr sdk.AccAddress, burn *bindingstypes.BurnTokens) ([]sdk.Event, [][]byte, [][]*types.Any, error) {
-	err := PerformBurn(m.tokenFactory, ctx, contractAddr, burn)
+	res, err := PerformBurn(m.tokenFactory, ctx, contractAddr, burn)
 	if err != nil {
 		return nil, nil, emptyMsgResp, errorsmod.Wrap(err, "perform burn")
 	}
-	return nil, nil, emptyMsgResp, nil
+	return dispatchResult(res)
 }
 
 // PerformBurn performs token burning after validating tokenBurn message.
-func PerformBurn(f *tokenfactorykeeper.Keeper, ctx sdk.Context, contractAddr sdk.AccAddress, burn *bindingstypes.BurnTokens) error {
+// The returned result holds the MsgBurnResponse.
+func PerformBurn(f *tokenfactorykeeper.Keeper, ctx sdk.Context, contractAddr sdk.AccAddress, burn *bindingstypes.BurnTokens) (*sdk.Result, error) {
 	if burn == nil {
-		return wasmvmtypes.InvalidRequest{Err: "burn token null mint"}
+		return nil, wasmvmtypes.InvalidRequest{Err: "burn token null mint"}
 	}
 
 	coin := sdk.Coin{Denom: burn.Denom, Amount: burn.Amount}
@@ -211,78 +249,219 @@ func PerformBurn(f *tokenfactorykeeper.Keeper, ctx sdk.Context, contractAddr sdk
 	}
 
 	if err := sdkMsg.ValidateBasic(); err != nil {
-		return err
+		return nil, err
 	}
 
 	// Burn through token factory / message server
 	msgServer := tokenfactorykeeper.NewMsgServerImpl(*f)
-	_, err := msgServer.Burn(ctx, sdkMsg)
+	resp, err := msgServer.Burn(ctx, sdkMsg)
 	if err != nil {
-		return errorsmod.Wrap(err, "burning coins from message")
+		return nil, errorsmod.Wrap(err, "burning coins from message")
 	}
-	return nil
+	return sdk.WrapServiceResult(ctx, resp, nil)
 }
 
 // forceTransfer moves tokens.
 func (m *CustomMessenger) forceTransfer(ctx sdk.Context, contractAddr sdk.AccAddress, forcetransfer *bindingstypes.ForceTransfer) ([]sdk.Event, [][]byte, [][]*types.Any, error) {
-	err := PerformForceTransfer(m.tokenFactory, ctx, contractAddr, forcetransfer)
+	res, err := PerformForceTransfer(m.tokenFactory, ctx, contractAddr, forcetransfer)
 	if err != nil {
 		return nil, nil, emptyMsgResp, errorsmod.Wrap(err, "perform force transfer")
 	}
-	return nil, nil, emptyMsgResp, nil
+	return dispatchResult(res)
 }
 
 // PerformForceTransfer performs token moving after validating tokenForceTransfer message.
-func PerformForceTransfer(f *tokenfactorykeeper.Keeper, ctx sdk.Context, contractAddr sdk.AccAddress, forcetransfer *bindingstypes.ForceTransfer) error {
+// The returned result holds the MsgForceTransferResponse.
+func PerformForceTransfer(f *tokenfactorykeeper.Keeper, ctx sdk.Context, contractAddr sdk.AccAddress, forcetransfer *bindingstypes.ForceTransfer) (*sdk.Result, error) {
 	if forcetransfer == nil {
-		return wasmvmtypes.InvalidRequest{Err: "force transfer null"}
+		return nil, wasmvmtypes.InvalidRequest{Err: "force transfer null"}
 	}
 
 	_, err := parseAddress(forcetransfer.FromAddress)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	_, err = parseAddress(forcetransfer.ToAddress)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	coin := sdk.Coin{Denom: forcetransfer.Denom, Amount: forcetransfer.Amount}
 	sdkMsg := tokenfactorytypes.NewMsgForceTransfer(contractAddr.String(), coin, forcetransfer.FromAddress, forcetransfer.ToAddress)
 
 	if err := sdkMsg.ValidateBasic(); err != nil {
-		return err
+		return nil, err
 	}
 
 	// Transfer through token factory / message server
 	msgServer := tokenfactorykeeper.NewMsgServerImpl(*f)
-	_, err = msgServer.ForceTransfer(ctx, sdkMsg)
+	resp, err := msgServer.ForceTransfer(ctx, sdkMsg)
 	if err != nil {
-		return errorsmod.Wrap(err, "force transferring from message")
+		return nil, errorsmod.Wrap(err, "force transferring from message")
 	}
-	return nil
+	return sdk.WrapServiceResult(ctx, resp, nil)
 }
 
-// createDenom creates a new token denom
+// multiMint mints tokens to several addresses.
+func (m *CustomMessenger) multiMint(ctx sdk.Context, contractAddr sdk.AccAddress, multiMint *bindingstypes.MultiMint) ([]sdk.Event, [][]byte, [][]*types.Any, error) {
+	res, err := PerformMultiMint(m.tokenFactory, ctx, contractAddr, multiMint)
+	if err != nil {
+		return nil, nil, emptyMsgResp, errorsmod.Wrap(err, "perform multi mint")
+	}
+	return dispatchResult(res)
+}
+
+// PerformMultiMint performs the minting to several addresses after validating the multiMint message.
+// The returned result holds the MsgMultiMintResponse.
+func PerformMultiMint(f *tokenfactorykeeper.Keeper, ctx sdk.Context, contractAddr sdk.AccAddress, multiMint *bindingstypes.MultiMint) (*sdk.Result, error) {
+	if multiMint == nil {
+		return nil, wasmvmtypes.InvalidRequest{Err: "multi mint null"}
+	}
+
+	outputs := make([]tokenfactorytypes.MultiMintOutput, 0, len(multiMint.Outputs))
+	for _, output := range multiMint.Outputs {
+		outputs = append(outputs, tokenfactorytypes.MultiMintOutput{Address: output.Address, Amount: output.Amount})
+	}
+	sdkMsg := tokenfactorytypes.NewMsgMultiMint(contractAddr.String(), multiMint.Denom, outputs)
+
+	if err := sdkMsg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	// Mint through token factory / message server
+	msgServer := tokenfactorykeeper.NewMsgServerImpl(*f)
+	resp, err := msgServer.MultiMint(ctx, sdkMsg)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "multi minting coins from message")
+	}
+	return sdk.WrapServiceResult(ctx, resp, nil)
+}
+
+// multiBurn burns tokens from several addresses.
+func (m *CustomMessenger) multiBurn(ctx sdk.Context, contractAddr sdk.AccAddress, multiBurn *bindingstypes.MultiBurn) ([]sdk.Event, [][]byte, [][]*types.Any, error) {
+	res, err := PerformMultiBurn(m.tokenFactory, ctx, contractAddr, multiBurn)
+	if err != nil {
+		return nil, nil, emptyMsgResp, errorsmod.Wrap(err, "perform multi burn")
+	}
+	return dispatchResult(res)
+}
+
+// PerformMultiBurn performs the burning from several addresses after validating the multiBurn message.
+// The returned result holds the MsgMultiBurnResponse.
+func PerformMultiBurn(f *tokenfactorykeeper.Keeper, ctx sdk.Context, contractAddr sdk.AccAddress, multiBurn *bindingstypes.MultiBurn) (*sdk.Result, error) {
+	if multiBurn == nil {
+		return nil, wasmvmtypes.InvalidRequest{Err: "multi burn null"}
+	}
+
+	inputs := make([]tokenfactorytypes.MultiBurnInput, 0, len(multiBurn.Inputs))
+	for _, input := range multiBurn.Inputs {
+		inputs = append(inputs, tokenfactorytypes.MultiBurnInput{Address: input.Address, Amount: input.Amount})
+	}
+	sdkMsg := tokenfactorytypes.NewMsgMultiBurn(contractAddr.String(), multiBurn.Denom, inputs)
+
+	if err := sdkMsg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	// Burn through token factory / message server
+	msgServer := tokenfactorykeeper.NewMsgServerImpl(*f)
+	resp, err := msgServer.MultiBurn(ctx, sdkMsg)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "multi burning coins from message")
+	}
+	return sdk.WrapServiceResult(ctx, resp, nil)
+}
+
+// registerCW20 binds a token denom to a CW20 contract.
+func (m *CustomMessenger) registerCW20(ctx sdk.Context, contractAddr sdk.AccAddress, registerCW20 *bindingstypes.RegisterCW20) ([]sdk.Event, [][]byte, [][]*types.Any, error) {
+	res, err := PerformRegisterCW20(m.tokenFactory, ctx, contractAddr, registerCW20)
+	if err != nil {
+		return nil, nil, emptyMsgResp, errorsmod.Wrap(err, "perform register cw20")
+	}
+	return dispatchResult(res)
+}
+
+// PerformRegisterCW20 binds a denom the contract is the admin of to a CW20 contract after validating the registerCW20 message.
+// The returned result holds an empty MsgRegisterCW20Response.
+func PerformRegisterCW20(f *tokenfactorykeeper.Keeper, ctx sdk.Context, contractAddr sdk.AccAddress, registerCW20 *bindingstypes.RegisterCW20) (*sdk.Result, error) {
+	if registerCW20 == nil {
+		return nil, wasmvmtypes.InvalidRequest{Err: "register cw20 null"}
+	}
+
+	sdkMsg := tokenfactorytypes.NewMsgRegisterCW20(contractAddr.String(), registerCW20.Denom, registerCW20.ContractAddress)
+
+	if err := sdkMsg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	msgServer := tokenfactorykeeper.NewMsgServerImpl(*f)
+	resp, err := msgServer.RegisterCW20(ctx, sdkMsg)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "registering cw20 from message")
+	}
+	return sdk.WrapServiceResult(ctx, resp, nil)
+}
+
+// receiveCW20 mints the denom bound to a CW20 contract for the tokens it sent to the bridge address.
+func (m *CustomMessenger) receiveCW20(ctx sdk.Context, contractAddr sdk.AccAddress, execute *wasmvmtypes.ExecuteMsg) ([]sdk.Event, [][]byte, [][]*types.Any, error) {
+	res, err := PerformReceiveCW20(m.tokenFactory, ctx, contractAddr, execute)
+	if err != nil {
+		return nil, nil, emptyMsgResp, errorsmod.Wrap(err, "perform receive cw20")
+	}
+	return dispatchResult(res)
+}
+
+// PerformReceiveCW20 handles the `Receive` hook a CW20 contract executes on the bridge address when
+// tokens are sent to it, minting the bound denom to the sender of the tokens.
+// The returned result holds the events of the deposit only, as no message server handles it.
+func PerformReceiveCW20(f *tokenfactorykeeper.Keeper, ctx sdk.Context, contractAddr sdk.AccAddress, execute *wasmvmtypes.ExecuteMsg) (*sdk.Result, error) {
+	if execute == nil {
+		return nil, wasmvmtypes.InvalidRequest{Err: "receive cw20 null"}
+	}
+	if len(execute.Funds) != 0 {
+		return nil, wasmvmtypes.InvalidRequest{Err: "the cw20 bridge does not accept funds"}
+	}
+
+	var hook tokenfactorytypes.CW20ReceiveHook
+	if err := json.Unmarshal(execute.Msg, &hook); err != nil {
+		return nil, errorsmod.Wrap(err, "cw20 receive hook")
+	}
+	if hook.Receive == nil {
+		return nil, wasmvmtypes.InvalidRequest{Err: "the cw20 bridge only handles receive hooks"}
+	}
+
+	depositor, err := parseAddress(hook.Receive.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := f.DepositCW20(ctx, contractAddr.String(), depositor.String(), hook.Receive.Amount); err != nil {
+		return nil, errorsmod.Wrap(err, "depositing cw20 tokens")
+	}
+	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
+}
+
+// setMetadata sets the metadata of a token denom
 func (m *CustomMessenger) setMetadata(ctx sdk.Context, contractAddr sdk.AccAddress, setMetadata *bindingstypes.SetMetadata) ([]sdk.Event, [][]byte, [][]*types.Any, error) {
-	err := PerformSetMetadata(m.tokenFactory, m.bank, ctx, contractAddr, setMetadata.Denom, setMetadata.Metadata)
+	res, err := PerformSetMetadata(m.tokenFactory, m.bank, ctx, contractAddr, setMetadata.Denom, setMetadata.Metadata)
 	if err != nil {
-		return nil, nil, emptyMsgResp, errorsmod.Wrap(err, "perform create denom")
+		return nil, nil, emptyMsgResp, errorsmod.Wrap(err, "perform set metadata")
 	}
-	return nil, nil, emptyMsgResp, nil
+	return dispatchResult(res)
 }
 
 // PerformSetMetadata is used with setMetadata to add new metadata
 // It also is called inside CreateDenom if optional metadata field is set
-func PerformSetMetadata(f *tokenfactorykeeper.Keeper, b bankkeeper.Keeper, ctx sdk.Context, contractAddr sdk.AccAddress, denom string, metadata bindingstypes.Metadata) error {
+// The returned result holds an empty MsgSetDenomMetadataResponse.
+func PerformSetMetadata(f *tokenfactorykeeper.Keeper, b bankkeeper.Keeper, ctx sdk.Context, contractAddr sdk.AccAddress, denom string, metadata bindingstypes.Metadata) (*sdk.Result, error) {
 	// ensure contract address is admin of denom
 	auth, err := f.GetAuthorityMetadata(ctx, denom)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	if auth.Admin != contractAddr.String() {
-		return wasmvmtypes.InvalidRequest{Err: "only admin can set metadata"}
+		return nil, wasmvmtypes.InvalidRequest{Err: "only admin can set metadata"}
 	}
 
 	// ensure we are setting proper denom metadata (bank uses Base field, fill it if missing)
@@ -290,17 +469,27 @@ func PerformSetMetadata(f *tokenfactorykeeper.Keeper, b bankkeeper.Keeper, ctx s
 		metadata.Base = denom
 	} else if metadata.Base != denom {
 		// this is the key that we set
-		return wasmvmtypes.InvalidRequest{Err: "Base must be the same as denom"}
+		return nil, wasmvmtypes.InvalidRequest{Err: "Base must be the same as denom"}
 	}
 
 	// Create and validate the metadata
 	bankMetadata := WasmMetadataToSdk(metadata)
 	if err := bankMetadata.Validate(); err != nil {
-		return err
+		return nil, err
+	}
+
+	if err := f.SetDenomMetadata(ctx, bankMetadata); err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&tokenfactorytypes.EventSetDenomMetadata{
+		Denom:    denom,
+		Metadata: bankMetadata,
+	}); err != nil {
+		return nil, err
 	}
 
-	b.SetDenomMetaData(ctx, bankMetadata)
-	return nil
+	return sdk.WrapServiceResult(ctx, &tokenfactorytypes.MsgSetDenomMetadataResponse{}, nil)
 }
 
 // GetFullDenom is a function, not method, so the message_plugin can use it
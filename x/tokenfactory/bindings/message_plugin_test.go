@@ -0,0 +1,163 @@
+package bindings_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v3/types"
+	"github.com/cosmos/gogoproto/proto"
+	wasmbinding "github.com/cosmos/tokenfactory/x/tokenfactory/bindings"
+	bindings "github.com/cosmos/tokenfactory/x/tokenfactory/bindings/types"
+	"github.com/cosmos/tokenfactory/x/tokenfactory/types"
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestDispatchMsgResponsesAndEvents(t *testing.T) {
+	creator := RandomAccountAddress()
+	app, ctx := SetupCustomApp(t, creator)
+
+	contract := RandomAccountAddress()
+	fundAccount(t, ctx, app, contract, sdk.NewCoins(sdk.NewCoin(types.DefaultParams().DenomCreationFee[0].Denom, types.DefaultParams().DenomCreationFee[0].Amount.MulRaw(100))))
+
+	messenger := wasmbinding.CustomMessageDecorator(app.BankKeeper, &app.TokenFactoryKeeper)(nil)
+	denom := fmt.Sprintf("factory/%s/sun", contract.String())
+	rcpt := RandomAccountAddress()
+
+	specs := []struct {
+		name       string
+		msg        bindings.TokenFactoryMsg
+		expTypeURL string
+		expEvent   proto.Message
+	}{
+		{
+			name:       "create denom",
+			msg:        bindings.TokenFactoryMsg{CreateDenom: &bindings.CreateDenom{Subdenom: "sun"}},
+			expTypeURL: "/osmosis.tokenfactory.v1beta1.MsgCreateDenomResponse",
+			expEvent:   &types.EventCreateDenom{},
+		},
+		{
+			name:       "mint",
+			msg:        bindings.TokenFactoryMsg{MintTokens: &bindings.MintTokens{Denom: denom, Amount: sdkmath.NewInt(100), MintToAddress: rcpt.String()}},
+			expTypeURL: "/osmosis.tokenfactory.v1beta1.MsgMintResponse",
+			expEvent:   &types.EventMint{},
+		},
+		{
+			name:       "force transfer",
+			msg:        bindings.TokenFactoryMsg{ForceTransfer: &bindings.ForceTransfer{Denom: denom, Amount: sdkmath.NewInt(10), FromAddress: rcpt.String(), ToAddress: contract.String()}},
+			expTypeURL: "/osmosis.tokenfactory.v1beta1.MsgForceTransferResponse",
+			expEvent:   &types.EventForceTransfer{},
+		},
+		{
+			name:       "burn",
+			msg:        bindings.TokenFactoryMsg{BurnTokens: &bindings.BurnTokens{Denom: denom, Amount: sdkmath.NewInt(10)}},
+			expTypeURL: "/osmosis.tokenfactory.v1beta1.MsgBurnResponse",
+			expEvent:   &types.EventBurn{},
+		},
+		{
+			name:       "multi mint",
+			msg:        bindings.TokenFactoryMsg{MultiMint: &bindings.MultiMint{Denom: denom, Outputs: []bindings.MultiMintOutput{{Address: rcpt.String(), Amount: sdkmath.NewInt(10)}, {Address: contract.String(), Amount: sdkmath.NewInt(10)}}}},
+			expTypeURL: "/osmosis.tokenfactory.v1beta1.MsgMultiMintResponse",
+			expEvent:   &types.EventMint{},
+		},
+		{
+			name:       "multi burn",
+			msg:        bindings.TokenFactoryMsg{MultiBurn: &bindings.MultiBurn{Denom: denom, Inputs: []bindings.MultiBurnInput{{Address: rcpt.String(), Amount: sdkmath.NewInt(5)}, {Address: contract.String(), Amount: sdkmath.NewInt(5)}}}},
+			expTypeURL: "/osmosis.tokenfactory.v1beta1.MsgMultiBurnResponse",
+			expEvent:   &types.EventBurn{},
+		},
+		{
+			name:       "set metadata",
+			msg:        bindings.TokenFactoryMsg{SetMetadata: &bindings.SetMetadata{Denom: denom, Metadata: bindings.Metadata{Name: "Sun", Symbol: "SUN", Display: denom, DenomUnits: []bindings.DenomUnit{{Denom: denom}}}}},
+			expTypeURL: "/osmosis.tokenfactory.v1beta1.MsgSetDenomMetadataResponse",
+			expEvent:   &types.EventSetDenomMetadata{},
+		},
+		{
+			name:       "change admin",
+			msg:        bindings.TokenFactoryMsg{ChangeAdmin: &bindings.ChangeAdmin{Denom: denom, NewAdminAddress: rcpt.String()}},
+			expTypeURL: "/osmosis.tokenfactory.v1beta1.MsgChangeAdminResponse",
+			expEvent:   &types.EventChangeAdmin{},
+		},
+	}
+	for _, spec := range specs {
+		t.Run(spec.name, func(t *testing.T) {
+			bz, err := json.Marshal(spec.msg)
+			require.NoError(t, err)
+
+			events, data, msgResponses, err := messenger.DispatchMsg(ctx, contract, "", wasmvmtypes.CosmosMsg{Custom: bz})
+			require.NoError(t, err)
+
+			require.Len(t, data, 1)
+			require.Len(t, msgResponses, 1)
+			require.Len(t, msgResponses[0], 1)
+			require.Equal(t, spec.expTypeURL, msgResponses[0][0].TypeUrl)
+			require.Equal(t, data[0], msgResponses[0][0].Value)
+
+			var found bool
+			for _, e := range events {
+				if e.Type == proto.MessageName(spec.expEvent) {
+					found = true
+				}
+			}
+			require.True(t, found, "event %s not returned", proto.MessageName(spec.expEvent))
+		})
+	}
+}
+
+func TestDispatchCW20Bridge(t *testing.T) {
+	creator := RandomAccountAddress()
+	app, ctx := SetupCustomApp(t, creator)
+
+	contract := RandomAccountAddress()
+	fundAccount(t, ctx, app, contract, sdk.NewCoins(sdk.NewCoin(types.DefaultParams().DenomCreationFee[0].Denom, types.DefaultParams().DenomCreationFee[0].Amount.MulRaw(100))))
+
+	messenger := wasmbinding.CustomMessageDecorator(app.BankKeeper, &app.TokenFactoryKeeper)(nil)
+	denom := fmt.Sprintf("factory/%s/wrapped", contract.String())
+	cw20Contract := RandomAccountAddress()
+	holder := RandomAccountAddress()
+
+	for _, msg := range []bindings.TokenFactoryMsg{
+		{CreateDenom: &bindings.CreateDenom{Subdenom: "wrapped"}},
+		{RegisterCW20: &bindings.RegisterCW20{Denom: denom, ContractAddress: cw20Contract.String()}},
+	} {
+		bz, err := json.Marshal(msg)
+		require.NoError(t, err)
+		_, _, _, err = messenger.DispatchMsg(ctx, contract, "", wasmvmtypes.CosmosMsg{Custom: bz})
+		require.NoError(t, err)
+	}
+
+	bridge, found := app.TokenFactoryKeeper.GetCW20Bridge(ctx, denom)
+	require.True(t, found)
+	require.Equal(t, cw20Contract.String(), bridge.ContractAddress)
+
+	// the Send of a cw20 contract to the bridge address executes its receive hook
+	receive := func(funds wasmvmtypes.Array[wasmvmtypes.Coin]) wasmvmtypes.CosmosMsg {
+		return wasmvmtypes.CosmosMsg{Wasm: &wasmvmtypes.WasmMsg{Execute: &wasmvmtypes.ExecuteMsg{
+			ContractAddr: types.CW20BridgeAddress.String(),
+			Msg:          []byte(fmt.Sprintf(`{"receive":{"sender":"%s","amount":"100","msg":"e30="}}`, holder)),
+			Funds:        funds,
+		}}}
+	}
+
+	events, _, _, err := messenger.DispatchMsg(ctx, cw20Contract, "", receive(nil))
+	require.NoError(t, err)
+	require.Equal(t, sdkmath.NewInt(100), app.BankKeeper.GetBalance(ctx, holder, denom).Amount)
+	var deposited bool
+	for _, e := range events {
+		if e.Type == proto.MessageName(&types.EventDepositCW20{}) {
+			deposited = true
+		}
+	}
+	require.True(t, deposited, "event %s not returned", proto.MessageName(&types.EventDepositCW20{}))
+
+	// only the bound contract can deposit, without funds
+	_, _, _, err = messenger.DispatchMsg(ctx, contract, "", receive(nil))
+	require.ErrorIs(t, err, types.ErrCW20BridgeNotFound)
+	_, _, _, err = messenger.DispatchMsg(ctx, cw20Contract, "", receive(wasmvmtypes.Array[wasmvmtypes.Coin]{{Denom: "stake", Amount: "1"}}))
+	require.Error(t, err)
+	require.Equal(t, sdkmath.NewInt(100), app.BankKeeper.GetSupply(ctx, denom).Amount)
+}
@@ -3,6 +3,7 @@ package bindings
 import (
 	"context"
 	"fmt"
+	"time"
 
 	bindingstypes "github.com/cosmos/tokenfactory/x/tokenfactory/bindings/types"
 	tokenfactorykeeper "github.com/cosmos/tokenfactory/x/tokenfactory/keeper"
@@ -33,12 +34,67 @@ func (qp QueryPlugin) GetDenomAdmin(ctx context.Context, denom string) (*binding
 	return &bindingstypes.AdminResponse{Admin: metadata.Admin}, nil
 }
 
-func (qp QueryPlugin) GetDenomsByCreator(ctx context.Context, creator string) (*bindingstypes.DenomsByCreatorResponse, error) {
+// GetDenomsByCreator is a query to get the denoms created by creator. Every visited denom
+// is charged with the wasm query gas per item param, at most limit denoms are returned
+// unless limit is 0.
+func (qp QueryPlugin) GetDenomsByCreator(ctx context.Context, creator string, limit uint32) (*bindingstypes.DenomsByCreatorResponse, error) {
 	// TODO: validate creator address
-	denoms := qp.tokenFactoryKeeper.GetDenomsFromCreator(sdk.UnwrapSDKContext(ctx), creator)
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	gasPerItem := qp.tokenFactoryKeeper.GetParams(sdkCtx).WasmQueryGasPerItem
+
+	denoms := []string{}
+	qp.tokenFactoryKeeper.IterateDenomsFromCreator(sdkCtx, creator, func(denom string) bool {
+		sdkCtx.GasMeter().ConsumeGas(gasPerItem, "tokenfactory denoms by creator query")
+		denoms = append(denoms, denom)
+		return limit != 0 && len(denoms) >= int(limit)
+	})
 	return &bindingstypes.DenomsByCreatorResponse{Denoms: denoms}, nil
 }
 
+// GetDenomsFromAdmin is a query to get all denoms the given address is the admin of.
+// It scans all factory denoms, so every visited denom is charged with the wasm query
+// gas per item param, not only the matching ones. At most limit denoms are returned
+// unless limit is 0.
+func (qp QueryPlugin) GetDenomsFromAdmin(ctx context.Context, admin string, limit uint32) (*bindingstypes.DenomsFromAdminResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	gasPerItem := qp.tokenFactoryKeeper.GetParams(sdkCtx).WasmQueryGasPerItem
+
+	iterator := qp.tokenFactoryKeeper.GetAllDenomsIterator(sdkCtx)
+	defer iterator.Close()
+
+	denoms := []string{}
+	for ; iterator.Valid(); iterator.Next() {
+		sdkCtx.GasMeter().ConsumeGas(gasPerItem, "tokenfactory denoms from admin query")
+
+		denom := string(iterator.Value())
+		metadata, err := qp.tokenFactoryKeeper.GetAuthorityMetadata(sdkCtx, denom)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get denoms for admin: %s", admin)
+		}
+		if metadata.Admin != admin {
+			continue
+		}
+
+		denoms = append(denoms, denom)
+		if limit != 0 && len(denoms) >= int(limit) {
+			break
+		}
+	}
+	return &bindingstypes.DenomsFromAdminResponse{Denoms: denoms}, nil
+}
+
+// GetDenomSupply is a query to get the total supply of a denom.
+func (qp QueryPlugin) GetDenomSupply(ctx context.Context, denom string) (*bindingstypes.DenomSupplyResponse, error) {
+	supply := qp.bankKeeper.GetSupply(ctx, denom)
+	return &bindingstypes.DenomSupplyResponse{Amount: ConvertSdkCoinToWasmCoin(supply)}, nil
+}
+
+// GetCapabilities is a query to get the capabilities enabled on the chain.
+func (qp QueryPlugin) GetCapabilities(_ context.Context) (*bindingstypes.CapabilitiesResponse, error) {
+	capabilities := append([]string{}, qp.tokenFactoryKeeper.GetEnabledCapabilities()...)
+	return &bindingstypes.CapabilitiesResponse{Capabilities: capabilities}, nil
+}
+
 func (qp QueryPlugin) GetMetadata(ctx context.Context, denom string) (*bindingstypes.MetadataResponse, error) {
 	metadata, found := qp.bankKeeper.GetDenomMetaData(ctx, denom)
 	var parsed *bindingstypes.Metadata
@@ -52,7 +108,13 @@ func (qp QueryPlugin) GetParams(ctx context.Context) (*bindingstypes.ParamsRespo
 	params := qp.tokenFactoryKeeper.GetParams(sdk.UnwrapSDKContext(ctx))
 	return &bindingstypes.ParamsResponse{
 		Params: bindingstypes.Params{
-			DenomCreationFee: ConvertSdkCoinsToWasmCoins(params.DenomCreationFee),
+			DenomCreationFee:           ConvertSdkCoinsToWasmCoins(params.DenomCreationFee),
+			DenomCreationGasConsume:    params.DenomCreationGasConsume,
+			WasmQueryGasPerItem:        params.WasmQueryGasPerItem,
+			DenomHookGasLimit:          params.DenomHookGasLimit,
+			BeforeSendHookGasLimit:     params.BeforeSendHookGasLimit,
+			ReferenceIDRetentionBlocks: params.ReferenceIdRetentionBlocks,
+			RedemptionTimeoutSeconds:   uint64(params.RedemptionTimeout / time.Second),
 		},
 	}, nil
 }
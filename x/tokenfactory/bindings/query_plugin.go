@@ -68,7 +68,7 @@ func CustomQuerier(qp *QueryPlugin) func(ctx sdk.Context, request json.RawMessag
 			return bz, nil
 
 		case contractQuery.DenomsByCreator != nil:
-			res, err := qp.GetDenomsByCreator(ctx, contractQuery.DenomsByCreator.Creator)
+			res, err := qp.GetDenomsByCreator(ctx, contractQuery.DenomsByCreator.Creator, contractQuery.DenomsByCreator.Limit)
 			if err != nil {
 				return nil, err
 			}
@@ -80,6 +80,45 @@ func CustomQuerier(qp *QueryPlugin) func(ctx sdk.Context, request json.RawMessag
 
 			return bz, nil
 
+		case contractQuery.DenomsFromAdmin != nil:
+			res, err := qp.GetDenomsFromAdmin(ctx, contractQuery.DenomsFromAdmin.Admin, contractQuery.DenomsFromAdmin.Limit)
+			if err != nil {
+				return nil, err
+			}
+
+			bz, err := json.Marshal(res)
+			if err != nil {
+				return nil, fmt.Errorf("failed to JSON marshal DenomsFromAdminResponse: %w", err)
+			}
+
+			return bz, nil
+
+		case contractQuery.DenomSupply != nil:
+			res, err := qp.GetDenomSupply(ctx, contractQuery.DenomSupply.Denom)
+			if err != nil {
+				return nil, err
+			}
+
+			bz, err := json.Marshal(res)
+			if err != nil {
+				return nil, fmt.Errorf("failed to JSON marshal DenomSupplyResponse: %w", err)
+			}
+
+			return bz, nil
+
+		case contractQuery.Capabilities != nil:
+			res, err := qp.GetCapabilities(ctx)
+			if err != nil {
+				return nil, err
+			}
+
+			bz, err := json.Marshal(res)
+			if err != nil {
+				return nil, fmt.Errorf("failed to JSON marshal CapabilitiesResponse: %w", err)
+			}
+
+			return bz, nil
+
 		case contractQuery.Params != nil:
 			res, err := qp.GetParams(ctx)
 			if err != nil {
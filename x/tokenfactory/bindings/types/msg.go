@@ -19,6 +19,16 @@ type TokenFactoryMsg struct {
 	SetMetadata *SetMetadata `json:"set_metadata,omitempty"`
 	/// Forces a transfer of tokens from one address to another.
 	ForceTransfer *ForceTransfer `json:"force_transfer,omitempty"`
+	/// Contracts can mint a factory denom they are the admin of to several
+	/// addresses at once.
+	MultiMint *MultiMint `json:"multi_mint,omitempty"`
+	/// Contracts can burn a factory denom they are the admin of from several
+	/// addresses at once.
+	MultiBurn *MultiBurn `json:"multi_burn,omitempty"`
+	/// Contracts can bind a factory denom they are the admin of to a CW20
+	/// contract, which then mints the denom for the CW20 tokens sent to
+	/// the bridge address.
+	RegisterCW20 *RegisterCW20 `json:"register_cw20,omitempty"`
 }
 
 // CreateDenom creates a new factory denom, of denomination:
@@ -62,3 +72,28 @@ type ForceTransfer struct {
 	FromAddress string   `json:"from_address"`
 	ToAddress   string   `json:"to_address"`
 }
+
+type MultiMint struct {
+	Denom   string            `json:"denom"`
+	Outputs []MultiMintOutput `json:"outputs"`
+}
+
+type MultiMintOutput struct {
+	Address string   `json:"address"`
+	Amount  math.Int `json:"amount"`
+}
+
+type MultiBurn struct {
+	Denom  string           `json:"denom"`
+	Inputs []MultiBurnInput `json:"inputs"`
+}
+
+type MultiBurnInput struct {
+	Address string   `json:"address"`
+	Amount  math.Int `json:"amount"`
+}
+
+type RegisterCW20 struct {
+	Denom           string `json:"denom"`
+	ContractAddress string `json:"contract_address"`
+}
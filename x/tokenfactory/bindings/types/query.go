@@ -1,5 +1,9 @@
 package types
 
+import (
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v3/types"
+)
+
 // See https://github.com/CosmWasm/token-bindings/blob/main/packages/bindings/src/query.rs
 type TokenFactoryQuery struct {
 	/// Given a subdenom minted by a contract via `OsmosisMsg::MintTokens`,
@@ -8,6 +12,9 @@ type TokenFactoryQuery struct {
 	Admin           *DenomAdmin      `json:"admin,omitempty"`
 	Metadata        *GetMetadata     `json:"metadata,omitempty"`
 	DenomsByCreator *DenomsByCreator `json:"denoms_by_creator,omitempty"`
+	DenomsFromAdmin *DenomsFromAdmin `json:"denoms_from_admin,omitempty"`
+	DenomSupply     *DenomSupply     `json:"denom_supply,omitempty"`
+	Capabilities    *GetCapabilities `json:"capabilities,omitempty"`
 	Params          *GetParams       `json:"params,omitempty"`
 }
 
@@ -28,8 +35,22 @@ type DenomAdmin struct {
 
 type DenomsByCreator struct {
 	Creator string `json:"creator"`
+	// Limit caps the number of returned denoms, 0 returns all of them.
+	Limit uint32 `json:"limit,omitempty"`
+}
+
+type DenomsFromAdmin struct {
+	Admin string `json:"admin"`
+	// Limit caps the number of returned denoms, 0 returns all of them.
+	Limit uint32 `json:"limit,omitempty"`
 }
 
+type DenomSupply struct {
+	Denom string `json:"denom"`
+}
+
+type GetCapabilities struct{}
+
 type GetParams struct{}
 
 // responses
@@ -50,6 +71,18 @@ type DenomsByCreatorResponse struct {
 	Denoms []string `json:"denoms"`
 }
 
+type DenomsFromAdminResponse struct {
+	Denoms []string `json:"denoms"`
+}
+
+type DenomSupplyResponse struct {
+	Amount wasmvmtypes.Coin `json:"amount"`
+}
+
+type CapabilitiesResponse struct {
+	Capabilities []string `json:"capabilities"`
+}
+
 type ParamsResponse struct {
 	Params Params `json:"params"`
 }
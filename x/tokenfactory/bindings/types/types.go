@@ -33,5 +33,11 @@ type DenomUnit struct {
 }
 
 type Params struct {
-	DenomCreationFee []wasmvmtypes.Coin `json:"denom_creation_fee"`
+	DenomCreationFee           []wasmvmtypes.Coin `json:"denom_creation_fee"`
+	DenomCreationGasConsume    uint64             `json:"denom_creation_gas_consume"`
+	WasmQueryGasPerItem        uint64             `json:"wasm_query_gas_per_item"`
+	DenomHookGasLimit          uint64             `json:"denom_hook_gas_limit"`
+	BeforeSendHookGasLimit     uint64             `json:"before_send_hook_gas_limit"`
+	ReferenceIDRetentionBlocks uint64             `json:"reference_id_retention_blocks"`
+	RedemptionTimeoutSeconds   uint64             `json:"redemption_timeout_seconds"`
 }
@@ -155,7 +155,7 @@ func TestChangeAdmin(t *testing.T) {
 			})
 			require.NoError(t, err)
 
-			err = wasmbinding.ChangeAdmin(&app.TokenFactoryKeeper, ctx, spec.actor, spec.changeAdmin)
+			_, err = wasmbinding.ChangeAdmin(&app.TokenFactoryKeeper, ctx, spec.actor, spec.changeAdmin)
 			if len(spec.expErrMsg) > 0 {
 				require.Error(t, err)
 				actualErrMsg := err.Error()
@@ -275,13 +275,21 @@ func TestMint(t *testing.T) {
 	for name, spec := range specs {
 		t.Run(name, func(t *testing.T) {
 			// when
-			gotErr := wasmbinding.PerformMint(&app.TokenFactoryKeeper, app.BankKeeper, ctx, creator, spec.mint)
+			gotRes, gotErr := wasmbinding.PerformMint(&app.TokenFactoryKeeper, app.BankKeeper, ctx, creator, spec.mint)
 			// then
 			if spec.expErr {
 				require.Error(t, gotErr)
 				return
 			}
 			require.NoError(t, gotErr)
+
+			var resp types.MsgMintResponse
+			require.NoError(t, resp.Unmarshal(gotRes.Data))
+			require.Len(t, gotRes.MsgResponses, 1)
+			require.Equal(t, "/osmosis.tokenfactory.v1beta1.MsgMintResponse", gotRes.MsgResponses[0].TypeUrl)
+			rcpt := sdk.MustAccAddressFromBech32(spec.mint.MintToAddress)
+			require.Equal(t, app.BankKeeper.GetSupply(ctx, spec.mint.Denom), resp.TotalSupply)
+			require.Equal(t, app.BankKeeper.GetBalance(ctx, rcpt, spec.mint.Denom), resp.MintToBalance)
 		})
 	}
 }
@@ -392,7 +400,7 @@ func TestBurn(t *testing.T) {
 				Amount:        mintAmount,
 				MintToAddress: creator.String(),
 			}
-			err := wasmbinding.PerformMint(&app.TokenFactoryKeeper, app.BankKeeper, ctx, creator, mintBinding)
+			_, err := wasmbinding.PerformMint(&app.TokenFactoryKeeper, app.BankKeeper, ctx, creator, mintBinding)
 			require.NoError(t, err)
 
 			emptyDenomMintBinding := &bindings.MintTokens{
@@ -400,11 +408,11 @@ func TestBurn(t *testing.T) {
 				Amount:        mintAmount,
 				MintToAddress: creator.String(),
 			}
-			err = wasmbinding.PerformMint(&app.TokenFactoryKeeper, app.BankKeeper, ctx, creator, emptyDenomMintBinding)
+			_, err = wasmbinding.PerformMint(&app.TokenFactoryKeeper, app.BankKeeper, ctx, creator, emptyDenomMintBinding)
 			require.NoError(t, err)
 
 			// when
-			gotErr := wasmbinding.PerformBurn(&app.TokenFactoryKeeper, ctx, creator, spec.burn)
+			_, gotErr := wasmbinding.PerformBurn(&app.TokenFactoryKeeper, ctx, creator, spec.burn)
 			// then
 			if spec.expErr {
 				require.Error(t, gotErr)
@@ -1,13 +1,26 @@
 package bindings_test
 
 import (
+	"encoding/json"
 	"fmt"
+	"maps"
+	"math"
+	"os"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"strings"
 	"testing"
 
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v3/types"
 	wasmbinding "github.com/cosmos/tokenfactory/x/tokenfactory/bindings"
+	bindings "github.com/cosmos/tokenfactory/x/tokenfactory/bindings/types"
+	"github.com/cosmos/tokenfactory/x/tokenfactory/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	storetypes "cosmossdk.io/store/types"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -114,3 +127,189 @@ func TestDenomAdmin(t *testing.T) {
 		})
 	}
 }
+
+func TestDenomsFromAdmin(t *testing.T) {
+	addr := RandomAccountAddress()
+	app, ctx := SetupCustomApp(t, addr)
+
+	tfParams := app.TokenFactoryKeeper.GetParams(ctx)
+	tfParams.DenomCreationFee = sdk.NewCoins()
+	require.NoError(t, app.TokenFactoryKeeper.SetParams(ctx, tfParams))
+
+	admin := sdk.AccAddress([]byte("addr1_______________"))
+	denomA, err := app.TokenFactoryKeeper.CreateDenom(ctx, admin.String(), "a")
+	require.NoError(t, err)
+	denomB, err := app.TokenFactoryKeeper.CreateDenom(ctx, admin.String(), "b")
+	require.NoError(t, err)
+
+	queryPlugin := wasmbinding.NewQueryPlugin(app.BankKeeper, &app.TokenFactoryKeeper)
+
+	resp, err := queryPlugin.GetDenomsFromAdmin(ctx, admin.String(), 0)
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{denomA, denomB}, resp.Denoms)
+
+	resp, err = queryPlugin.GetDenomsFromAdmin(ctx, addr.String(), 0)
+	require.NoError(t, err)
+	require.NotNil(t, resp.Denoms)
+	require.Empty(t, resp.Denoms)
+}
+
+func TestDenomSupplyCapabilitiesAndParams(t *testing.T) {
+	addr := RandomAccountAddress()
+	app, ctx := SetupCustomApp(t, addr)
+
+	fundAccount(t, ctx, app, addr, sdk.NewCoins(sdk.NewInt64Coin("utest", 1234)))
+
+	querier := wasmbinding.CustomQuerier(wasmbinding.NewQueryPlugin(app.BankKeeper, &app.TokenFactoryKeeper))
+	query := func(request bindings.TokenFactoryQuery, response interface{}) {
+		bz, err := json.Marshal(request)
+		require.NoError(t, err)
+		resBz, err := querier(ctx, bz)
+		require.NoError(t, err)
+		require.NoError(t, json.Unmarshal(resBz, response))
+	}
+
+	var supply bindings.DenomSupplyResponse
+	query(bindings.TokenFactoryQuery{DenomSupply: &bindings.DenomSupply{Denom: "utest"}}, &supply)
+	require.Equal(t, wasmvmtypes.Coin{Denom: "utest", Amount: "1234"}, supply.Amount)
+
+	var capabilities bindings.CapabilitiesResponse
+	query(bindings.TokenFactoryQuery{Capabilities: &bindings.GetCapabilities{}}, &capabilities)
+	require.Equal(t, app.TokenFactoryKeeper.GetEnabledCapabilities(), capabilities.Capabilities)
+	require.Contains(t, capabilities.Capabilities, types.EnableForceTransfer)
+
+	var params bindings.ParamsResponse
+	query(bindings.TokenFactoryQuery{Params: &bindings.GetParams{}}, &params)
+	expParams := app.TokenFactoryKeeper.GetParams(ctx)
+	require.Equal(t, expParams.DenomCreationGasConsume, params.Params.DenomCreationGasConsume)
+	require.Equal(t, wasmbinding.ConvertSdkCoinsToWasmCoins(expParams.DenomCreationFee), params.Params.DenomCreationFee)
+}
+
+// TestQuerySchema ensures the JSON schema files exported for contracts stay in
+// sync with the Go query and response types.
+func TestQuerySchema(t *testing.T) {
+	type schema struct {
+		Required   []string                   `json:"required"`
+		Properties map[string]json.RawMessage `json:"properties"`
+		OneOf      []schema                   `json:"oneOf"`
+	}
+	load := func(name string) schema {
+		bz, err := os.ReadFile(filepath.Join("schema", name))
+		require.NoError(t, err)
+		var s schema
+		require.NoError(t, json.Unmarshal(bz, &s))
+		return s
+	}
+	jsonFields := func(typ reflect.Type) []string {
+		var fields []string
+		for i := 0; i < typ.NumField(); i++ {
+			fields = append(fields, strings.Split(typ.Field(i).Tag.Get("json"), ",")[0])
+		}
+		return fields
+	}
+
+	// every query variant is in the schema, with the same fields
+	queryType := reflect.TypeOf(bindings.TokenFactoryQuery{})
+	variants := map[string]schema{}
+	for _, variant := range load("token_factory_query.json").OneOf {
+		require.Len(t, variant.Required, 1)
+		var inner schema
+		require.NoError(t, json.Unmarshal(variant.Properties[variant.Required[0]], &inner))
+		variants[variant.Required[0]] = inner
+	}
+	require.ElementsMatch(t, jsonFields(queryType), slices.Collect(maps.Keys(variants)))
+	for i := 0; i < queryType.NumField(); i++ {
+		field := queryType.Field(i)
+		name := strings.Split(field.Tag.Get("json"), ",")[0]
+		require.ElementsMatch(t, jsonFields(field.Type.Elem()), slices.Collect(maps.Keys(variants[name].Properties)), name)
+	}
+
+	for file, resp := range map[string]interface{}{
+		"denoms_from_admin_response.json": bindings.DenomsFromAdminResponse{},
+		"denom_supply_response.json":      bindings.DenomSupplyResponse{},
+		"capabilities_response.json":      bindings.CapabilitiesResponse{},
+		"params_response.json":            bindings.ParamsResponse{},
+	} {
+		s := load(file)
+		require.ElementsMatch(t, jsonFields(reflect.TypeOf(resp)), slices.Collect(maps.Keys(s.Properties)), file)
+		require.ElementsMatch(t, s.Required, slices.Collect(maps.Keys(s.Properties)), file)
+	}
+}
+
+func TestListQueriesGasMetering(t *testing.T) {
+	addr := RandomAccountAddress()
+	app, ctx := SetupCustomApp(t, addr)
+
+	tfParams := types.DefaultParams()
+	tfParams.DenomCreationFee = sdk.NewCoins()
+	require.NoError(t, app.TokenFactoryKeeper.SetParams(ctx, tfParams))
+
+	const numDenoms = 50
+	creator := sdk.AccAddress([]byte("addr1_______________"))
+	for i := 0; i < numDenoms; i++ {
+		_, err := app.TokenFactoryKeeper.CreateDenom(ctx, creator.String(), fmt.Sprintf("denom%d", i))
+		require.NoError(t, err)
+	}
+
+	querier := wasmbinding.CustomQuerier(wasmbinding.NewQueryPlugin(app.BankKeeper, &app.TokenFactoryKeeper))
+	// query runs the request with a fresh gas meter and returns the number of
+	// returned denoms and the gas consumed
+	query := func(request bindings.TokenFactoryQuery, gasLimit uint64) (int, uint64) {
+		bz, err := json.Marshal(request)
+		require.NoError(t, err)
+
+		queryCtx := ctx.WithGasMeter(storetypes.NewGasMeter(gasLimit))
+		resBz, err := querier(queryCtx, bz)
+		require.NoError(t, err)
+
+		var res struct {
+			Denoms []string `json:"denoms"`
+		}
+		require.NoError(t, json.Unmarshal(resBz, &res))
+		return len(res.Denoms), queryCtx.GasMeter().GasConsumed()
+	}
+	byCreator := func(limit uint32) bindings.TokenFactoryQuery {
+		return bindings.TokenFactoryQuery{DenomsByCreator: &bindings.DenomsByCreator{Creator: creator.String(), Limit: limit}}
+	}
+	fromAdmin := func(admin sdk.AccAddress, limit uint32) bindings.TokenFactoryQuery {
+		return bindings.TokenFactoryQuery{DenomsFromAdmin: &bindings.DenomsFromAdmin{Admin: admin.String(), Limit: limit}}
+	}
+
+	gasPerItem := app.TokenFactoryKeeper.GetParams(ctx).WasmQueryGasPerItem
+	require.NotZero(t, gasPerItem)
+
+	// every returned denom is charged
+	count, allGas := query(byCreator(0), math.MaxUint64)
+	require.Equal(t, numDenoms, count)
+	require.GreaterOrEqual(t, allGas, numDenoms*gasPerItem)
+
+	// a limit bounds the work done and the price paid
+	count, limitedGas := query(byCreator(5), math.MaxUint64)
+	require.Equal(t, 5, count)
+	require.GreaterOrEqual(t, limitedGas, 5*gasPerItem)
+	require.Less(t, limitedGas, allGas)
+
+	// the scan over all denoms is charged even if nothing matches
+	count, emptyScanGas := query(fromAdmin(addr, 0), math.MaxUint64)
+	require.Zero(t, count)
+	require.GreaterOrEqual(t, emptyScanGas, numDenoms*gasPerItem)
+
+	count, _ = query(fromAdmin(creator, 3), math.MaxUint64)
+	require.Equal(t, 3, count)
+
+	// an unbounded scan can't be run with a fixed gas budget
+	require.Panics(t, func() {
+		query(byCreator(0), 10*gasPerItem)
+	})
+	require.Panics(t, func() {
+		query(fromAdmin(addr, 0), 10*gasPerItem)
+	})
+
+	// the cost per item is configurable
+	tfParams = app.TokenFactoryKeeper.GetParams(ctx)
+	tfParams.WasmQueryGasPerItem = 2 * gasPerItem
+	require.NoError(t, app.TokenFactoryKeeper.SetParams(ctx, tfParams))
+
+	_, doubledGas := query(byCreator(0), math.MaxUint64)
+	require.Equal(t, allGas+numDenoms*gasPerItem, doubledGas)
+}
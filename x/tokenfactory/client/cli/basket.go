@@ -0,0 +1,108 @@
+package cli
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/cosmos/tokenfactory/x/tokenfactory/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+// NewCreateBasketCmd broadcast MsgCreateBasket
+func NewCreateBasketCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-basket [subdenom] [components] [composition-timelock] [flags]",
+		Short: "Create a new denom minted by depositing a basket of components per unit. The denom creation fee is charged.",
+		Long: `Create a new denom minted by depositing the components of a basket for every unit, and redeemed for
+a proportional share of the deposited components. The components of the basket can only be changed by its admin
+after the composition timelock, which must be at least 24h.`,
+		Example: fmt.Sprintf(
+			"%s tx %s create-basket index 10uatom,5factory/cosmos1.../usd 168h --from admin",
+			version.AppName, types.ModuleName,
+		),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+			txf = txf.WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			components, err := sdk.ParseCoinsNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			compositionTimelock, err := time.ParseDuration(args[2])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCreateBasket(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				components,
+				compositionTimelock,
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewSetBasketCompositionCmd broadcast MsgSetBasketComposition
+func NewSetBasketCompositionCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-basket-composition [denom] [components] [flags]",
+		Short: "Schedule a change of the components of a basket after its composition timelock. Must have admin authority to do so.",
+		Long: `Schedule a change of the components of a basket, executed once its composition timelock passed. It
+replaces the pending change of the basket, and empty components "" cancel it.`,
+		Example: fmt.Sprintf(
+			"%s tx %s set-basket-composition factory/cosmos1.../index 10uatom,8factory/cosmos1.../usd --from admin",
+			version.AppName, types.ModuleName,
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+			txf = txf.WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			components, err := sdk.ParseCoinsNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetBasketComposition(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				components,
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
@@ -0,0 +1,495 @@
+package cli
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/cosmos/tokenfactory/x/tokenfactory/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/input"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+const (
+	FlagFile      = "file"
+	FlagGasPerMsg = "gas-per-msg"
+	FlagMaxTxGas  = "max-tx-gas"
+	FlagOutputDir = "output-dir"
+
+	BatchOpMint          = "mint"
+	BatchOpBurn          = "burn"
+	BatchOpChangeAdmin   = "change-admin"
+	BatchOpForceTransfer = "force-transfer"
+
+	// batch row statuses of the reconciliation report
+	BatchStatusBroadcast    = "broadcast"
+	BatchStatusFailed       = "failed"
+	BatchStatusNotBroadcast = "not_broadcast"
+	BatchStatusGenerated    = "generated"
+)
+
+// BatchOperation is a row of a batch file. The meaning of the address depends on the type:
+//   - mint: the address receiving the minted tokens, the sender when empty
+//   - burn: the address the tokens are burned from, the sender when empty
+//   - change-admin: the new admin of the denom
+//   - force-transfer: the address the tokens are transferred from, to the to_address
+//
+// Mints and burns take an optional reference id, rejected on chain if it has already been used.
+type BatchOperation struct {
+	// Row is the line number in a CSV file, or the position in the array of a JSON file
+	Row         int    `json:"-"`
+	Type        string `json:"type"`
+	Amount      string `json:"amount,omitempty"`
+	Denom       string `json:"denom,omitempty"`
+	Address     string `json:"address,omitempty"`
+	ToAddress   string `json:"to_address,omitempty"`
+	ReferenceID string `json:"reference_id,omitempty"`
+}
+
+// Msg returns the validated message of the operation sent by the sender.
+func (op BatchOperation) Msg(sender string) (sdk.Msg, error) {
+	var msg interface {
+		sdk.Msg
+		ValidateBasic() error
+	}
+
+	switch op.Type {
+	case BatchOpMint, BatchOpBurn, BatchOpForceTransfer:
+		amount, err := sdk.ParseCoinNormalized(op.Amount)
+		if err != nil {
+			return nil, fmt.Errorf("invalid amount %q: %w", op.Amount, err)
+		}
+
+		switch op.Type {
+		case BatchOpMint:
+			mint := types.NewMsgMintTo(sender, amount, op.Address)
+			mint.ReferenceId = op.ReferenceID
+			msg = mint
+		case BatchOpBurn:
+			burn := types.NewMsgBurnFrom(sender, amount, op.Address)
+			burn.ReferenceId = op.ReferenceID
+			msg = burn
+		default:
+			msg = types.NewMsgForceTransfer(sender, amount, op.Address, op.ToAddress)
+		}
+	case BatchOpChangeAdmin:
+		msg = types.NewMsgChangeAdmin(sender, op.Denom, op.Address)
+	default:
+		return nil, fmt.Errorf("unknown operation type %q, must be one of %s, %s, %s or %s",
+			op.Type, BatchOpMint, BatchOpBurn, BatchOpChangeAdmin, BatchOpForceTransfer)
+	}
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	return msg, nil
+}
+
+// ParseBatchFile reads the operations of a CSV file, with a header row naming the columns, or of
+// a JSON file holding an array of operations. The format is chosen from the file extension.
+func ParseBatchFile(path string) ([]BatchOperation, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var ops []BatchOperation
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".csv":
+		ops, err = parseBatchCSV(f)
+	case ".json":
+		ops, err = parseBatchJSON(f)
+	default:
+		return nil, fmt.Errorf("batch file %s must be a .csv or a .json file", path)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse batch file %s: %w", path, err)
+	}
+
+	if len(ops) == 0 {
+		return nil, fmt.Errorf("batch file %s has no operations", path)
+	}
+
+	return ops, nil
+}
+
+func parseBatchCSV(r io.Reader) ([]BatchOperation, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, err
+	}
+
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		name = strings.TrimSpace(name)
+		switch name {
+		case "type", "amount", "denom", "address", "to_address", "reference_id":
+		default:
+			return nil, fmt.Errorf("unknown column %q", name)
+		}
+		columns[name] = i
+	}
+	if _, ok := columns["type"]; !ok {
+		return nil, errors.New("missing type column")
+	}
+
+	column := func(record []string, name string) string {
+		i, ok := columns[name]
+		if !ok {
+			return ""
+		}
+		return strings.TrimSpace(record[i])
+	}
+
+	var ops []BatchOperation
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return ops, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		line, _ := reader.FieldPos(0)
+		ops = append(ops, BatchOperation{
+			Row:         line,
+			Type:        column(record, "type"),
+			Amount:      column(record, "amount"),
+			Denom:       column(record, "denom"),
+			Address:     column(record, "address"),
+			ToAddress:   column(record, "to_address"),
+			ReferenceID: column(record, "reference_id"),
+		})
+	}
+}
+
+func parseBatchJSON(r io.Reader) ([]BatchOperation, error) {
+	decoder := json.NewDecoder(r)
+	decoder.DisallowUnknownFields()
+
+	var ops []BatchOperation
+	if err := decoder.Decode(&ops); err != nil {
+		return nil, err
+	}
+
+	for i := range ops {
+		ops[i].Row = i + 1
+	}
+
+	return ops, nil
+}
+
+// PackBatch groups the consecutive messages, with the given gas estimates, into as few txs as
+// possible without exceeding the max gas of a tx. The order of the messages is kept, as later
+// operations may depend on earlier ones.
+func PackBatch(gasEstimates []uint64, maxTxGas uint64) ([][]int, error) {
+	var (
+		txs     [][]int
+		current []int
+		gas     uint64
+	)
+
+	for i, estimate := range gasEstimates {
+		if estimate > maxTxGas {
+			return nil, fmt.Errorf("message %d needs %d gas, more than the max gas of a tx %d", i, estimate, maxTxGas)
+		}
+
+		if gas+estimate > maxTxGas {
+			txs = append(txs, current)
+			current, gas = nil, 0
+		}
+
+		current = append(current, i)
+		gas += estimate
+	}
+
+	if len(current) > 0 {
+		txs = append(txs, current)
+	}
+
+	return txs, nil
+}
+
+// BatchReport is the reconciliation report of a batch, mapping the rows of the file to the txs.
+type BatchReport struct {
+	Txs  []BatchReportTx  `json:"txs"`
+	Rows []BatchReportRow `json:"rows"`
+}
+
+// BatchReportTx is a tx of a batch. Generated txs have no hash, they are written to the file and
+// must be signed with the sequence.
+type BatchReportTx struct {
+	Tx       int    `json:"tx"`
+	Rows     []int  `json:"rows"`
+	Sequence uint64 `json:"sequence"`
+	Gas      uint64 `json:"gas"`
+	Status   string `json:"status"`
+	TxHash   string `json:"tx_hash,omitempty"`
+	Code     uint32 `json:"code,omitempty"`
+	RawLog   string `json:"raw_log,omitempty"`
+	File     string `json:"file,omitempty"`
+}
+
+// BatchReportRow is an operation of a batch.
+type BatchReportRow struct {
+	Row    int    `json:"row"`
+	Type   string `json:"type"`
+	Tx     int    `json:"tx"`
+	Status string `json:"status"`
+	TxHash string `json:"tx_hash,omitempty"`
+}
+
+// NewBatchCmd broadcast the mints, burns, admin changes and force transfers of a file in as few txs as possible
+func NewBatchCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "batch --file [ops.csv|ops.json] [flags]",
+		Short: "Broadcast the mints, burns, admin changes and force transfers of a file in as few txs as possible.",
+		Long: fmt.Sprintf(`Broadcast the mints, burns, admin changes and force transfers of a CSV or a JSON file in as few txs as possible.
+
+Every operation has a type (%[1]s, %[2]s, %[3]s or %[4]s) and the amount, denom, address and to_address it needs:
+  - %[1]s: amount, and the address receiving the tokens, the sender when empty
+  - %[2]s: amount, and the address the tokens are burned from, the sender when empty
+Mints and burns take an optional reference_id, rejected on chain if it has already been used for the denom.
+  - %[3]s: denom, and the address of the new admin
+  - %[4]s: amount, the address the tokens are transferred from and the to_address
+
+A CSV file has a header row naming the columns, a JSON file holds an array of operations.
+Every operation is validated before anything is signed, and the operations are packed in order
+into txs, with a gas limit of --%[5]s per message and at most --%[6]s per tx.
+
+The txs are signed and broadcast one after the other, and the batch stops at the first rejected tx.
+With --generate-only, the unsigned txs are written to --%[7]s to be signed offline, with the
+sequences of the report.
+
+A reconciliation report maps every row of the file to its tx.`,
+			BatchOpMint, BatchOpBurn, BatchOpChangeAdmin, BatchOpForceTransfer, FlagGasPerMsg, FlagMaxTxGas, FlagOutputDir),
+		Example: fmt.Sprintf(`$ cat ops.csv
+type,amount,denom,address,to_address
+mint,1000factory/cosmos1...addr.../mytoken,,cosmos1...recipient...,
+burn,10factory/cosmos1...addr.../mytoken,,,
+change-admin,,factory/cosmos1...addr.../mytoken,cosmos1...newadmin...,
+$ %[1]s tx tokenfactory batch --file=ops.csv --from=mykey
+$ %[1]s tx tokenfactory batch --file=ops.csv --from=mymultisig --generate-only --output-dir=./unsigned`, version.AppName),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+			txf = txf.WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			if txf.SimulateAndExecute() || clientCtx.Simulate {
+				return fmt.Errorf("the gas of the batch txs is set from --%s, --gas=auto and --dry-run are not supported", FlagGasPerMsg)
+			}
+
+			file, err := cmd.Flags().GetString(FlagFile)
+			if err != nil {
+				return err
+			}
+			gasPerMsg, err := cmd.Flags().GetUint64(FlagGasPerMsg)
+			if err != nil {
+				return err
+			}
+			maxTxGas, err := cmd.Flags().GetUint64(FlagMaxTxGas)
+			if err != nil {
+				return err
+			}
+			outputDir, err := cmd.Flags().GetString(FlagOutputDir)
+			if err != nil {
+				return err
+			}
+			if clientCtx.GenerateOnly && outputDir == "" {
+				return fmt.Errorf("--%s is required with --%s", FlagOutputDir, flags.FlagGenerateOnly)
+			}
+
+			ops, err := ParseBatchFile(file)
+			if err != nil {
+				return err
+			}
+
+			// validate every operation before signing anything
+			sender := clientCtx.GetFromAddress().String()
+			msgs := make([]sdk.Msg, len(ops))
+			var invalid []string
+			for i, op := range ops {
+				msgs[i], err = op.Msg(sender)
+				if err != nil {
+					invalid = append(invalid, fmt.Sprintf("row %d: %s", op.Row, err))
+				}
+			}
+			if len(invalid) > 0 {
+				return fmt.Errorf("invalid operations:\n%s", strings.Join(invalid, "\n"))
+			}
+
+			gasEstimates := make([]uint64, len(msgs))
+			for i := range gasEstimates {
+				gasEstimates[i] = gasPerMsg
+			}
+			batches, err := PackBatch(gasEstimates, maxTxGas)
+			if err != nil {
+				return err
+			}
+
+			txf, err = txf.Prepare(clientCtx)
+			if err != nil {
+				return err
+			}
+
+			if !clientCtx.GenerateOnly && !clientCtx.SkipConfirm {
+				ok, err := input.GetConfirmation(
+					fmt.Sprintf("sign and broadcast %d operations in %d txs", len(msgs), len(batches)),
+					bufio.NewReader(clientCtx.Input), os.Stderr,
+				)
+				if err != nil {
+					return err
+				}
+				if !ok {
+					_, _ = fmt.Fprintln(os.Stderr, "canceled batch")
+					return nil
+				}
+			}
+
+			report, err := runBatch(clientCtx, txf, ops, msgs, batches, gasPerMsg, outputDir)
+			if report != nil {
+				bz, jsonErr := json.Marshal(report)
+				if jsonErr != nil {
+					return errors.Join(err, jsonErr)
+				}
+				if printErr := clientCtx.PrintRaw(bz); printErr != nil {
+					return errors.Join(err, printErr)
+				}
+			}
+
+			return err
+		},
+	}
+
+	cmd.Flags().String(FlagFile, "", "Path to the CSV or JSON file of the operations")
+	cmd.Flags().Uint64(FlagGasPerMsg, 200_000, "Gas limit of every operation in a tx")
+	cmd.Flags().Uint64(FlagMaxTxGas, 3_000_000, "Max gas limit of a tx")
+	cmd.Flags().String(FlagOutputDir, "", "Directory of the unsigned txs, with --generate-only")
+	_ = cmd.MarkFlagRequired(FlagFile)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// runBatch generates or broadcasts the txs of the batch, the returned report is set even when
+// a tx is rejected.
+func runBatch(
+	clientCtx client.Context,
+	txf tx.Factory,
+	ops []BatchOperation,
+	msgs []sdk.Msg,
+	batches [][]int,
+	gasPerMsg uint64,
+	outputDir string,
+) (*BatchReport, error) {
+	if clientCtx.GenerateOnly {
+		if err := os.MkdirAll(outputDir, 0o755); err != nil {
+			return nil, err
+		}
+	}
+
+	report := &BatchReport{
+		Txs:  make([]BatchReportTx, len(batches)),
+		Rows: make([]BatchReportRow, len(ops)),
+	}
+	for i, batch := range batches {
+		report.Txs[i] = BatchReportTx{
+			Tx:       i + 1,
+			Sequence: txf.Sequence() + uint64(i),
+			Gas:      gasPerMsg * uint64(len(batch)),
+			Status:   BatchStatusNotBroadcast,
+		}
+		for _, j := range batch {
+			report.Txs[i].Rows = append(report.Txs[i].Rows, ops[j].Row)
+			report.Rows[j] = BatchReportRow{Row: ops[j].Row, Type: ops[j].Type, Tx: i + 1, Status: BatchStatusNotBroadcast}
+		}
+	}
+
+	for i, batch := range batches {
+		reportTx := &report.Txs[i]
+
+		txMsgs := make([]sdk.Msg, len(batch))
+		for k, j := range batch {
+			txMsgs[k] = msgs[j]
+		}
+
+		txBuilder, err := txf.WithGas(reportTx.Gas).WithSequence(reportTx.Sequence).BuildUnsignedTx(txMsgs...)
+		if err != nil {
+			return report, err
+		}
+
+		if clientCtx.GenerateOnly {
+			bz, err := clientCtx.TxConfig.TxJSONEncoder()(txBuilder.GetTx())
+			if err != nil {
+				return report, err
+			}
+
+			reportTx.File = filepath.Join(outputDir, fmt.Sprintf("batch-tx-%d.json", reportTx.Tx))
+			if err := os.WriteFile(reportTx.File, bz, 0o600); err != nil {
+				return report, err
+			}
+
+			reportTx.Status = BatchStatusGenerated
+			setBatchRowsStatus(report, batch, reportTx)
+			continue
+		}
+
+		if err := tx.Sign(clientCtx.CmdContext, txf.WithSequence(reportTx.Sequence), clientCtx.FromName, txBuilder, true); err != nil {
+			return report, err
+		}
+
+		bz, err := clientCtx.TxConfig.TxEncoder()(txBuilder.GetTx())
+		if err != nil {
+			return report, err
+		}
+
+		res, err := clientCtx.BroadcastTx(bz)
+		if err != nil {
+			return report, err
+		}
+
+		reportTx.TxHash = res.TxHash
+		if res.Code != 0 {
+			reportTx.Status, reportTx.Code, reportTx.RawLog = BatchStatusFailed, res.Code, res.RawLog
+			setBatchRowsStatus(report, batch, reportTx)
+			return report, fmt.Errorf("tx %d was rejected with code %d, the next txs are not broadcast", reportTx.Tx, res.Code)
+		}
+
+		reportTx.Status = BatchStatusBroadcast
+		setBatchRowsStatus(report, batch, reportTx)
+	}
+
+	return report, nil
+}
+
+func setBatchRowsStatus(report *BatchReport, batch []int, reportTx *BatchReportTx) {
+	for _, j := range batch {
+		report.Rows[j].Status = reportTx.Status
+		report.Rows[j].TxHash = reportTx.TxHash
+	}
+}
@@ -0,0 +1,210 @@
+package cli_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/tokenfactory/x/tokenfactory/client/cli"
+	"github.com/cosmos/tokenfactory/x/tokenfactory/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	batchSender    = sdk.AccAddress("sender______________").String()
+	batchRecipient = sdk.AccAddress("recipient___________").String()
+	batchDenom     = "factory/" + batchSender + "/bitcoin"
+)
+
+func TestParseBatchFile(t *testing.T) {
+	dir := t.TempDir()
+	expected := []cli.BatchOperation{
+		{Row: 2, Type: cli.BatchOpMint, Amount: "100" + batchDenom, Address: batchRecipient},
+		{Row: 3, Type: cli.BatchOpBurn, Amount: "10" + batchDenom},
+		{Row: 4, Type: cli.BatchOpChangeAdmin, Denom: batchDenom, Address: batchRecipient},
+		{Row: 5, Type: cli.BatchOpForceTransfer, Amount: "1" + batchDenom, Address: batchRecipient, ToAddress: batchSender},
+	}
+
+	for _, tc := range []struct {
+		name     string
+		contents string
+		expOps   []cli.BatchOperation
+		expErr   string
+	}{
+		{
+			name: "ops.csv",
+			contents: "type,amount,denom,address,to_address\n" +
+				"mint,100" + batchDenom + ",," + batchRecipient + ",\n" +
+				"burn, 10" + batchDenom + ",,,\n" +
+				"change-admin,," + batchDenom + "," + batchRecipient + ",\n" +
+				"force-transfer,1" + batchDenom + ",," + batchRecipient + "," + batchSender + "\n",
+			expOps: expected,
+		},
+		{
+			name: "reordered_columns.csv",
+			contents: "address,type,amount\n" +
+				batchRecipient + ",mint,100" + batchDenom + "\n",
+			expOps: expected[:1],
+		},
+		{
+			name: "ops.json",
+			contents: `[
+				{"type": "mint", "amount": "100` + batchDenom + `", "address": "` + batchRecipient + `"},
+				{"type": "burn", "amount": "10` + batchDenom + `"}
+			]`,
+			expOps: []cli.BatchOperation{
+				{Row: 1, Type: cli.BatchOpMint, Amount: "100" + batchDenom, Address: batchRecipient},
+				{Row: 2, Type: cli.BatchOpBurn, Amount: "10" + batchDenom},
+			},
+		},
+		{
+			name:     "reference_ids.csv",
+			contents: "type,amount,reference_id\nmint,100" + batchDenom + ",wire-1\n",
+			expOps: []cli.BatchOperation{
+				{Row: 2, Type: cli.BatchOpMint, Amount: "100" + batchDenom, ReferenceID: "wire-1"},
+			},
+		},
+		{name: "unknown_column.csv", contents: "type,amount,recipient\n", expErr: `unknown column "recipient"`},
+		{name: "missing_type.csv", contents: "amount,address\n", expErr: "missing type column"},
+		{name: "empty.csv", contents: "type,amount\n", expErr: "has no operations"},
+		{name: "unknown_field.json", contents: `[{"type": "mint", "to": "` + batchRecipient + `"}]`, expErr: `unknown field "to"`},
+		{name: "ops.txt", contents: "", expErr: "must be a .csv or a .json file"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			path := filepath.Join(dir, tc.name)
+			require.NoError(t, os.WriteFile(path, []byte(tc.contents), 0o600))
+
+			ops, err := cli.ParseBatchFile(path)
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expOps, ops)
+		})
+	}
+}
+
+func TestBatchOperationMsg(t *testing.T) {
+	amount := sdk.NewInt64Coin(batchDenom, 100)
+
+	for _, tc := range []struct {
+		desc   string
+		op     cli.BatchOperation
+		expMsg sdk.Msg
+		expErr string
+	}{
+		{
+			desc:   "mint to the sender",
+			op:     cli.BatchOperation{Type: cli.BatchOpMint, Amount: amount.String()},
+			expMsg: types.NewMsgMintTo(batchSender, amount, ""),
+		},
+		{
+			desc:   "mint to an address",
+			op:     cli.BatchOperation{Type: cli.BatchOpMint, Amount: amount.String(), Address: batchRecipient},
+			expMsg: types.NewMsgMintTo(batchSender, amount, batchRecipient),
+		},
+		{
+			desc:   "burn from an address",
+			op:     cli.BatchOperation{Type: cli.BatchOpBurn, Amount: amount.String(), Address: batchRecipient},
+			expMsg: types.NewMsgBurnFrom(batchSender, amount, batchRecipient),
+		},
+		{
+			desc: "mint with a reference id",
+			op:   cli.BatchOperation{Type: cli.BatchOpMint, Amount: amount.String(), ReferenceID: "wire-1"},
+			expMsg: func() sdk.Msg {
+				msg := types.NewMsgMintTo(batchSender, amount, "")
+				msg.ReferenceId = "wire-1"
+				return msg
+			}(),
+		},
+		{
+			desc:   "change admin",
+			op:     cli.BatchOperation{Type: cli.BatchOpChangeAdmin, Denom: batchDenom, Address: batchRecipient},
+			expMsg: types.NewMsgChangeAdmin(batchSender, batchDenom, batchRecipient),
+		},
+		{
+			desc:   "force transfer",
+			op:     cli.BatchOperation{Type: cli.BatchOpForceTransfer, Amount: amount.String(), Address: batchRecipient, ToAddress: batchSender},
+			expMsg: types.NewMsgForceTransfer(batchSender, amount, batchRecipient, batchSender),
+		},
+		{
+			desc:   "unknown type",
+			op:     cli.BatchOperation{Type: "transfer", Amount: amount.String()},
+			expErr: `unknown operation type "transfer"`,
+		},
+		{
+			desc:   "invalid amount",
+			op:     cli.BatchOperation{Type: cli.BatchOpMint, Amount: "a lot"},
+			expErr: `invalid amount "a lot"`,
+		},
+		{
+			desc:   "invalid address",
+			op:     cli.BatchOperation{Type: cli.BatchOpMint, Amount: amount.String(), Address: "cosmos1invalid"},
+			expErr: "Invalid mint to address",
+		},
+		{
+			desc:   "change admin without new admin",
+			op:     cli.BatchOperation{Type: cli.BatchOpChangeAdmin, Denom: batchDenom},
+			expErr: "invalid address",
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			msg, err := tc.op.Msg(batchSender)
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expMsg, msg)
+		})
+	}
+}
+
+func TestPackBatch(t *testing.T) {
+	for _, tc := range []struct {
+		desc         string
+		gasEstimates []uint64
+		maxTxGas     uint64
+		expTxs       [][]int
+		expErr       string
+	}{
+		{
+			desc:         "single tx",
+			gasEstimates: []uint64{100, 100, 100},
+			maxTxGas:     300,
+			expTxs:       [][]int{{0, 1, 2}},
+		},
+		{
+			desc:         "split keeping the order",
+			gasEstimates: []uint64{200, 200, 50, 300, 100},
+			maxTxGas:     450,
+			expTxs:       [][]int{{0, 1, 2}, {3, 4}},
+		},
+		{
+			desc:         "one message per tx",
+			gasEstimates: []uint64{300, 300},
+			maxTxGas:     300,
+			expTxs:       [][]int{{0}, {1}},
+		},
+		{
+			desc:         "message over the max gas",
+			gasEstimates: []uint64{100, 500},
+			maxTxGas:     300,
+			expErr:       "message 1 needs 500 gas",
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			txs, err := cli.PackBatch(tc.gasEstimates, tc.maxTxGas)
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expTxs, txs)
+		})
+	}
+}
@@ -0,0 +1,284 @@
+package cli
+
+import (
+	"encoding/csv"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/cosmos/tokenfactory/x/tokenfactory/types"
+
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+const FlagExpiry = "expiry"
+
+// ClaimTree is the merkle tree of a claim campaign, printed by the claim-merkle-tree command.
+type ClaimTree struct {
+	// MerkleRoot is the hex encoded root of the tree
+	MerkleRoot string       `json:"merkle_root"`
+	TotalCap   sdkmath.Int  `json:"total_cap"`
+	Claims     []ClaimEntry `json:"claims"`
+}
+
+// ClaimEntry is the claim of an address, with the hex encoded hashes of its proof.
+type ClaimEntry struct {
+	Address string      `json:"address"`
+	Amount  sdkmath.Int `json:"amount"`
+	Proof   []string    `json:"proof"`
+}
+
+// BuildClaimTree returns the merkle tree of the claims, in the order of the claims. The total
+// cap is the sum of the claims.
+func BuildClaimTree(claims []types.MultiMintOutput) (ClaimTree, error) {
+	if len(claims) == 0 {
+		return ClaimTree{}, errors.New("no claims")
+	}
+
+	seen := map[string]bool{}
+	totalCap := sdkmath.ZeroInt()
+	leaves := make([][]byte, 0, len(claims))
+	for _, claim := range claims {
+		if _, err := sdk.AccAddressFromBech32(claim.Address); err != nil {
+			return ClaimTree{}, fmt.Errorf("invalid address %q: %w", claim.Address, err)
+		}
+		if seen[claim.Address] {
+			return ClaimTree{}, fmt.Errorf("duplicate address %s", claim.Address)
+		}
+		seen[claim.Address] = true
+		if !claim.Amount.IsPositive() {
+			return ClaimTree{}, fmt.Errorf("amount of %s must be positive", claim.Address)
+		}
+
+		totalCap = totalCap.Add(claim.Amount)
+		leaves = append(leaves, types.ClaimLeafHash(claim.Address, claim.Amount))
+	}
+
+	root, proofs := types.BuildClaimMerkleTree(leaves)
+	tree := ClaimTree{
+		MerkleRoot: hex.EncodeToString(root),
+		TotalCap:   totalCap,
+		Claims:     make([]ClaimEntry, 0, len(claims)),
+	}
+	for i, claim := range claims {
+		proof := make([]string, 0, len(proofs[i]))
+		for _, sibling := range proofs[i] {
+			proof = append(proof, hex.EncodeToString(sibling))
+		}
+		tree.Claims = append(tree.Claims, ClaimEntry{Address: claim.Address, Amount: claim.Amount, Proof: proof})
+	}
+
+	return tree, nil
+}
+
+// parseClaimsCSV parses address,amount rows, without header.
+func parseClaimsCSV(r io.Reader) ([]types.MultiMintOutput, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = 2
+	reader.TrimLeadingSpace = true
+
+	claims := []types.MultiMintOutput{}
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return claims, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		amount, ok := sdkmath.NewIntFromString(record[1])
+		if !ok {
+			return nil, fmt.Errorf("invalid amount %q for %s", record[1], record[0])
+		}
+		claims = append(claims, types.MultiMintOutput{Address: record[0], Amount: amount})
+	}
+}
+
+// ParseClaimProof parses the comma separated hex hashes of a claim proof.
+func ParseClaimProof(arg string) ([][]byte, error) {
+	proof := [][]byte{}
+	if arg == "" {
+		return proof, nil
+	}
+
+	for _, hash := range strings.Split(arg, ",") {
+		sibling, err := hex.DecodeString(strings.TrimSpace(hash))
+		if err != nil {
+			return nil, fmt.Errorf("invalid proof hash %q: %w", hash, err)
+		}
+		proof = append(proof, sibling)
+	}
+	return proof, nil
+}
+
+// NewClaimMerkleTreeCmd prints the merkle tree of a claim campaign, it does not broadcast
+func NewClaimMerkleTreeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "claim-merkle-tree [csv-file]",
+		Short: "Print the merkle root and the proofs of a claim campaign from a CSV file of address,amount rows",
+		Long: `Print the merkle root and the proofs of a claim campaign from a CSV file of address,amount rows.
+The output is JSON, with the merkle root and the total cap to create the campaign and the proof of every address to claim.
+Nothing is broadcast.`,
+		Example: fmt.Sprintf("%s tx %s claim-merkle-tree airdrop.csv > airdrop.json", version.AppName, types.ModuleName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			f, err := os.Open(args[0])
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+
+			claims, err := parseClaimsCSV(f)
+			if err != nil {
+				return err
+			}
+
+			tree, err := BuildClaimTree(claims)
+			if err != nil {
+				return err
+			}
+
+			bz, err := json.Marshal(tree)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintRaw(bz)
+		},
+	}
+
+	return cmd
+}
+
+// NewCreateClaimCampaignCmd broadcast MsgCreateClaimCampaign
+func NewCreateClaimCampaignCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-claim-campaign [denom] [merkle-root-hex] [total-cap] [flags]",
+		Short: "Create a claim campaign of a denom from the merkle root of its claims. Must have admin authority to do so.",
+		Example: fmt.Sprintf(
+			"%s tx %s create-claim-campaign factory/cosmos1.../bitcoin 3f5a... 1000000 --expiry 2030-01-01T00:00:00Z --from admin",
+			version.AppName, types.ModuleName,
+		),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+			txf = txf.WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			merkleRoot, err := hex.DecodeString(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid merkle root: %w", err)
+			}
+
+			totalCap, ok := sdkmath.NewIntFromString(args[2])
+			if !ok {
+				return fmt.Errorf("invalid total cap %q", args[2])
+			}
+
+			var expiry *time.Time
+			expiryStr, err := cmd.Flags().GetString(FlagExpiry)
+			if err != nil {
+				return err
+			}
+			if expiryStr != "" {
+				t, err := time.Parse(time.RFC3339, expiryStr)
+				if err != nil {
+					return fmt.Errorf("invalid expiry: %w", err)
+				}
+				expiry = &t
+			}
+
+			msg := types.NewMsgCreateClaimCampaign(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				merkleRoot,
+				totalCap,
+				expiry,
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	cmd.Flags().String(FlagExpiry, "", "RFC3339 time after which no more claims are accepted, the campaign never expires when empty")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewClaimCmd broadcast MsgClaim
+func NewClaimCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "claim [campaign-id] [amount] [proof-hex,...] [flags]",
+		Short: "Claim the amount of the sender in a claim campaign, with the comma separated hashes of its merkle proof",
+		Example: fmt.Sprintf(
+			"%s tx %s claim 1 1000 9c1e...,07ab... --from claimer",
+			version.AppName, types.ModuleName,
+		),
+		Args: cobra.RangeArgs(2, 3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+			txf = txf.WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			campaignID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid campaign id: %w", err)
+			}
+
+			amount, ok := sdkmath.NewIntFromString(args[1])
+			if !ok {
+				return fmt.Errorf("invalid amount %q", args[1])
+			}
+
+			var proof [][]byte
+			if len(args) == 3 {
+				proof, err = ParseClaimProof(args[2])
+				if err != nil {
+					return err
+				}
+			}
+
+			msg := types.NewMsgClaim(
+				clientCtx.GetFromAddress().String(),
+				campaignID,
+				amount,
+				proof,
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
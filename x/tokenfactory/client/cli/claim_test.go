@@ -0,0 +1,53 @@
+package cli_test
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/tokenfactory/x/tokenfactory/client/cli"
+	"github.com/cosmos/tokenfactory/x/tokenfactory/types"
+
+	sdkmath "cosmossdk.io/math"
+)
+
+func TestBuildClaimTree(t *testing.T) {
+	claims := []types.MultiMintOutput{
+		{Address: batchSender, Amount: sdkmath.NewInt(100)},
+		{Address: batchRecipient, Amount: sdkmath.NewInt(250)},
+	}
+
+	tree, err := cli.BuildClaimTree(claims)
+	require.NoError(t, err)
+	require.Equal(t, sdkmath.NewInt(350), tree.TotalCap)
+	require.Len(t, tree.Claims, 2)
+
+	root, err := hex.DecodeString(tree.MerkleRoot)
+	require.NoError(t, err)
+	for _, claim := range tree.Claims {
+		proof, err := cli.ParseClaimProof(strings.Join(claim.Proof, ","))
+		require.NoError(t, err)
+		require.True(t, types.VerifyClaimProof(root, types.ClaimLeafHash(claim.Address, claim.Amount), proof))
+	}
+
+	_, err = cli.BuildClaimTree(append(claims, claims[0]))
+	require.ErrorContains(t, err, "duplicate address")
+
+	_, err = cli.BuildClaimTree([]types.MultiMintOutput{{Address: batchSender, Amount: sdkmath.ZeroInt()}})
+	require.ErrorContains(t, err, "must be positive")
+}
+
+func TestParseClaimProof(t *testing.T) {
+	proof, err := cli.ParseClaimProof("0a0b, 0c")
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{{0x0a, 0x0b}, {0x0c}}, proof)
+
+	proof, err = cli.ParseClaimProof("")
+	require.NoError(t, err)
+	require.Empty(t, proof)
+
+	_, err = cli.ParseClaimProof("0a,zz")
+	require.ErrorContains(t, err, `invalid proof hash "zz"`)
+}
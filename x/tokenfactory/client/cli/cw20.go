@@ -0,0 +1,56 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/cosmos/tokenfactory/x/tokenfactory/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+// NewWithdrawCW20Cmd broadcast MsgWithdrawCW20
+func NewWithdrawCW20Cmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "withdraw-cw20 [amount] [flags]",
+		Short: "Burn tokens of a denom bound to a cw20 contract, and receive the same amount of cw20 tokens.",
+		Long: `Burn tokens of a denom bound to a cw20 contract, and receive the same amount of cw20 tokens from the bridge.
+The cw20 tokens are deposited by sending them to the bridge address with the Send message of the contract.`,
+		Example: fmt.Sprintf(
+			"%s tx %s withdraw-cw20 1000factory/cosmos1.../wrapped --from holder",
+			version.AppName, types.ModuleName,
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+			txf = txf.WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			amount, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgWithdrawCW20(
+				clientCtx.GetFromAddress().String(),
+				amount,
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
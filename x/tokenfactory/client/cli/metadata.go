@@ -0,0 +1,34 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+
+	"sigs.k8s.io/yaml"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// ParseMetadataFile reads a bank metadata from a JSON or YAML file, in the format printed by
+// the get-metadata query.
+func ParseMetadataFile(cdc codec.JSONCodec, path string) (banktypes.Metadata, error) {
+	var metadata banktypes.Metadata
+
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		return metadata, err
+	}
+
+	// JSON is valid YAML, so both formats go through the YAML conversion
+	bz, err := yaml.YAMLToJSON(contents)
+	if err != nil {
+		return metadata, fmt.Errorf("failed to parse metadata file %s: %w", path, err)
+	}
+
+	if err := cdc.UnmarshalJSON(bz, &metadata); err != nil {
+		return metadata, fmt.Errorf("failed to parse metadata file %s: %w", path, err)
+	}
+
+	return metadata, nil
+}
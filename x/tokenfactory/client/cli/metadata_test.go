@@ -0,0 +1,69 @@
+package cli_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"sigs.k8s.io/yaml"
+
+	"github.com/cosmos/tokenfactory/x/tokenfactory/client/cli"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestParseMetadataFile(t *testing.T) {
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	base := "factory/cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewc9m7z44/mytoken"
+	metadata := banktypes.Metadata{
+		Description: "My token",
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: base, Exponent: 0, Aliases: []string{"umyt"}},
+			{Denom: "mmyt", Exponent: 3, Aliases: []string{}},
+			{Denom: "MYT", Exponent: 6, Aliases: []string{"mytoken"}},
+		},
+		Base:    base,
+		Display: "MYT",
+		Name:    "My Token",
+		Symbol:  "MYT",
+		URI:     "https://example.com/mytoken.png",
+		URIHash: "b9a4d8e1f5c2",
+	}
+
+	// the get-metadata query prints the codec JSON, converted to YAML for the text output
+	jsonBz, err := cdc.MarshalJSON(&metadata)
+	require.NoError(t, err)
+	yamlBz, err := yaml.JSONToYAML(jsonBz)
+	require.NoError(t, err)
+
+	dir := t.TempDir()
+	for _, tc := range []struct {
+		name     string
+		contents []byte
+		expErr   string
+	}{
+		{name: "metadata.json", contents: jsonBz},
+		{name: "metadata.yaml", contents: yamlBz},
+		{name: "unknown_field.json", contents: []byte(`{"bse": "factory/creator/mytoken"}`), expErr: `unknown field "bse"`},
+		{name: "invalid.yaml", contents: []byte("base: [factory"), expErr: "failed to parse metadata file"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			path := filepath.Join(dir, tc.name)
+			require.NoError(t, os.WriteFile(path, tc.contents, 0o600))
+
+			parsed, err := cli.ParseMetadataFile(cdc, path)
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, metadata, parsed)
+		})
+	}
+
+	_, err = cli.ParseMetadataFile(cdc, filepath.Join(dir, "missing.json"))
+	require.Error(t, err)
+}
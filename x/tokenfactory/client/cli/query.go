@@ -8,9 +8,10 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
-// GetQueryCmd returns the cli query commands for this module
+// GetQueryCmd returns the cli query commands for this module, the other queries are generated by autocli
 func GetQueryCmd() *cobra.Command {
 	// Group tokenfactory queries under a subcommand
 	cmd := &cobra.Command{
@@ -22,120 +23,33 @@ func GetQueryCmd() *cobra.Command {
 	}
 
 	cmd.AddCommand(
-		GetParams(),
-		GetCmdDenomAuthorityMetadata(),
-		GetCmdDenomsFromCreator(),
-		GetCmdDenomsFromAdmin(),
+		GetCmdMetadata(),
 	)
 
 	return cmd
 }
 
-// GetParams returns the params for the module
-func GetParams() *cobra.Command {
+// GetCmdMetadata returns the bank metadata of a denom, in the format accepted by modify-metadata --metadata-file
+func GetCmdMetadata() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "params [flags]",
-		Short: "Get the params for the x/tokenfactory module",
-		Args:  cobra.ExactArgs(0),
-		RunE: func(cmd *cobra.Command, _ []string) error {
-			clientCtx, err := client.GetClientQueryContext(cmd)
-			if err != nil {
-				return err
-			}
-			queryClient := types.NewQueryClient(clientCtx)
-
-			res, err := queryClient.Params(cmd.Context(), &types.QueryParamsRequest{})
-			if err != nil {
-				return err
-			}
-
-			return clientCtx.PrintProto(res)
-		},
-	}
-
-	flags.AddQueryFlagsToCmd(cmd)
-
-	return cmd
-}
-
-// GetCmdDenomAuthorityMetadata returns the authority metadata for a queried denom
-func GetCmdDenomAuthorityMetadata() *cobra.Command {
-	cmd := &cobra.Command{
-		Use:   "denom-authority-metadata [denom] [flags]",
-		Short: "Get the authority metadata for a specific denom",
+		Use:   "get-metadata [denom] [flags]",
+		Short: "Get the bank metadata of a denom, in the format of the modify-metadata --metadata-file flag",
 		Args:  cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientQueryContext(cmd)
 			if err != nil {
 				return err
 			}
-			queryClient := types.NewQueryClient(clientCtx)
+			queryClient := banktypes.NewQueryClient(clientCtx)
 
-			res, err := queryClient.DenomAuthorityMetadata(cmd.Context(), &types.QueryDenomAuthorityMetadataRequest{
+			res, err := queryClient.DenomMetadata(cmd.Context(), &banktypes.QueryDenomMetadataRequest{
 				Denom: args[0],
 			})
 			if err != nil {
 				return err
 			}
 
-			return clientCtx.PrintProto(res)
-		},
-	}
-
-	flags.AddQueryFlagsToCmd(cmd)
-
-	return cmd
-}
-
-// GetCmdDenomsFromCreator a command to get a list of all tokens created by a specific creator address
-func GetCmdDenomsFromCreator() *cobra.Command {
-	cmd := &cobra.Command{
-		Use:   "denoms-from-creator [creator address] [flags]",
-		Short: "Returns a list of all tokens created by a specific creator address",
-		Args:  cobra.ExactArgs(1),
-		RunE: func(cmd *cobra.Command, args []string) error {
-			clientCtx, err := client.GetClientQueryContext(cmd)
-			if err != nil {
-				return err
-			}
-			queryClient := types.NewQueryClient(clientCtx)
-
-			res, err := queryClient.DenomsFromCreator(cmd.Context(), &types.QueryDenomsFromCreatorRequest{
-				Creator: args[0],
-			})
-			if err != nil {
-				return err
-			}
-
-			return clientCtx.PrintProto(res)
-		},
-	}
-
-	flags.AddQueryFlagsToCmd(cmd)
-
-	return cmd
-}
-
-func GetCmdDenomsFromAdmin() *cobra.Command {
-	cmd := &cobra.Command{
-		Use:   "denoms-from-admin [admin address] [flags]",
-		Short: "Returns a list of all tokens owned by a specific admin address",
-		Args:  cobra.ExactArgs(1),
-		RunE: func(cmd *cobra.Command, args []string) error {
-			clientCtx, err := client.GetClientQueryContext(cmd)
-			if err != nil {
-				return err
-			}
-			queryClient := types.NewQueryClient(clientCtx)
-
-			res, err := queryClient.DenomsFromAdmin(cmd.Context(), &types.QueryDenomsFromAdminRequest{
-				Admin: args[0],
-			})
-			if err != nil {
-				return err
-			}
-
-			return clientCtx.PrintProto(res)
+			return clientCtx.PrintProto(&res.Metadata)
 		},
 	}
 
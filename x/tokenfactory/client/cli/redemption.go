@@ -0,0 +1,58 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/cosmos/tokenfactory/x/tokenfactory/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+// NewRequestRedemptionCmd broadcast MsgRequestRedemption
+func NewRequestRedemptionCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "request-redemption [amount] [payout-reference] [flags]",
+		Short: "Escrow factory tokens and ask the admin of their denom to redeem them off-chain.",
+		Long: `Escrow factory tokens in the module account and ask the admin of their denom to redeem them off-chain,
+to the payout reference such as a bank account reference. The admin burns the tokens once paid out, or returns
+them when rejecting the request. They are returned as well if the request times out.`,
+		Example: fmt.Sprintf(
+			"%s tx %s request-redemption 1000factory/cosmos1.../usd IBAN:DE89370400440532013000 --from holder",
+			version.AppName, types.ModuleName,
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+			txf = txf.WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			amount, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRequestRedemption(
+				clientCtx.GetFromAddress().String(),
+				amount,
+				args[1],
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
@@ -0,0 +1,171 @@
+package cli
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/cosmos/tokenfactory/x/tokenfactory/types"
+
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+// ParseReportHash returns the report hash of a reserve attestation from a hex encoded sha256
+// hash, or from the path of the report file, which is then hashed.
+func ParseReportHash(report string) ([]byte, error) {
+	if len(report) == hex.EncodedLen(types.ReportHashLength) {
+		if hash, err := hex.DecodeString(report); err == nil {
+			return hash, nil
+		}
+	}
+
+	bz, err := os.ReadFile(report)
+	if err != nil {
+		return nil, fmt.Errorf("report must be a hex encoded sha256 hash or a file: %w", err)
+	}
+
+	hash := sha256.Sum256(bz)
+	return hash[:], nil
+}
+
+// NewSetReserveAttestorCmd broadcast MsgSetReserveAttestor
+func NewSetReserveAttestorCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-reserve-attestor [denom] [attestor-address] [max-staleness] [flags]",
+		Short: "Sets the address attesting the reserves of a denom, mints can then not exceed the latest attested reserve. Must have admin authority to do so.",
+		Long: `Sets the address attesting the reserves of a denom with attest-reserve. The mints of the denom are then
+rejected if they would push its supply above the latest attested reserve, or if the latest attestation is older
+than max-staleness, a duration such as 24h.`,
+		Example: fmt.Sprintf(
+			"%s tx %s set-reserve-attestor factory/cosmos1.../usd cosmos1...auditor... 24h --from admin",
+			version.AppName, types.ModuleName,
+		),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+			txf = txf.WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			maxStaleness, err := time.ParseDuration(args[2])
+			if err != nil {
+				return fmt.Errorf("invalid max staleness: %w", err)
+			}
+
+			msg := types.NewMsgSetReserveAttestor(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				args[1],
+				maxStaleness,
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewRemoveReserveAttestorCmd broadcast MsgSetReserveAttestor without an attestor
+func NewRemoveReserveAttestorCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-reserve-attestor [denom] [flags]",
+		Short: "Removes the reserve attestor of a denom, its mints are no longer capped by the attested reserve. Must have admin authority to do so.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+			txf = txf.WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			msg := types.NewMsgSetReserveAttestor(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				"",
+				0,
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewAttestReserveCmd broadcast MsgAttestReserve
+func NewAttestReserveCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "attest-reserve [denom] [amount] [timestamp] [report] [flags]",
+		Short: "Attest the reserve backing a denom. Must be the reserve attestor of the denom to do so.",
+		Long: `Attest the reserve backing a denom, in base units of the denom. The timestamp is the RFC3339 time of the
+reserve report, it can not be after the block time nor before the latest attestation. The report is the hex
+encoded sha256 hash of the report, or the path of the report file to hash.`,
+		Example: fmt.Sprintf(
+			"%s tx %s attest-reserve factory/cosmos1.../usd 1000000000 2024-06-30T00:00:00Z report.pdf --from auditor",
+			version.AppName, types.ModuleName,
+		),
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+			txf = txf.WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			amount, ok := sdkmath.NewIntFromString(args[1])
+			if !ok {
+				return fmt.Errorf("invalid amount %q", args[1])
+			}
+
+			timestamp, err := time.Parse(time.RFC3339, args[2])
+			if err != nil {
+				return fmt.Errorf("invalid timestamp: %w", err)
+			}
+
+			reportHash, err := ParseReportHash(args[3])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgAttestReserve(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				amount,
+				timestamp.UTC(),
+				reportHash,
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
@@ -0,0 +1,33 @@
+package cli_test
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/tokenfactory/x/tokenfactory/client/cli"
+)
+
+func TestParseReportHash(t *testing.T) {
+	report := []byte("reserve report")
+	expected := sha256.Sum256(report)
+
+	// a hex encoded hash is used as is
+	hash, err := cli.ParseReportHash(hex.EncodeToString(expected[:]))
+	require.NoError(t, err)
+	require.Equal(t, expected[:], hash)
+
+	// a report file is hashed
+	path := filepath.Join(t.TempDir(), "report.pdf")
+	require.NoError(t, os.WriteFile(path, report, 0o600))
+	hash, err = cli.ParseReportHash(path)
+	require.NoError(t, err)
+	require.Equal(t, expected[:], hash)
+
+	_, err = cli.ParseReportHash(filepath.Join(t.TempDir(), "missing.pdf"))
+	require.ErrorContains(t, err, "report must be a hex encoded sha256 hash or a file")
+}
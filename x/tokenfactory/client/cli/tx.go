@@ -8,13 +8,24 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/cosmos/tokenfactory/x/tokenfactory/types"
 
+	sdkmath "cosmossdk.io/math"
+
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
+const (
+	FlagHookContract = "hook-contract"
+	FlagHookStrict   = "hook-strict"
+	FlagStrict       = "strict"
+	FlagMetadataFile = "metadata-file"
+	FlagReferenceID  = "reference-id"
+)
+
 // GetTxCmd returns the transaction commands for this module
 func GetTxCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -31,9 +42,29 @@ func GetTxCmd() *cobra.Command {
 		NewMintToCmd(),
 		NewBurnCmd(),
 		NewBurnFromCmd(),
+		NewMultiMintCmd(),
+		NewMultiBurnCmd(),
+		NewCreateClaimCampaignCmd(),
+		NewClaimCmd(),
+		NewClaimMerkleTreeCmd(),
+		NewSetVoucherSignerCmd(),
+		NewRemoveVoucherSignerCmd(),
+		NewSignMintVoucherCmd(),
+		NewRedeemMintVoucherCmd(),
+		NewSetReserveAttestorCmd(),
+		NewRemoveReserveAttestorCmd(),
+		NewAttestReserveCmd(),
+		NewRequestRedemptionCmd(),
+		NewWithdrawCW20Cmd(),
+		NewCreateBasketCmd(),
+		NewSetBasketCompositionCmd(),
+		NewMintVestingCmd(),
 		NewForceTransferCmd(),
-		NewChangeAdminCmd(),
 		NewModifyDenomMetadataCmd(),
+		NewSetDenomHookCmd(),
+		NewRemoveDenomHookCmd(),
+		NewRemoveBeforeSendHookCmd(),
+		NewBatchCmd(),
 	)
 
 	return cmd
@@ -63,10 +94,29 @@ func NewCreateDenomCmd() *cobra.Command {
 				args[0],
 			)
 
+			hookContract, err := cmd.Flags().GetString(FlagHookContract)
+			if err != nil {
+				return err
+			}
+
+			if hookContract != "" {
+				strict, err := cmd.Flags().GetBool(FlagHookStrict)
+				if err != nil {
+					return err
+				}
+
+				msg.DenomHook = &types.DenomHook{
+					ContractAddress: hookContract,
+					Strict:          strict,
+				}
+			}
+
 			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
 		},
 	}
 
+	cmd.Flags().String(FlagHookContract, "", "Address of the contract called on the lifecycle events of the denom")
+	cmd.Flags().Bool(FlagHookStrict, false, "Abort the operation when the hook contract call fails")
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
@@ -99,11 +149,16 @@ func NewMintCmd() *cobra.Command {
 				clientCtx.GetFromAddress().String(),
 				amount,
 			)
+			msg.ReferenceId, err = cmd.Flags().GetString(FlagReferenceID)
+			if err != nil {
+				return err
+			}
 
 			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
 		},
 	}
 
+	cmd.Flags().String(FlagReferenceID, "", "Optional external reference of the mint, rejected if it has already been used for the denom")
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
@@ -141,11 +196,16 @@ func NewMintToCmd() *cobra.Command {
 				amount,
 				toAddr.String(),
 			)
+			msg.ReferenceId, err = cmd.Flags().GetString(FlagReferenceID)
+			if err != nil {
+				return err
+			}
 
 			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
 		},
 	}
 
+	cmd.Flags().String(FlagReferenceID, "", "Optional external reference of the mint, rejected if it has already been used for the denom")
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
@@ -177,11 +237,16 @@ func NewBurnCmd() *cobra.Command {
 				clientCtx.GetFromAddress().String(),
 				amount,
 			)
+			msg.ReferenceId, err = cmd.Flags().GetString(FlagReferenceID)
+			if err != nil {
+				return err
+			}
 
 			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
 		},
 	}
 
+	cmd.Flags().String(FlagReferenceID, "", "Optional external reference of the burn, rejected if it has already been used for the denom")
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
@@ -219,15 +284,127 @@ func NewBurnFromCmd() *cobra.Command {
 				amount,
 				fromAddr.String(),
 			)
+			msg.ReferenceId, err = cmd.Flags().GetString(FlagReferenceID)
+			if err != nil {
+				return err
+			}
 
 			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
 		},
 	}
 
+	cmd.Flags().String(FlagReferenceID, "", "Optional external reference of the burn, rejected if it has already been used for the denom")
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
 
+// NewMultiMintCmd broadcast MsgMultiMint
+func NewMultiMintCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "multi-mint [denom] [address:amount]... [flags]",
+		Short: "Mint a denom to several addresses at once. Must have admin authority to do so.",
+		Example: fmt.Sprintf(
+			"%s tx %s multi-mint factory/cosmos1.../bitcoin cosmos1...:100 cosmos1...:250 --from admin",
+			version.AppName, types.ModuleName,
+		),
+		Args: cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+			txf = txf.WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			outputs, err := ParseAddressAmounts(args[1:])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgMultiMint(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				outputs,
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewMultiBurnCmd broadcast MsgMultiBurn
+func NewMultiBurnCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "multi-burn [denom] [address:amount]... [flags]",
+		Short: "Burn a denom from several addresses at once. Must have admin authority to do so.",
+		Example: fmt.Sprintf(
+			"%s tx %s multi-burn factory/cosmos1.../bitcoin cosmos1...:100 cosmos1...:250 --from admin",
+			version.AppName, types.ModuleName,
+		),
+		Args: cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+			txf = txf.WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			outputs, err := ParseAddressAmounts(args[1:])
+			if err != nil {
+				return err
+			}
+
+			inputs := make([]types.MultiBurnInput, 0, len(outputs))
+			for _, output := range outputs {
+				inputs = append(inputs, types.MultiBurnInput(output))
+			}
+
+			msg := types.NewMsgMultiBurn(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				inputs,
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// ParseAddressAmounts parses the address:amount arguments of the multi-mint and multi-burn commands.
+func ParseAddressAmounts(args []string) ([]types.MultiMintOutput, error) {
+	outputs := make([]types.MultiMintOutput, 0, len(args))
+	for _, arg := range args {
+		address, amountStr, found := strings.Cut(arg, ":")
+		if !found {
+			return nil, fmt.Errorf("invalid argument %q, expected address:amount", arg)
+		}
+
+		amount, ok := sdkmath.NewIntFromString(amountStr)
+		if !ok {
+			return nil, fmt.Errorf("invalid amount %q for %s", amountStr, address)
+		}
+
+		outputs = append(outputs, types.MultiMintOutput{Address: address, Amount: amount})
+	}
+
+	return outputs, nil
+}
+
 // NewForceTransferCmd broadcast MsgForceTransfer
 func NewForceTransferCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -266,12 +443,33 @@ func NewForceTransferCmd() *cobra.Command {
 	return cmd
 }
 
-// NewChangeAdminCmd broadcast MsgChangeAdmin
-func NewChangeAdminCmd() *cobra.Command {
+// NewModifyDenomMetadataCmd broadcast a Bank Metadata modification transaction
+func NewModifyDenomMetadataCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "change-admin [denom] [new-admin-address] [flags]",
-		Short: "Changes the admin address for a factory-created denom. Must have admin authority to do so.",
-		Args:  cobra.ExactArgs(2),
+		Use:   "modify-metadata [denom] [ticker-symbol] [description] [exponent] [flags]",
+		Short: "Changes the base data for frontends to query the data of.",
+		Long: `Changes the base data for frontends to query the data of.
+
+The positional arguments create a metadata with the base denom and a single display unit.
+A full bank metadata, with aliases, uri, uri_hash, name or more display units, can be read
+from a JSON or YAML file with --metadata-file instead, in the format of the get-metadata query.`,
+		Example: fmt.Sprintf(`%[1]s tx tokenfactory modify-metadata factory/cosmos1...addr.../mytoken MYT "My token" 6 --from=mykey
+%[1]s tx tokenfactory modify-metadata --metadata-file=metadata.json --from=mykey`, version.AppName),
+		Args: func(cmd *cobra.Command, args []string) error {
+			metadataFile, err := cmd.Flags().GetString(FlagMetadataFile)
+			if err != nil {
+				return err
+			}
+
+			if metadataFile != "" {
+				if len(args) != 0 {
+					return fmt.Errorf("positional arguments cannot be used with --%s", FlagMetadataFile)
+				}
+				return nil
+			}
+
+			return cobra.ExactArgs(4)(cmd, args)
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -284,26 +482,85 @@ func NewChangeAdminCmd() *cobra.Command {
 			}
 			txf = txf.WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
 
-			msg := types.NewMsgChangeAdmin(
+			metadataFile, err := cmd.Flags().GetString(FlagMetadataFile)
+			if err != nil {
+				return err
+			}
+
+			var bankMetadata banktypes.Metadata
+			if metadataFile != "" {
+				bankMetadata, err = ParseMetadataFile(clientCtx.Codec, metadataFile)
+			} else {
+				bankMetadata, err = newMetadataFromArgs(args)
+			}
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetDenomMetadata(
 				clientCtx.GetFromAddress().String(),
-				args[0],
-				args[1],
+				bankMetadata,
 			)
 
+			// validate before broadcasting so an invalid file is reported without signing
+			if err := msg.ValidateBasic(); err != nil {
+				return fmt.Errorf("invalid metadata: %w", err)
+			}
+
 			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
 		},
 	}
 
+	cmd.Flags().String(FlagMetadataFile, "", "Path to a JSON or YAML file with the full bank metadata, replaces the positional arguments")
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
 
-// NewModifyDenomMetadataCmd broadcast a Bank Metadata modification transaction
-func NewModifyDenomMetadataCmd() *cobra.Command {
+// newMetadataFromArgs builds a two unit metadata from the modify-metadata positional arguments
+func newMetadataFromArgs(args []string) (banktypes.Metadata, error) {
+	fullDenom, ticker, desc := args[0], strings.ToUpper(args[1]), args[2]
+
+	if !strings.HasPrefix(fullDenom, "factory/") {
+		return banktypes.Metadata{}, fmt.Errorf("denom must start with factory/")
+	}
+
+	if len(ticker) == 0 {
+		return banktypes.Metadata{}, fmt.Errorf("ticker cannot be empty")
+	}
+
+	// Exponent Checks
+	exponent, err := strconv.ParseUint(args[3], 10, 32)
+	if err != nil {
+		return banktypes.Metadata{}, err
+	}
+
+	return banktypes.Metadata{
+		Description: desc,
+		Display:     ticker,
+		Symbol:      ticker,
+		Name:        fullDenom,
+		DenomUnits: []*banktypes.DenomUnit{
+			{
+				Denom:    fullDenom,
+				Exponent: 0, // must be 0 for the base denom
+				Aliases:  []string{ticker},
+			},
+			{
+				Denom:    ticker,
+				Exponent: uint32(exponent),
+				Aliases:  []string{fullDenom},
+			},
+		},
+		Base: fullDenom,
+	}, nil
+}
+
+// NewSetDenomHookCmd broadcast MsgSetDenomHook
+func NewSetDenomHookCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "modify-metadata [denom] [ticker-symbol] [description] [exponent] [flags]",
-		Short: "Changes the base data for frontends to query the data of.",
-		Args:  cobra.ExactArgs(4),
+		Use:   "set-denom-hook [denom] [contract-address] [flags]",
+		Short: "Sets the contract called on the lifecycle events of a denom. Must have admin authority to do so.",
+		Args:  cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -316,45 +573,83 @@ func NewModifyDenomMetadataCmd() *cobra.Command {
 			}
 			txf = txf.WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
 
-			fullDenom, ticker, desc := args[0], strings.ToUpper(args[1]), args[2]
+			strict, err := cmd.Flags().GetBool(FlagStrict)
+			if err != nil {
+				return err
+			}
 
-			if !strings.HasPrefix(fullDenom, "factory/") {
-				return fmt.Errorf("denom must start with factory/")
+			msg := types.NewMsgSetDenomHook(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				&types.DenomHook{
+					ContractAddress: args[1],
+					Strict:          strict,
+				},
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	cmd.Flags().Bool(FlagStrict, false, "Abort the operation when the hook contract call fails")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewRemoveDenomHookCmd broadcast MsgSetDenomHook without a hook
+func NewRemoveDenomHookCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-denom-hook [denom] [flags]",
+		Short: "Removes the hook contract of a denom. Must have admin authority to do so.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
 			}
 
-			if len(ticker) == 0 {
-				return fmt.Errorf("ticker cannot be empty")
+			txf, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
 			}
+			txf = txf.WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			msg := types.NewMsgSetDenomHook(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				nil,
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
 
-			// Exponent Checks
-			exponent, err := strconv.ParseUint(args[3], 10, 32)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewRemoveBeforeSendHookCmd broadcast MsgSetBeforeSendHook with an empty address
+func NewRemoveBeforeSendHookCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-before-send-hook [denom] [flags]",
+		Short: "Removes the before send hook contract of a denom. Must have admin authority to do so.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
 			}
 
-			bankMetadata := banktypes.Metadata{
-				Description: desc,
-				Display:     ticker,
-				Symbol:      ticker,
-				Name:        fullDenom,
-				DenomUnits: []*banktypes.DenomUnit{
-					{
-						Denom:    fullDenom,
-						Exponent: 0, // must be 0 for the base denom
-						Aliases:  []string{ticker},
-					},
-					{
-						Denom:    ticker,
-						Exponent: uint32(exponent),
-						Aliases:  []string{fullDenom},
-					},
-				},
-				Base: fullDenom,
+			txf, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
 			}
+			txf = txf.WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
 
-			msg := types.NewMsgSetDenomMetadata(
+			msg := types.NewMsgSetBeforeSendHook(
 				clientCtx.GetFromAddress().String(),
-				bankMetadata,
+				args[0],
+				"",
 			)
 
 			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
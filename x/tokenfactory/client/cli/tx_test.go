@@ -0,0 +1,50 @@
+package cli_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/tokenfactory/x/tokenfactory/client/cli"
+	"github.com/cosmos/tokenfactory/x/tokenfactory/types"
+
+	sdkmath "cosmossdk.io/math"
+)
+
+func TestParseAddressAmounts(t *testing.T) {
+	for _, tc := range []struct {
+		desc       string
+		args       []string
+		expOutputs []types.MultiMintOutput
+		expErr     string
+	}{
+		{
+			desc: "several addresses",
+			args: []string{batchSender + ":100", batchRecipient + ":250"},
+			expOutputs: []types.MultiMintOutput{
+				{Address: batchSender, Amount: sdkmath.NewInt(100)},
+				{Address: batchRecipient, Amount: sdkmath.NewInt(250)},
+			},
+		},
+		{
+			desc:   "missing amount",
+			args:   []string{batchSender},
+			expErr: "expected address:amount",
+		},
+		{
+			desc:   "invalid amount",
+			args:   []string{batchSender + ":100stake"},
+			expErr: `invalid amount "100stake"`,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			outputs, err := cli.ParseAddressAmounts(tc.args)
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expOutputs, outputs)
+		})
+	}
+}
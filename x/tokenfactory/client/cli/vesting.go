@@ -0,0 +1,135 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/cosmos/tokenfactory/x/tokenfactory/types"
+
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+const (
+	FlagStartTime = "start-time"
+	FlagEndTime   = "end-time"
+	FlagPeriods   = "periods"
+)
+
+// NewMintVestingCmd broadcast MsgMintVesting
+func NewMintVestingCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "mint-vesting [amount] [recipient] [flags]",
+		Short: "Mint tokens into a vesting schedule of the recipient. Must have admin authority to do so.",
+		Long: fmt.Sprintf(`Mint tokens into a vesting schedule of the recipient, whose unvested tokens are locked and can be
+clawed back by the admin. The schedule vests continuously until --%s, or by the --%s given as
+comma separated length:amount pairs, whose amounts add up to the minted amount.`, FlagEndTime, FlagPeriods),
+		Example: fmt.Sprintf(`%[1]s tx %[2]s mint-vesting 1000factory/cosmos1.../team cosmos1... --end-time 2027-01-01T00:00:00Z --from admin
+%[1]s tx %[2]s mint-vesting 1000factory/cosmos1.../team cosmos1... --periods 720h:500,720h:500 --from admin`,
+			version.AppName, types.ModuleName,
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+			txf = txf.WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			amount, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			startTime, err := parseTimeFlag(cmd, FlagStartTime)
+			if err != nil {
+				return err
+			}
+
+			endTime, err := parseTimeFlag(cmd, FlagEndTime)
+			if err != nil {
+				return err
+			}
+
+			periodsStr, err := cmd.Flags().GetString(FlagPeriods)
+			if err != nil {
+				return err
+			}
+			periods, err := parseVestingPeriods(periodsStr)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgMintVesting(
+				clientCtx.GetFromAddress().String(),
+				amount,
+				args[1],
+				startTime,
+				endTime,
+				periods,
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	cmd.Flags().String(FlagStartTime, "", "RFC3339 start time of the schedule, the block time when empty")
+	cmd.Flags().String(FlagEndTime, "", "RFC3339 end time of a continuous schedule")
+	cmd.Flags().String(FlagPeriods, "", "Periods of a periodic schedule, as comma separated length:amount pairs")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// parseTimeFlag parses an RFC3339 time flag, zero when empty
+func parseTimeFlag(cmd *cobra.Command, flag string) (time.Time, error) {
+	str, err := cmd.Flags().GetString(flag)
+	if err != nil || str == "" {
+		return time.Time{}, err
+	}
+
+	t, err := time.Parse(time.RFC3339, str)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid %s: %w", flag, err)
+	}
+	return t, nil
+}
+
+// parseVestingPeriods parses comma separated length:amount pairs, such as 720h:500,720h:500
+func parseVestingPeriods(str string) ([]types.VestingPeriod, error) {
+	if str == "" {
+		return nil, nil
+	}
+
+	var periods []types.VestingPeriod
+	for _, pair := range strings.Split(str, ",") {
+		lengthStr, amountStr, found := strings.Cut(strings.TrimSpace(pair), ":")
+		if !found {
+			return nil, fmt.Errorf("invalid period %q, expected length:amount", pair)
+		}
+
+		length, err := time.ParseDuration(lengthStr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid period %q: %w", pair, err)
+		}
+
+		amount, ok := sdkmath.NewIntFromString(amountStr)
+		if !ok {
+			return nil, fmt.Errorf("invalid period %q: invalid amount", pair)
+		}
+
+		periods = append(periods, types.VestingPeriod{Length: length, Amount: amount})
+	}
+	return periods, nil
+}
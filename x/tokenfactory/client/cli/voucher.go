@@ -0,0 +1,245 @@
+package cli
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/cosmos/tokenfactory/x/tokenfactory/types"
+
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+// SignedMintVoucher is a mint voucher with its hex encoded signature, printed by the
+// sign-mint-voucher command and read by the redeem-mint-voucher command.
+type SignedMintVoucher struct {
+	Voucher types.MintVoucher `json:"voucher"`
+	// Signature is the hex encoded signature of the voucher sign bytes
+	Signature string `json:"signature"`
+	// PubKey is the hex encoded public key of the signer, to set as the voucher signer of the denom
+	PubKey string `json:"pub_key"`
+}
+
+// SignMintVoucher signs a mint voucher, redeemable on the chain, with a secp256k1 key of the keyring.
+func SignMintVoucher(kr keyring.Keyring, uid, chainID string, voucher types.MintVoucher) (SignedMintVoucher, error) {
+	if chainID == "" {
+		return SignedMintVoucher{}, fmt.Errorf("the chain id of the voucher must be set")
+	}
+	if err := voucher.Validate(); err != nil {
+		return SignedMintVoucher{}, err
+	}
+
+	signBytes, err := types.VoucherSignBytes(chainID, voucher)
+	if err != nil {
+		return SignedMintVoucher{}, err
+	}
+
+	signature, pubKey, err := kr.Sign(uid, signBytes, signing.SignMode_SIGN_MODE_DIRECT)
+	if err != nil {
+		return SignedMintVoucher{}, err
+	}
+	if _, ok := pubKey.(*secp256k1.PubKey); !ok {
+		return SignedMintVoucher{}, fmt.Errorf("voucher signer key must be secp256k1, got %s", pubKey.Type())
+	}
+
+	return SignedMintVoucher{
+		Voucher:   voucher,
+		Signature: hex.EncodeToString(signature),
+		PubKey:    hex.EncodeToString(pubKey.Bytes()),
+	}, nil
+}
+
+// NewSetVoucherSignerCmd broadcast MsgSetVoucherSigner
+func NewSetVoucherSignerCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-voucher-signer [denom] [pubkey-hex] [flags]",
+		Short: "Sets the compressed secp256k1 public key signing the mint vouchers of a denom. Must have admin authority to do so.",
+		Example: fmt.Sprintf(
+			"%s tx %s set-voucher-signer factory/cosmos1.../bitcoin 02a1b2... --from admin",
+			version.AppName, types.ModuleName,
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+			txf = txf.WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			pubKey, err := hex.DecodeString(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid public key: %w", err)
+			}
+
+			msg := types.NewMsgSetVoucherSigner(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				pubKey,
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewRemoveVoucherSignerCmd broadcast MsgSetVoucherSigner without a public key
+func NewRemoveVoucherSignerCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-voucher-signer [denom] [flags]",
+		Short: "Removes the voucher signer of a denom, its vouchers can no longer be redeemed. Must have admin authority to do so.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+			txf = txf.WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			msg := types.NewMsgSetVoucherSigner(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				nil,
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewSignMintVoucherCmd prints a mint voucher signed by a key of the keyring, it does not broadcast
+func NewSignMintVoucherCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sign-mint-voucher [denom] [amount] [recipient] [nonce] [expiry] [flags]",
+		Short: "Create and sign offline a mint voucher, redeemable by anyone on the chain of --chain-id",
+		Long: `Create and sign offline a mint voucher with the --from key, which must be the voucher signer of the denom.
+The expiry is an RFC3339 time from which the voucher is rejected, and every nonce of a denom can be redeemed once.
+The output is JSON with the voucher, its signature and the public key of the signer, to redeem with redeem-mint-voucher.
+Nothing is broadcast.`,
+		Example: fmt.Sprintf(
+			"%s tx %s sign-mint-voucher factory/cosmos1.../bitcoin 1000 cosmos1... 42 2030-01-01T00:00:00Z --from issuer --chain-id mychain > voucher.json",
+			version.AppName, types.ModuleName,
+		),
+		Args: cobra.ExactArgs(5),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, ok := sdkmath.NewIntFromString(args[1])
+			if !ok {
+				return fmt.Errorf("invalid amount %q", args[1])
+			}
+
+			nonce, err := strconv.ParseUint(args[3], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid nonce: %w", err)
+			}
+
+			expiry, err := time.Parse(time.RFC3339, args[4])
+			if err != nil {
+				return fmt.Errorf("invalid expiry: %w", err)
+			}
+
+			signed, err := SignMintVoucher(clientCtx.Keyring, clientCtx.FromName, clientCtx.ChainID, types.MintVoucher{
+				Denom:     args[0],
+				Amount:    amount,
+				Recipient: args[2],
+				Nonce:     nonce,
+				Expiry:    expiry.UTC(),
+			})
+			if err != nil {
+				return err
+			}
+
+			bz, err := json.Marshal(signed)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintRaw(bz)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewRedeemMintVoucherCmd broadcast MsgRedeemMintVoucher
+func NewRedeemMintVoucherCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "redeem-mint-voucher [voucher-file] [flags]",
+		Short: "Redeem a mint voucher created by sign-mint-voucher, the amount is minted to its recipient",
+		Example: fmt.Sprintf(
+			"%s tx %s redeem-mint-voucher voucher.json --from redeemer",
+			version.AppName, types.ModuleName,
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+			txf = txf.WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			bz, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			var signed SignedMintVoucher
+			if err := json.Unmarshal(bz, &signed); err != nil {
+				return fmt.Errorf("invalid voucher file: %w", err)
+			}
+
+			signature, err := hex.DecodeString(signed.Signature)
+			if err != nil {
+				return fmt.Errorf("invalid signature: %w", err)
+			}
+
+			msg := types.NewMsgRedeemMintVoucher(
+				clientCtx.GetFromAddress().String(),
+				signed.Voucher,
+				signature,
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
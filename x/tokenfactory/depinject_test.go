@@ -0,0 +1,135 @@
+package tokenfactory_test
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/tokenfactory/x/tokenfactory"
+	"github.com/cosmos/tokenfactory/x/tokenfactory/keeper"
+	modulev1 "github.com/cosmos/tokenfactory/x/tokenfactory/module/v1"
+	"github.com/cosmos/tokenfactory/x/tokenfactory/types"
+
+	"cosmossdk.io/core/appconfig"
+	"cosmossdk.io/depinject"
+	"cosmossdk.io/log"
+	sdkmath "cosmossdk.io/math"
+
+	_ "github.com/cosmos/cosmos-sdk/x/auth"
+	_ "github.com/cosmos/cosmos-sdk/x/auth/tx/config"
+	_ "github.com/cosmos/cosmos-sdk/x/bank"
+	_ "github.com/cosmos/cosmos-sdk/x/consensus"
+	_ "github.com/cosmos/cosmos-sdk/x/distribution"
+	_ "github.com/cosmos/cosmos-sdk/x/genutil"
+	_ "github.com/cosmos/cosmos-sdk/x/staking"
+
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+// mockHooks records the mints, the other hooks are no-ops.
+type mockHooks struct {
+	types.MultiTokenFactoryHooks
+	mints []string
+}
+
+func (h *mockHooks) AfterMint(_ context.Context, amount sdk.Coin, mintToAddress string) error {
+	h.mints = append(h.mints, amount.String()+" "+mintToAddress)
+	return nil
+}
+
+// mockContractKeeper records the sudo calls, every address is a contract.
+type mockContractKeeper struct {
+	sudoMsgs [][]byte
+}
+
+func (m *mockContractKeeper) Sudo(_ sdk.Context, _ sdk.AccAddress, msg []byte) ([]byte, error) {
+	m.sudoMsgs = append(m.sudoMsgs, msg)
+	return nil, nil
+}
+
+func (m *mockContractKeeper) Execute(_ sdk.Context, _, _ sdk.AccAddress, _ []byte, _ sdk.Coins) ([]byte, error) {
+	return nil, nil
+}
+
+func (m *mockContractKeeper) HasContractInfo(_ context.Context, _ sdk.AccAddress) bool {
+	return true
+}
+
+func TestAppConfig(t *testing.T) {
+	bz, err := os.ReadFile("testdata/app.yaml")
+	require.NoError(t, err)
+
+	var (
+		tokenFactoryKeeper keeper.Keeper
+		bankKeeper         bankkeeper.Keeper
+	)
+	hooks, contractKeeper := &mockHooks{}, &mockContractKeeper{}
+	app, err := simtestutil.Setup(
+		depinject.Configs(appconfig.LoadYAML(bz), depinject.Supply(log.NewNopLogger(), hooks, contractKeeper)),
+		&tokenFactoryKeeper, &bankKeeper,
+	)
+	require.NoError(t, err)
+	ctx := app.BaseApp.NewContext(false)
+
+	// the capabilities and the authority come from the module config
+	require.Equal(t, []string{types.EnableSetMetadata, types.EnableForceTransfer, types.EnableBurnFrom, types.EnableBeforeSendHook}, tokenFactoryKeeper.GetEnabledCapabilities())
+	require.Equal(t, authtypes.NewModuleAddress(govtypes.ModuleName).String(), tokenFactoryKeeper.GetAuthority())
+
+	// the module account permissions come from the auth module config
+	params := types.DefaultParams()
+	params.DenomCreationFee = nil
+	require.NoError(t, tokenFactoryKeeper.SetParams(ctx, params))
+
+	creator := sdk.AccAddress("creator_____________")
+	denom, err := tokenFactoryKeeper.CreateDenom(ctx, creator.String(), "bitcoin")
+	require.NoError(t, err)
+
+	msgServer := keeper.NewMsgServerImpl(tokenFactoryKeeper)
+	_, err = msgServer.Mint(ctx, types.NewMsgMint(creator.String(), sdk.NewInt64Coin(denom, 100)))
+	require.NoError(t, err)
+	require.Equal(t, sdkmath.NewInt(100), bankKeeper.GetBalance(ctx, creator, denom).Amount)
+
+	// the module accounts of the auth module config can't be force transferred from
+	require.NoError(t, bankKeeper.SendCoinsFromAccountToModule(ctx, creator, authtypes.FeeCollectorName, sdk.NewCoins(sdk.NewInt64Coin(denom, 10))))
+	feeCollector := authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+	_, err = msgServer.ForceTransfer(ctx, types.NewMsgForceTransfer(creator.String(), sdk.NewInt64Coin(denom, 10), feeCollector.String(), creator.String()))
+	require.ErrorContains(t, err, "send from module acc not available")
+
+	// the vesting send restriction is provided to the bank module
+	recipient := sdk.AccAddress("recipient___________")
+	_, err = msgServer.MintVesting(ctx, types.NewMsgMintVesting(creator.String(), sdk.NewInt64Coin(denom, 10), recipient.String(), time.Time{}, ctx.BlockTime().Add(time.Hour), nil))
+	require.NoError(t, err)
+	err = bankKeeper.SendCoins(ctx, recipient, creator, sdk.NewCoins(sdk.NewInt64Coin(denom, 1)))
+	require.ErrorIs(t, err, types.ErrVestingLocked)
+
+	// the supplied hooks and contract keeper are used by the msg server of the module
+	hooks.mints = nil
+	mint := types.NewMsgMint(creator.String(), sdk.NewInt64Coin(denom, 5))
+	_, err = app.MsgServiceRouter().Handler(mint)(ctx, mint)
+	require.NoError(t, err)
+	require.Equal(t, []string{"5" + denom + " " + creator.String()}, hooks.mints)
+
+	contract := sdk.AccAddress("contract____________")
+	setHook := types.NewMsgSetBeforeSendHook(creator.String(), denom, contract.String())
+	_, err = app.MsgServiceRouter().Handler(setHook)(ctx, setHook)
+	require.NoError(t, err)
+
+	// and by the before send restriction provided to the bank module
+	require.NoError(t, bankKeeper.SendCoins(ctx, creator, recipient, sdk.NewCoins(sdk.NewInt64Coin(denom, 1))))
+	require.Len(t, contractKeeper.sudoMsgs, 1)
+	require.Contains(t, string(contractKeeper.sudoMsgs[0]), "block_before_send")
+}
+
+func TestProvideModuleUnknownCapability(t *testing.T) {
+	_, err := tokenfactory.ProvideModule(tokenfactory.ModuleInputs{
+		Config: &modulev1.Module{EnabledCapabilities: []string{types.EnableBurnFrom, "enable_everything"}},
+	})
+	require.ErrorContains(t, err, "unknown tokenfactory capability enable_everything")
+}
@@ -40,10 +40,28 @@ func (k Keeper) setAuthorityMetadata(ctx context.Context, denom string, metadata
 	return nil
 }
 
-func (k Keeper) setAdmin(ctx context.Context, metadata types.DenomAuthorityMetadata, denom string, admin string) error {
+// changeAdmin changes the admin of an existing denom, and calls the AfterAdminChanged hook and
+// the DenomAdminChanged denom hook. It is only called by MsgChangeAdmin: the admin stored on
+// denom creation and in InitGenesis is set with setAuthorityMetadata, without calling the hooks.
+func (k Keeper) changeAdmin(ctx context.Context, metadata types.DenomAuthorityMetadata, denom string, admin string) error {
+	previousAdmin := metadata.Admin
 	metadata.Admin = admin
 
-	return k.setAuthorityMetadata(ctx, denom, metadata)
+	if err := k.setAuthorityMetadata(ctx, denom, metadata); err != nil {
+		return err
+	}
+
+	if err := k.Hooks().AfterAdminChanged(ctx, denom, previousAdmin, admin); err != nil {
+		return err
+	}
+
+	return k.callDenomHook(sdk.UnwrapSDKContext(ctx), denom, types.DenomHookSudoMsg{
+		DenomAdminChanged: &types.DenomAdminChangedHook{
+			Denom:         denom,
+			PreviousAdmin: previousAdmin,
+			NewAdmin:      admin,
+		},
+	})
 }
 
 // GetDenomsFromAdmin returns all denoms for which the provided address is the admin
@@ -8,7 +8,13 @@ import (
 	"github.com/cosmos/tokenfactory/x/tokenfactory/types"
 	"google.golang.org/grpc/codes"
 
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
 func (k Keeper) mintTo(ctx sdk.Context, amount sdk.Coin, mintTo string) error {
@@ -18,11 +24,27 @@ func (k Keeper) mintTo(ctx sdk.Context, amount sdk.Coin, mintTo string) error {
 		return err
 	}
 
+	if err := k.CheckReserveCeiling(ctx, amount); err != nil {
+		return err
+	}
+
 	err = k.bankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(amount))
 	if err != nil {
 		return err
 	}
 
+	if err := k.CheckVaultBacking(ctx, amount.Denom); err != nil {
+		return err
+	}
+
+	if err := k.CheckCW20Backing(ctx, amount.Denom); err != nil {
+		return err
+	}
+
+	if err := k.CheckBasketBacking(ctx, amount.Denom); err != nil {
+		return err
+	}
+
 	addr, err := sdk.AccAddressFromBech32(mintTo)
 	if err != nil {
 		return err
@@ -32,9 +54,24 @@ func (k Keeper) mintTo(ctx sdk.Context, amount sdk.Coin, mintTo string) error {
 		return fmt.Errorf("failed to mint to blocked address: %s", addr)
 	}
 
-	return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName,
+	err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName,
 		addr,
 		sdk.NewCoins(amount))
+	if err != nil {
+		return err
+	}
+
+	if err := k.Hooks().AfterMint(ctx, amount, mintTo); err != nil {
+		return err
+	}
+
+	return k.callDenomHook(ctx, amount.Denom, types.DenomHookSudoMsg{
+		DenomMinted: &types.DenomMintedHook{
+			Denom:         amount.Denom,
+			Amount:        amount.Amount,
+			MintToAddress: mintTo,
+		},
+	})
 }
 
 func (k Keeper) burnFrom(ctx sdk.Context, amount sdk.Coin, burnFrom string) error {
@@ -53,6 +90,10 @@ func (k Keeper) burnFrom(ctx sdk.Context, amount sdk.Coin, burnFrom string) erro
 		return fmt.Errorf("failed to burn from blocked address: %s", addr)
 	}
 
+	if k.isEscrowAddress(ctx, addr) {
+		return fmt.Errorf("failed to burn from escrow address: %s", addr)
+	}
+
 	err = k.bankKeeper.SendCoinsFromAccountToModule(ctx,
 		addr,
 		types.ModuleName,
@@ -61,7 +102,200 @@ func (k Keeper) burnFrom(ctx sdk.Context, amount sdk.Coin, burnFrom string) erro
 		return err
 	}
 
-	return k.bankKeeper.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(amount))
+	return k.burnFromModule(ctx, amount, burnFrom)
+}
+
+// burnFromModule burns an amount held by the module account, such as escrowed tokens, and calls
+// the hooks as if it was burned from the burnFrom address.
+func (k Keeper) burnFromModule(ctx sdk.Context, amount sdk.Coin, burnFrom string) error {
+	err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(amount))
+	if err != nil {
+		return err
+	}
+
+	if err := k.CheckVaultBacking(ctx, amount.Denom); err != nil {
+		return err
+	}
+
+	if err := k.CheckCW20Backing(ctx, amount.Denom); err != nil {
+		return err
+	}
+
+	if err := k.CheckBasketBacking(ctx, amount.Denom); err != nil {
+		return err
+	}
+
+	if err := k.Hooks().AfterBurn(ctx, amount, burnFrom); err != nil {
+		return err
+	}
+
+	return k.callDenomHook(ctx, amount.Denom, types.DenomHookSudoMsg{
+		DenomBurned: &types.DenomBurnedHook{
+			Denom:           amount.Denom,
+			Amount:          amount.Amount,
+			BurnFromAddress: burnFrom,
+		},
+	})
+}
+
+// multiMintTo mints the sum of the outputs once and sends it from the module account to all the
+// recipients in a single bank InputOutputCoins call.
+func (k Keeper) multiMintTo(ctx sdk.Context, denom string, outputs []types.MultiMintOutput) error {
+	// verify that denom is an x/tokenfactory denom
+	_, _, err := types.DeconstructDenom(denom)
+	if err != nil {
+		return err
+	}
+
+	total := sdkmath.ZeroInt()
+	bankOutputs := make([]banktypes.Output, 0, len(outputs))
+	for _, output := range outputs {
+		addr, err := sdk.AccAddressFromBech32(output.Address)
+		if err != nil {
+			return err
+		}
+
+		if k.bankKeeper.BlockedAddr(addr) {
+			return fmt.Errorf("failed to mint to blocked address: %s", addr)
+		}
+
+		total = total.Add(output.Amount)
+		bankOutputs = append(bankOutputs, banktypes.NewOutput(addr, sdk.NewCoins(sdk.NewCoin(denom, output.Amount))))
+	}
+
+	if err := k.CheckReserveCeiling(ctx, sdk.NewCoin(denom, total)); err != nil {
+		return err
+	}
+
+	totalCoins := sdk.NewCoins(sdk.NewCoin(denom, total))
+	err = k.bankKeeper.MintCoins(ctx, types.ModuleName, totalCoins)
+	if err != nil {
+		return err
+	}
+
+	if err := k.CheckVaultBacking(ctx, denom); err != nil {
+		return err
+	}
+
+	if err := k.CheckCW20Backing(ctx, denom); err != nil {
+		return err
+	}
+
+	if err := k.CheckBasketBacking(ctx, denom); err != nil {
+		return err
+	}
+
+	err = k.bankKeeper.InputOutputCoins(ctx, banktypes.NewInput(authtypes.NewModuleAddress(types.ModuleName), totalCoins), bankOutputs)
+	if err != nil {
+		return err
+	}
+
+	for _, output := range outputs {
+		amount := sdk.NewCoin(denom, output.Amount)
+		if err := k.Hooks().AfterMint(ctx, amount, output.Address); err != nil {
+			return err
+		}
+
+		if err := k.callDenomHook(ctx, denom, types.DenomHookSudoMsg{
+			DenomMinted: &types.DenomMintedHook{
+				Denom:         denom,
+				Amount:        output.Amount,
+				MintToAddress: output.Address,
+			},
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// multiBurnFrom moves the inputs to the module account and burns their sum once.
+func (k Keeper) multiBurnFrom(ctx sdk.Context, denom string, inputs []types.MultiBurnInput) error {
+	// verify that denom is an x/tokenfactory denom
+	_, _, err := types.DeconstructDenom(denom)
+	if err != nil {
+		return err
+	}
+
+	total := sdkmath.ZeroInt()
+	for _, input := range inputs {
+		addr, err := sdk.AccAddressFromBech32(input.Address)
+		if err != nil {
+			return err
+		}
+
+		if k.bankKeeper.BlockedAddr(addr) {
+			return fmt.Errorf("failed to burn from blocked address: %s", addr)
+		}
+
+		if k.isEscrowAddress(ctx, addr) {
+			return fmt.Errorf("failed to burn from escrow address: %s", addr)
+		}
+
+		err = k.bankKeeper.SendCoinsFromAccountToModule(ctx,
+			addr,
+			types.ModuleName,
+			sdk.NewCoins(sdk.NewCoin(denom, input.Amount)))
+		if err != nil {
+			return err
+		}
+
+		total = total.Add(input.Amount)
+	}
+
+	err = k.bankKeeper.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(sdk.NewCoin(denom, total)))
+	if err != nil {
+		return err
+	}
+
+	if err := k.CheckVaultBacking(ctx, denom); err != nil {
+		return err
+	}
+
+	if err := k.CheckCW20Backing(ctx, denom); err != nil {
+		return err
+	}
+
+	if err := k.CheckBasketBacking(ctx, denom); err != nil {
+		return err
+	}
+
+	for _, input := range inputs {
+		amount := sdk.NewCoin(denom, input.Amount)
+		if err := k.Hooks().AfterBurn(ctx, amount, input.Address); err != nil {
+			return err
+		}
+
+		if err := k.callDenomHook(ctx, denom, types.DenomHookSudoMsg{
+			DenomBurned: &types.DenomBurnedHook{
+				Denom:           denom,
+				Amount:          input.Amount,
+				BurnFromAddress: input.Address,
+			},
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// getEscrowStore returns the store indexing the denoms of the vaults and the baskets by the
+// address of their escrow account
+func (k Keeper) getEscrowStore(ctx sdk.Context) storetypes.KVStore {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), types.GetEscrowPrefix())
+}
+
+// setEscrowAddress indexes the denom of a vault or a basket by the address of its escrow account
+func (k Keeper) setEscrowAddress(ctx sdk.Context, escrow sdk.AccAddress, denom string) {
+	k.getEscrowStore(ctx).Set([]byte(escrow.String()), []byte(denom))
+}
+
+// isEscrowAddress returns whether an address is the escrow account of a vault or a basket, whose
+// balances back the supply of their denoms and can not be force transferred or burned from
+func (k Keeper) isEscrowAddress(ctx sdk.Context, addr sdk.AccAddress) bool {
+	return k.getEscrowStore(ctx).Has([]byte(addr.String()))
 }
 
 func (k Keeper) forceTransfer(ctx sdk.Context, amount sdk.Coin, fromAddr string, toAddr string) error {
@@ -93,6 +327,10 @@ func (k Keeper) forceTransfer(ctx sdk.Context, amount sdk.Coin, fromAddr string,
 		}
 	}
 
+	if k.isEscrowAddress(ctx, fromSdkAddr) {
+		return fmt.Errorf("failed to force transfer from escrow address: %s", fromSdkAddr)
+	}
+
 	toSdkAddr, err := sdk.AccAddressFromBech32(toAddr)
 	if err != nil {
 		return err
@@ -102,5 +340,37 @@ func (k Keeper) forceTransfer(ctx sdk.Context, amount sdk.Coin, fromAddr string,
 		return fmt.Errorf("failed to force transfer to blocked address: %s", toSdkAddr)
 	}
 
-	return k.bankKeeper.SendCoins(ctx, fromSdkAddr, toSdkAddr, sdk.NewCoins(amount))
+	err = k.bankKeeper.SendCoins(ctx, fromSdkAddr, toSdkAddr, sdk.NewCoins(amount))
+	if err != nil {
+		return err
+	}
+
+	if err := k.Hooks().AfterForceTransfer(ctx, amount, fromAddr, toAddr); err != nil {
+		return err
+	}
+
+	return k.callDenomHook(ctx, amount.Denom, types.DenomHookSudoMsg{
+		DenomForceTransferred: &types.DenomForceTransferredHook{
+			Denom:       amount.Denom,
+			Amount:      amount.Amount,
+			FromAddress: fromAddr,
+			ToAddress:   toAddr,
+		},
+	})
+}
+
+// SetDenomMetadata sets the bank metadata of a factory denom and calls its hooks.
+func (k Keeper) SetDenomMetadata(ctx sdk.Context, metadata banktypes.Metadata) error {
+	k.bankKeeper.SetDenomMetaData(ctx, metadata)
+
+	if err := k.Hooks().AfterMetadataSet(ctx, metadata.Base, metadata); err != nil {
+		return err
+	}
+
+	return k.callDenomHook(ctx, metadata.Base, types.DenomHookSudoMsg{
+		DenomMetadataChanged: &types.DenomMetadataChangedHook{
+			Denom:    metadata.Base,
+			Metadata: metadata,
+		},
+	})
 }
@@ -0,0 +1,235 @@
+package keeper
+
+import (
+	"bytes"
+	"time"
+
+	"github.com/cosmos/tokenfactory/x/tokenfactory/types"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (k Keeper) getBasketStore(ctx sdk.Context) storetypes.KVStore {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), types.GetBasketPrefix())
+}
+
+// GetBasket returns the basket of a denom, if the denom was created as a basket
+func (k Keeper) GetBasket(ctx sdk.Context, denom string) (types.Basket, bool) {
+	bz := k.getBasketStore(ctx).Get([]byte(denom))
+	if bz == nil {
+		return types.Basket{}, false
+	}
+
+	basket := types.Basket{}
+	k.cdc.MustUnmarshal(bz, &basket)
+	return basket, true
+}
+
+// setBasket stores a basket, and indexes its pending composition change by execution time
+func (k Keeper) setBasket(ctx sdk.Context, basket types.Basket) {
+	k.getBasketStore(ctx).Set([]byte(basket.Denom), k.cdc.MustMarshal(&basket))
+	k.setEscrowAddress(ctx, types.BasketAddress(basket.Denom), basket.Denom)
+	if pending := basket.PendingComposition; pending != nil {
+		ctx.KVStore(k.storeKey).Set(types.GetBasketCompositionKey(pending.ExecuteAfter, basket.Denom), []byte{})
+	}
+}
+
+// GetAllBaskets returns all the baskets, ordered by denom
+func (k Keeper) GetAllBaskets(ctx sdk.Context) []types.Basket {
+	iterator := k.getBasketStore(ctx).Iterator(nil, nil)
+	defer iterator.Close()
+
+	baskets := []types.Basket{}
+	for ; iterator.Valid(); iterator.Next() {
+		basket := types.Basket{}
+		k.cdc.MustUnmarshal(iterator.Value(), &basket)
+		baskets = append(baskets, basket)
+	}
+	return baskets
+}
+
+// CreateBasket creates a factory denom bound to a composition of other denoms, whose admin is the
+// creator. The denom creation fee is charged as for any other denom.
+func (k Keeper) CreateBasket(ctx sdk.Context, creatorAddr, subdenom string, components sdk.Coins, compositionTimelock time.Duration) (types.Basket, error) {
+	denom, err := k.CreateDenom(ctx, creatorAddr, subdenom)
+	if err != nil {
+		return types.Basket{}, err
+	}
+
+	basket := types.Basket{
+		Denom:               denom,
+		Components:          components,
+		Escrowed:            sdk.NewCoins(),
+		Supply:              sdkmath.ZeroInt(),
+		CompositionTimelock: compositionTimelock,
+	}
+	if err := basket.Validate(); err != nil {
+		return types.Basket{}, err
+	}
+
+	k.setBasket(ctx, basket)
+	return basket, nil
+}
+
+// BasketMint moves the components of an amount of a basket denom from the minter to the account
+// of the basket, and mints the amount to the minter. It returns the deposited coins.
+func (k Keeper) BasketMint(ctx sdk.Context, minter, denom string, amount sdkmath.Int) (sdk.Coins, error) {
+	basket, found := k.GetBasket(ctx, denom)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrBasketNotFound, "denom %s", denom)
+	}
+
+	deposit, err := basket.MintDeposit(amount)
+	if err != nil {
+		return nil, err
+	}
+
+	minterAddr, err := sdk.AccAddressFromBech32(minter)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.bankKeeper.SendCoins(ctx, minterAddr, types.BasketAddress(denom), deposit); err != nil {
+		return nil, err
+	}
+
+	// the basket is updated before the mint, which checks the supply against it
+	basket.Escrowed = basket.Escrowed.Add(deposit...)
+	basket.Supply = basket.Supply.Add(amount)
+	k.setBasket(ctx, basket)
+
+	if err := k.mintTo(ctx, sdk.NewCoin(denom, amount), minter); err != nil {
+		return nil, err
+	}
+	return deposit, nil
+}
+
+// BasketRedeem burns an amount of a basket denom from the redeemer, and moves its proportional
+// share of the coins escrowed by the basket to the redeemer. It returns the redeemed coins.
+func (k Keeper) BasketRedeem(ctx sdk.Context, redeemer, denom string, amount sdkmath.Int) (sdk.Coins, error) {
+	basket, found := k.GetBasket(ctx, denom)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrBasketNotFound, "denom %s", denom)
+	}
+
+	if amount.GT(basket.Supply) {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInsufficientFunds, "basket %s has a supply of %s", denom, basket.Supply)
+	}
+
+	redeemerAddr, err := sdk.AccAddressFromBech32(redeemer)
+	if err != nil {
+		return nil, err
+	}
+
+	redeemed := basket.RedeemedCoins(amount)
+
+	// the basket is updated before the burn, which checks the supply against it
+	basket.Escrowed = basket.Escrowed.Sub(redeemed...)
+	basket.Supply = basket.Supply.Sub(amount)
+	k.setBasket(ctx, basket)
+
+	if err := k.bankKeeper.SendCoins(ctx, types.BasketAddress(denom), redeemerAddr, redeemed); err != nil {
+		return nil, err
+	}
+
+	if err := k.burnFrom(ctx, sdk.NewCoin(denom, amount), redeemer); err != nil {
+		return nil, err
+	}
+	return redeemed, nil
+}
+
+// SetBasketComposition schedules a change of the components of a basket after its composition
+// timelock, replacing its pending change. No components cancel the pending change. It returns the
+// time from which the change is executed, zero when it is cancelled.
+func (k Keeper) SetBasketComposition(ctx sdk.Context, denom string, components sdk.Coins) (time.Time, error) {
+	basket, found := k.GetBasket(ctx, denom)
+	if !found {
+		return time.Time{}, errorsmod.Wrapf(types.ErrBasketNotFound, "denom %s", denom)
+	}
+
+	if pending := basket.PendingComposition; pending != nil {
+		ctx.KVStore(k.storeKey).Delete(types.GetBasketCompositionKey(pending.ExecuteAfter, denom))
+		basket.PendingComposition = nil
+	}
+
+	var executeAfter time.Time
+	if len(components) != 0 {
+		if err := types.ValidateBasketComponents(denom, components); err != nil {
+			return time.Time{}, err
+		}
+
+		executeAfter = ctx.BlockTime().Add(basket.CompositionTimelock)
+		basket.PendingComposition = &types.PendingBasketComposition{
+			Components:   components,
+			ExecuteAfter: executeAfter,
+		}
+	}
+
+	k.setBasket(ctx, basket)
+	return executeAfter, nil
+}
+
+// ExecuteBasketCompositions executes the pending composition changes of the baskets whose
+// execution time has passed. The coins escrowed by a basket are not rebalanced: the next mints
+// deposit the new components, and the redemptions keep paying a share of the escrowed coins.
+func (k Keeper) ExecuteBasketCompositions(ctx sdk.Context) {
+	// the index keys are ordered by execution time, every key up to the block time is due
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.BasketCompositionPrefix+types.KeySeparator))
+	iterator := indexStore.Iterator(nil, storetypes.PrefixEndBytes(sdk.FormatTimeBytes(ctx.BlockTime())))
+	defer iterator.Close()
+
+	var due [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		due = append(due, iterator.Key())
+	}
+
+	for _, key := range due {
+		indexStore.Delete(key)
+
+		// key is {timeBytes}|{denom}, the time does not contain the separator
+		i := bytes.Index(key, []byte(types.KeySeparator))
+		if i < 0 {
+			continue
+		}
+		denom := string(key[i+len(types.KeySeparator):])
+
+		basket, found := k.GetBasket(ctx, denom)
+		if !found || basket.PendingComposition == nil {
+			continue
+		}
+
+		basket.Components = basket.PendingComposition.Components
+		basket.PendingComposition = nil
+		k.setBasket(ctx, basket)
+
+		if err := ctx.EventManager().EmitTypedEvent(&types.EventBasketCompositionChanged{
+			Denom:      denom,
+			Components: basket.Components,
+		}); err != nil {
+			k.Logger(ctx).Error("failed to emit basket composition event", "error", err)
+		}
+	}
+}
+
+// CheckBasketBacking returns an error if the supply of a basket denom is not the supply minted
+// by the deposits in its basket. It is checked after every mint and burn, so that a basket denom
+// can only be minted and burned through its basket. Denoms without basket are not checked.
+func (k Keeper) CheckBasketBacking(ctx sdk.Context, denom string) error {
+	basket, found := k.GetBasket(ctx, denom)
+	if !found {
+		return nil
+	}
+
+	supply := k.bankKeeper.GetSupply(ctx, denom).Amount
+	if !supply.Equal(basket.Supply) {
+		return errorsmod.Wrapf(types.ErrBasketBacking, "supply %s%s, minted by deposits %s", supply, denom, basket.Supply)
+	}
+
+	return nil
+}
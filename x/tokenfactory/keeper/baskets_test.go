@@ -0,0 +1,255 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/cosmos/tokenfactory/x/tokenfactory/keeper"
+	"github.com/cosmos/tokenfactory/x/tokenfactory/types"
+
+	"github.com/cosmos/gogoproto/proto"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+func (suite *KeeperTestSuite) TestBasketMsgs() {
+	suite.CreateDefaultDenom()
+	admin, holder := suite.TestAccs[0], suite.TestAccs[1]
+	_, err := suite.msgServer.Mint(suite.Ctx, types.NewMsgMint(admin.String(), sdk.NewInt64Coin(suite.defaultDenom, 1000)))
+	suite.Require().NoError(err)
+	err = suite.App.BankKeeper.SendCoins(suite.Ctx, admin, holder, sdk.NewCoins(sdk.NewInt64Coin(suite.defaultDenom, 1000)))
+	suite.Require().NoError(err)
+
+	createFee := types.DefaultParams().DenomCreationFee
+	suite.Require().NoError(suite.App.TokenFactoryKeeper.SetParams(suite.Ctx, types.Params{DenomCreationFee: createFee}))
+
+	// create a basket of 10 utwo and 5 bitcoin per unit
+	components := sdk.NewCoins(sdk.NewInt64Coin("utwo", 10), sdk.NewInt64Coin(suite.defaultDenom, 5))
+	balanceBefore := suite.App.BankKeeper.GetAllBalances(suite.Ctx, admin)
+	ctx := suite.Ctx.WithEventManager(sdk.NewEventManager())
+	res, err := suite.msgServer.CreateBasket(ctx, types.NewMsgCreateBasket(admin.String(), "index", components, types.MinBasketCompositionTimelock))
+	suite.Require().NoError(err)
+	denom := res.GetNewTokenDenom()
+	suite.Require().Equal("factory/"+admin.String()+"/index", denom)
+	suite.AssertEventEmitted(ctx, proto.MessageName(&types.EventCreateDenom{}), 1)
+	suite.AssertEventEmitted(ctx, proto.MessageName(&types.EventCreateBasket{}), 1)
+
+	// the denom creation fee is charged
+	suite.Require().Equal(balanceBefore.Sub(createFee...), suite.App.BankKeeper.GetAllBalances(suite.Ctx, admin))
+
+	// minting deposits the components of every unit
+	ctx = suite.Ctx.WithEventManager(sdk.NewEventManager())
+	mintRes, err := suite.msgServer.BasketMint(ctx, types.NewMsgBasketMint(holder.String(), denom, sdkmath.NewInt(3)))
+	suite.Require().NoError(err)
+	deposited := sdk.NewCoins(sdk.NewInt64Coin("utwo", 30), sdk.NewInt64Coin(suite.defaultDenom, 15))
+	suite.Require().Equal(deposited, mintRes.Deposited)
+	suite.AssertEventEmitted(ctx, proto.MessageName(&types.EventMint{}), 1)
+	suite.AssertEventEmitted(ctx, proto.MessageName(&types.EventBasketMint{}), 1)
+	suite.Require().Equal(sdkmath.NewInt(3), suite.App.BankKeeper.GetBalance(suite.Ctx, holder, denom).Amount)
+	suite.Require().Equal(deposited, suite.App.BankKeeper.GetAllBalances(suite.Ctx, types.BasketAddress(denom)))
+	suite.Require().Equal(sdkmath.NewInt(1000-15), suite.App.BankKeeper.GetBalance(suite.Ctx, holder, suite.defaultDenom).Amount)
+
+	// the basket denom can not be minted or burned by its admin
+	cacheCtx, _ := suite.Ctx.CacheContext()
+	_, err = suite.msgServer.Mint(cacheCtx, types.NewMsgMint(admin.String(), sdk.NewInt64Coin(denom, 100)))
+	suite.Require().ErrorIs(err, types.ErrBasketBacking)
+	cacheCtx, _ = suite.Ctx.CacheContext()
+	_, err = suite.msgServer.Burn(cacheCtx, types.NewMsgBurnFrom(admin.String(), sdk.NewInt64Coin(denom, 1), holder.String()))
+	suite.Require().ErrorIs(err, types.ErrBasketBacking)
+
+	// nor redeemed off-chain
+	_, err = suite.msgServer.RequestRedemption(cacheCtx, types.NewMsgRequestRedemption(holder.String(), sdk.NewInt64Coin(denom, 1), "iban"))
+	suite.Require().ErrorIs(err, sdkerrors.ErrInvalidRequest)
+
+	for _, tc := range []struct {
+		desc   string
+		denom  string
+		amount int64
+		expErr error
+	}{
+		{desc: "more than the supply", denom: denom, amount: 4, expErr: sdkerrors.ErrInsufficientFunds},
+		{desc: "not a basket", denom: suite.defaultDenom, amount: 1, expErr: types.ErrBasketNotFound},
+	} {
+		suite.Run(tc.desc, func() {
+			ctx, _ := suite.Ctx.CacheContext()
+			_, err := suite.msgServer.BasketRedeem(ctx, types.NewMsgBasketRedeem(holder.String(), tc.denom, sdkmath.NewInt(tc.amount)))
+			suite.Require().ErrorIs(err, tc.expErr)
+		})
+	}
+
+	// the minter can not deposit more than its balance
+	cacheCtx, _ = suite.Ctx.CacheContext()
+	_, err = suite.msgServer.BasketMint(cacheCtx, types.NewMsgBasketMint(holder.String(), denom, sdkmath.NewInt(198)))
+	suite.Require().Error(err)
+
+	// redeeming burns the denom and returns its share of the components
+	ctx = suite.Ctx.WithEventManager(sdk.NewEventManager())
+	redeemRes, err := suite.msgServer.BasketRedeem(ctx, types.NewMsgBasketRedeem(holder.String(), denom, sdkmath.NewInt(1)))
+	suite.Require().NoError(err)
+	suite.Require().Equal(components, redeemRes.Redeemed)
+	suite.AssertEventEmitted(ctx, proto.MessageName(&types.EventBurn{}), 1)
+	suite.AssertEventEmitted(ctx, proto.MessageName(&types.EventBasketRedeem{}), 1)
+	suite.Require().Equal(sdkmath.NewInt(2), suite.App.BankKeeper.GetBalance(suite.Ctx, holder, denom).Amount)
+	suite.Require().Equal(sdkmath.NewInt(1000-10), suite.App.BankKeeper.GetBalance(suite.Ctx, holder, suite.defaultDenom).Amount)
+
+	queryRes, err := suite.queryClient.Basket(suite.Ctx.Context(), &types.QueryBasketRequest{Denom: denom})
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.Basket{
+		Denom:               denom,
+		Components:          components,
+		Escrowed:            deposited.Sub(components...),
+		Supply:              sdkmath.NewInt(2),
+		CompositionTimelock: types.MinBasketCompositionTimelock,
+	}, queryRes.Basket)
+	suite.Require().Equal(types.BasketAddress(denom).String(), queryRes.Address)
+	suite.Require().Equal(sdkmath.NewInt(2), suite.App.BankKeeper.GetSupply(suite.Ctx, denom).Amount)
+
+	_, err = suite.queryClient.Basket(suite.Ctx.Context(), &types.QueryBasketRequest{Denom: suite.defaultDenom})
+	suite.Require().Error(err)
+
+	msg, broken := keeper.BasketBackingInvariant(suite.App.TokenFactoryKeeper)(suite.Ctx)
+	suite.Require().False(broken, msg)
+}
+
+func (suite *KeeperTestSuite) TestBasketComposition() {
+	admin, holder := suite.TestAccs[0], suite.TestAccs[1]
+	res, err := suite.msgServer.CreateBasket(suite.Ctx, types.NewMsgCreateBasket(admin.String(), "index", sdk.NewCoins(sdk.NewInt64Coin("utwo", 1)), 48*time.Hour))
+	suite.Require().NoError(err)
+	denom := res.GetNewTokenDenom()
+	_, err = suite.msgServer.BasketMint(suite.Ctx, types.NewMsgBasketMint(holder.String(), denom, sdkmath.NewInt(3)))
+	suite.Require().NoError(err)
+
+	// only the admin can change the composition of a basket
+	newComponents := sdk.NewCoins(sdk.NewInt64Coin("utwo", 2))
+	cacheCtx, _ := suite.Ctx.CacheContext()
+	_, err = suite.msgServer.SetBasketComposition(cacheCtx, types.NewMsgSetBasketComposition(holder.String(), denom, newComponents))
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	createRes, err := suite.msgServer.CreateDenom(suite.Ctx, types.NewMsgCreateDenom(admin.String(), "bitcoin"))
+	suite.Require().NoError(err)
+	cacheCtx, _ = suite.Ctx.CacheContext()
+	_, err = suite.msgServer.SetBasketComposition(cacheCtx, types.NewMsgSetBasketComposition(admin.String(), createRes.GetNewTokenDenom(), newComponents))
+	suite.Require().ErrorIs(err, types.ErrBasketNotFound)
+
+	// a first change is replaced by a second one, executed after the timelock
+	_, err = suite.msgServer.SetBasketComposition(suite.Ctx, types.NewMsgSetBasketComposition(admin.String(), denom, sdk.NewCoins(sdk.NewInt64Coin("utwo", 7))))
+	suite.Require().NoError(err)
+	ctx := suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(time.Hour)).WithEventManager(sdk.NewEventManager())
+	setRes, err := suite.msgServer.SetBasketComposition(ctx, types.NewMsgSetBasketComposition(admin.String(), denom, newComponents))
+	suite.Require().NoError(err)
+	suite.Require().Equal(ctx.BlockTime().Add(48*time.Hour), setRes.ExecuteAfter)
+	suite.AssertEventEmitted(ctx, proto.MessageName(&types.EventSetBasketComposition{}), 1)
+
+	ctx = suite.Ctx.WithBlockTime(setRes.ExecuteAfter.Add(-time.Second)).WithEventManager(sdk.NewEventManager())
+	suite.App.TokenFactoryKeeper.ExecuteBasketCompositions(ctx)
+	basket, _ := suite.App.TokenFactoryKeeper.GetBasket(suite.Ctx, denom)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("utwo", 1)), basket.Components)
+	suite.AssertEventEmitted(ctx, proto.MessageName(&types.EventBasketCompositionChanged{}), 0)
+
+	ctx = suite.Ctx.WithBlockTime(setRes.ExecuteAfter).WithEventManager(sdk.NewEventManager())
+	suite.App.TokenFactoryKeeper.ExecuteBasketCompositions(ctx)
+	basket, _ = suite.App.TokenFactoryKeeper.GetBasket(suite.Ctx, denom)
+	suite.Require().Equal(newComponents, basket.Components)
+	suite.Require().Nil(basket.PendingComposition)
+	suite.AssertEventEmitted(ctx, proto.MessageName(&types.EventBasketCompositionChanged{}), 1)
+
+	// the escrowed coins are not rebalanced, the redemptions pay a share of them rounded down
+	_, err = suite.msgServer.BasketMint(suite.Ctx, types.NewMsgBasketMint(holder.String(), denom, sdkmath.NewInt(1)))
+	suite.Require().NoError(err)
+	redeemRes, err := suite.msgServer.BasketRedeem(suite.Ctx, types.NewMsgBasketRedeem(holder.String(), denom, sdkmath.NewInt(1)))
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("utwo", 1)), redeemRes.Redeemed)
+
+	// the whole supply redeems the whole escrow
+	redeemRes, err = suite.msgServer.BasketRedeem(suite.Ctx, types.NewMsgBasketRedeem(holder.String(), denom, sdkmath.NewInt(3)))
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("utwo", 4)), redeemRes.Redeemed)
+	suite.Require().True(suite.App.BankKeeper.GetAllBalances(suite.Ctx, types.BasketAddress(denom)).IsZero())
+
+	// a cancelled change is not executed
+	setRes, err = suite.msgServer.SetBasketComposition(suite.Ctx, types.NewMsgSetBasketComposition(admin.String(), denom, sdk.NewCoins(sdk.NewInt64Coin("utwo", 7))))
+	suite.Require().NoError(err)
+	executeAfter := setRes.ExecuteAfter
+	setRes, err = suite.msgServer.SetBasketComposition(suite.Ctx, types.NewMsgSetBasketComposition(admin.String(), denom, nil))
+	suite.Require().NoError(err)
+	suite.Require().True(setRes.ExecuteAfter.IsZero())
+	suite.App.TokenFactoryKeeper.ExecuteBasketCompositions(suite.Ctx.WithBlockTime(executeAfter))
+	basket, _ = suite.App.TokenFactoryKeeper.GetBasket(suite.Ctx, denom)
+	suite.Require().Equal(newComponents, basket.Components)
+	suite.Require().Nil(basket.PendingComposition)
+}
+
+func (suite *KeeperTestSuite) TestCreateBasketInvalid() {
+	suite.CreateDefaultDenom()
+	admin := suite.TestAccs[0]
+
+	for _, tc := range []struct {
+		desc       string
+		subdenom   string
+		components sdk.Coins
+		timelock   time.Duration
+	}{
+		{desc: "composed of itself", subdenom: "index", components: sdk.NewCoins(sdk.NewInt64Coin("factory/"+admin.String()+"/index", 1)), timelock: types.MinBasketCompositionTimelock},
+		{desc: "timelock too short", subdenom: "index", components: sdk.NewCoins(sdk.NewInt64Coin("utwo", 1)), timelock: time.Hour},
+		{desc: "existing denom", subdenom: "bitcoin", components: sdk.NewCoins(sdk.NewInt64Coin("utwo", 1)), timelock: types.MinBasketCompositionTimelock},
+	} {
+		suite.Run(tc.desc, func() {
+			ctx, _ := suite.Ctx.CacheContext()
+			_, err := suite.msgServer.CreateBasket(ctx, types.NewMsgCreateBasket(admin.String(), tc.subdenom, tc.components, tc.timelock))
+			suite.Require().Error(err)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestBasketsQuery() {
+	for i, subdenom := range []string{"ione", "itwo", "ithree"} {
+		components := sdk.NewCoins(sdk.NewInt64Coin("utwo", int64(i+1)))
+		_, err := suite.msgServer.CreateBasket(suite.Ctx, types.NewMsgCreateBasket(suite.TestAccs[i].String(), subdenom, components, types.MinBasketCompositionTimelock))
+		suite.Require().NoError(err)
+	}
+
+	res, err := suite.queryClient.Baskets(suite.Ctx.Context(), &types.QueryBasketsRequest{
+		Pagination: &query.PageRequest{Limit: 2, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Baskets, 2)
+	suite.Require().Equal(uint64(3), res.Pagination.Total)
+
+	res, err = suite.queryClient.Baskets(suite.Ctx.Context(), &types.QueryBasketsRequest{
+		Pagination: &query.PageRequest{Key: res.Pagination.NextKey},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Baskets, 1)
+	suite.Require().Equal(suite.App.TokenFactoryKeeper.GetAllBaskets(suite.Ctx)[2], res.Baskets[0])
+}
+
+func (suite *KeeperTestSuite) TestBasketEscrowAccount() {
+	suite.CreateDefaultDenom()
+	admin, holder := suite.TestAccs[0], suite.TestAccs[1]
+	_, err := suite.msgServer.Mint(suite.Ctx, types.NewMsgMintTo(admin.String(), sdk.NewInt64Coin(suite.defaultDenom, 50), holder.String()))
+	suite.Require().NoError(err)
+
+	// a basket of another admin holding the default denom
+	res, err := suite.msgServer.CreateBasket(suite.Ctx, types.NewMsgCreateBasket(holder.String(), "index", sdk.NewCoins(sdk.NewInt64Coin(suite.defaultDenom, 5)), types.MinBasketCompositionTimelock))
+	suite.Require().NoError(err)
+	denom := res.GetNewTokenDenom()
+	_, err = suite.msgServer.BasketMint(suite.Ctx, types.NewMsgBasketMint(holder.String(), denom, sdkmath.NewInt(10)))
+	suite.Require().NoError(err)
+
+	// the admin of a component can not pull it out of the basket account
+	_, err = suite.msgServer.ForceTransfer(suite.Ctx, types.NewMsgForceTransfer(admin.String(), sdk.NewInt64Coin(suite.defaultDenom, 50), types.BasketAddress(denom).String(), admin.String()))
+	suite.Require().ErrorContains(err, "failed to force transfer from escrow address")
+	_, err = suite.msgServer.Burn(suite.Ctx, types.NewMsgBurnFrom(admin.String(), sdk.NewInt64Coin(suite.defaultDenom, 50), types.BasketAddress(denom).String()))
+	suite.Require().ErrorContains(err, "failed to burn from escrow address")
+	_, err = suite.msgServer.MultiBurn(suite.Ctx, types.NewMsgMultiBurn(admin.String(), suite.defaultDenom, []types.MultiBurnInput{
+		{Address: types.BasketAddress(denom).String(), Amount: sdkmath.NewInt(50)},
+	}))
+	suite.Require().ErrorContains(err, "failed to burn from escrow address")
+	suite.Require().Equal(sdkmath.NewInt(50), suite.App.BankKeeper.GetBalance(suite.Ctx, types.BasketAddress(denom), suite.defaultDenom).Amount)
+
+	msg, broken := keeper.BasketBackingInvariant(suite.App.TokenFactoryKeeper)(suite.Ctx)
+	suite.Require().False(broken, msg)
+}
@@ -0,0 +1,87 @@
+package keeper
+
+import (
+	"context"
+	"strings"
+
+	"github.com/cosmos/tokenfactory/x/tokenfactory/types"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// GetBeforeSendHook returns the before send hook contract address of a specific denom, or an
+// empty string when the denom has no before send hook
+func (k Keeper) GetBeforeSendHook(ctx context.Context, denom string) string {
+	bz := k.GetDenomPrefixStore(sdk.UnwrapSDKContext(ctx), denom).Get([]byte(types.BeforeSendHookAddressKey))
+	return string(bz)
+}
+
+// setBeforeSendHook stores the before send hook contract address of a specific denom, an empty
+// address removes it. The address must be an instantiated contract when the contract keeper is
+// set, as the hook could not be called otherwise.
+func (k Keeper) setBeforeSendHook(ctx context.Context, denom string, cosmwasmAddress string) error {
+	store := k.GetDenomPrefixStore(sdk.UnwrapSDKContext(ctx), denom)
+
+	if cosmwasmAddress == "" {
+		store.Delete([]byte(types.BeforeSendHookAddressKey))
+		return nil
+	}
+
+	contractAddr, err := sdk.AccAddressFromBech32(cosmwasmAddress)
+	if err != nil {
+		return err
+	}
+
+	if k.contractKeeper != nil && !k.contractKeeper.HasContractInfo(ctx, contractAddr) {
+		return errorsmod.Wrapf(types.ErrContractNotFound, "before send hook %s", cosmwasmAddress)
+	}
+
+	store.Set([]byte(types.BeforeSendHookAddressKey), []byte(cosmwasmAddress))
+	return nil
+}
+
+// BeforeSendRestriction is the x/bank send restriction calling the before send hook contract
+// of every factory denom transferred. The transfer fails when a contract call fails or runs
+// out of the before send hook gas limit.
+func (k Keeper) BeforeSendRestriction(ctx context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) (sdk.AccAddress, error) {
+	if k.contractKeeper == nil {
+		return toAddr, nil
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	for _, coin := range amt {
+		// avoid a store read for the denoms which cannot have a hook
+		if !strings.HasPrefix(coin.Denom, types.ModuleDenomPrefix+"/") {
+			continue
+		}
+
+		cosmwasmAddress := k.GetBeforeSendHook(sdkCtx, coin.Denom)
+		if cosmwasmAddress == "" {
+			continue
+		}
+
+		contractAddr, err := sdk.AccAddressFromBech32(cosmwasmAddress)
+		if err != nil {
+			return nil, err
+		}
+
+		msg := types.BlockBeforeSendSudoMsg{
+			BlockBeforeSend: types.BlockBeforeSendMsg{
+				From:   fromAddr.String(),
+				To:     toAddr.String(),
+				Amount: coin,
+			},
+		}
+		gasLimit := k.GetParams(sdkCtx).BeforeSendHookGasLimit
+		if err := k.sudoWithGasLimit(sdkCtx, contractAddr, msg, gasLimit, "tokenfactory before send hook"); err != nil {
+			return nil, errorsmod.Wrapf(types.ErrBeforeSendHookFailed, "denom %s, contract %s: %s", coin.Denom, cosmwasmAddress, err)
+		}
+	}
+
+	return toAddr, nil
+}
+
+var _ banktypes.SendRestrictionFn = Keeper{}.BeforeSendRestriction
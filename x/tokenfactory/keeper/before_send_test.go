@@ -0,0 +1,142 @@
+package keeper_test
+
+import (
+	"encoding/json"
+	"errors"
+
+	"github.com/cosmos/tokenfactory/x/tokenfactory/types"
+
+	"github.com/cosmos/gogoproto/proto"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// setupBeforeSendHook creates the default denom with a before send hook calling a mock
+// contract keeper, registered as the only bank send restriction.
+func (suite *KeeperTestSuite) setupBeforeSendHook() *mockContractKeeper {
+	suite.Require().NoError(suite.App.TokenFactoryKeeper.SetParams(suite.Ctx, types.DefaultParams()))
+
+	contractKeeper := &mockContractKeeper{key: suite.App.GetKey(types.StoreKey)}
+	suite.App.TokenFactoryKeeper.SetContractKeeper(contractKeeper)
+	suite.App.BankKeeper.ClearSendRestriction()
+	suite.App.BankKeeper.AppendSendRestriction(suite.App.TokenFactoryKeeper.BeforeSendRestriction)
+	suite.OverrideMsgServer(suite.App.TokenFactoryKeeper)
+
+	suite.CreateDefaultDenom()
+	_, err := suite.msgServer.Mint(suite.Ctx, types.NewMsgMint(suite.TestAccs[0].String(), sdk.NewInt64Coin(suite.defaultDenom, 100)))
+	suite.Require().NoError(err)
+
+	_, err = suite.msgServer.SetBeforeSendHook(suite.Ctx, types.NewMsgSetBeforeSendHook(suite.TestAccs[0].String(), suite.defaultDenom, suite.TestAccs[2].String()))
+	suite.Require().NoError(err)
+	return contractKeeper
+}
+
+func (suite *KeeperTestSuite) TestSetBeforeSendHookMsg() {
+	suite.SetupTest()
+	contractKeeper := &mockContractKeeper{key: suite.App.GetKey(types.StoreKey)}
+	suite.App.TokenFactoryKeeper.SetContractKeeper(contractKeeper)
+	suite.OverrideMsgServer(suite.App.TokenFactoryKeeper)
+	suite.CreateDefaultDenom()
+	hookAddr := suite.TestAccs[2].String()
+
+	// the hook must be an existing contract
+	contractKeeper.noContracts = true
+	_, err := suite.msgServer.SetBeforeSendHook(suite.Ctx, types.NewMsgSetBeforeSendHook(suite.TestAccs[0].String(), suite.defaultDenom, hookAddr))
+	suite.Require().ErrorIs(err, types.ErrContractNotFound)
+	contractKeeper.noContracts = false
+
+	// only the admin can set the hook
+	_, err = suite.msgServer.SetBeforeSendHook(suite.Ctx, types.NewMsgSetBeforeSendHook(suite.TestAccs[1].String(), suite.defaultDenom, hookAddr))
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	ctx := suite.Ctx.WithEventManager(sdk.NewEventManager())
+	_, err = suite.msgServer.SetBeforeSendHook(ctx, types.NewMsgSetBeforeSendHook(suite.TestAccs[0].String(), suite.defaultDenom, hookAddr))
+	suite.Require().NoError(err)
+	suite.AssertEventEmitted(ctx, proto.MessageName(&types.EventSetBeforeSendHook{}), 1)
+
+	res, err := suite.queryClient.BeforeSendHookAddress(suite.Ctx.Context(), &types.QueryBeforeSendHookAddressRequest{Denom: suite.defaultDenom})
+	suite.Require().NoError(err)
+	suite.Require().Equal(hookAddr, res.CosmwasmAddress)
+
+	// an empty address removes the hook
+	_, err = suite.msgServer.SetBeforeSendHook(suite.Ctx, types.NewMsgSetBeforeSendHook(suite.TestAccs[0].String(), suite.defaultDenom, ""))
+	suite.Require().NoError(err)
+
+	res, err = suite.queryClient.BeforeSendHookAddress(suite.Ctx.Context(), &types.QueryBeforeSendHookAddressRequest{Denom: suite.defaultDenom})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.CosmwasmAddress)
+
+	// the message requires the before send hook capability
+	suite.App.TokenFactoryKeeper.SetEnabledCapabilities(suite.Ctx, []string{})
+	suite.OverrideMsgServer(suite.App.TokenFactoryKeeper)
+	_, err = suite.msgServer.SetBeforeSendHook(suite.Ctx, types.NewMsgSetBeforeSendHook(suite.TestAccs[0].String(), suite.defaultDenom, hookAddr))
+	suite.Require().ErrorIs(err, types.ErrCapabilityNotEnabled)
+}
+
+func (suite *KeeperTestSuite) TestBeforeSendHook() {
+	suite.SetupTest()
+	contractKeeper := suite.setupBeforeSendHook()
+	from, to := suite.TestAccs[0], suite.TestAccs[1]
+
+	// the denom was minted in the setup before the hook was set
+	suite.Require().Len(contractKeeper.rawMsgs, 0)
+
+	coins := sdk.NewCoins(sdk.NewInt64Coin(suite.defaultDenom, 10), sdk.NewInt64Coin("utwo", 10))
+	suite.Require().NoError(suite.App.BankKeeper.SendCoins(suite.Ctx, from, to, coins))
+
+	// only the factory denom with a hook is sent to the contract
+	suite.Require().Len(contractKeeper.rawMsgs, 1)
+	var msg types.BlockBeforeSendSudoMsg
+	suite.Require().NoError(json.Unmarshal(contractKeeper.rawMsgs[0], &msg))
+	suite.Require().Equal(types.BlockBeforeSendSudoMsg{
+		BlockBeforeSend: types.BlockBeforeSendMsg{
+			From:   from.String(),
+			To:     to.String(),
+			Amount: sdk.NewInt64Coin(suite.defaultDenom, 10),
+		},
+	}, msg)
+
+	// the hook is also called for the tokenfactory operations moving funds
+	_, err := suite.msgServer.Burn(suite.Ctx, types.NewMsgBurn(from.String(), sdk.NewInt64Coin(suite.defaultDenom, 10)))
+	suite.Require().NoError(err)
+	suite.Require().Len(contractKeeper.rawMsgs, 2)
+}
+
+func (suite *KeeperTestSuite) TestBeforeSendHookFailure() {
+	for _, tc := range []struct {
+		desc    string
+		err     error
+		gasUsed uint64
+	}{
+		{
+			desc: "hook error",
+			err:  errors.New("transfer blocked"),
+		},
+		{
+			desc:    "hook out of gas",
+			gasUsed: types.DefaultParams().BeforeSendHookGasLimit + 1,
+		},
+	} {
+		suite.Run(tc.desc, func() {
+			suite.SetupTest()
+			contractKeeper := suite.setupBeforeSendHook()
+			contractKeeper.err = tc.err
+			contractKeeper.gasUsed = tc.gasUsed
+			from, to := suite.TestAccs[0], suite.TestAccs[1]
+
+			ctx := suite.Ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+			err := suite.App.BankKeeper.SendCoins(ctx, from, to, sdk.NewCoins(sdk.NewInt64Coin(suite.defaultDenom, 10)))
+			suite.Require().ErrorIs(err, types.ErrBeforeSendHookFailed)
+
+			// the state changes of the failed hook are discarded
+			suite.Require().Nil(suite.Ctx.KVStore(suite.App.GetKey(types.StoreKey)).Get([]byte("hook")))
+
+			// the gas used by the hook is bounded by the before send hook gas limit
+			if tc.gasUsed > 0 {
+				suite.Require().Less(ctx.GasMeter().GasConsumed(), tc.gasUsed+types.DefaultParams().BeforeSendHookGasLimit)
+			}
+		})
+	}
+}
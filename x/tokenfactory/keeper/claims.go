@@ -0,0 +1,196 @@
+package keeper
+
+import (
+	"time"
+
+	"github.com/cosmos/tokenfactory/x/tokenfactory/types"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// GetNextClaimCampaignID returns the id of the next claim campaign, ids start at 1
+func (k Keeper) GetNextClaimCampaignID(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get([]byte(types.NextClaimCampaignIDKey))
+	if bz == nil {
+		return 1
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+func (k Keeper) setNextClaimCampaignID(ctx sdk.Context, id uint64) {
+	ctx.KVStore(k.storeKey).Set([]byte(types.NextClaimCampaignIDKey), sdk.Uint64ToBigEndian(id))
+}
+
+// GetClaimCampaign returns a claim campaign by id
+func (k Keeper) GetClaimCampaign(ctx sdk.Context, campaignID uint64) (types.ClaimCampaign, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetClaimCampaignKey(campaignID))
+	if bz == nil {
+		return types.ClaimCampaign{}, false
+	}
+
+	campaign := types.ClaimCampaign{}
+	k.cdc.MustUnmarshal(bz, &campaign)
+	return campaign, true
+}
+
+func (k Keeper) setClaimCampaign(ctx sdk.Context, campaign types.ClaimCampaign) {
+	ctx.KVStore(k.storeKey).Set(types.GetClaimCampaignKey(campaign.Id), k.cdc.MustMarshal(&campaign))
+}
+
+// GetAllClaimCampaigns returns all the claim campaigns, ordered by id
+func (k Keeper) GetAllClaimCampaigns(ctx sdk.Context) []types.ClaimCampaign {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.ClaimCampaignPrefixKey+types.KeySeparator))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	campaigns := []types.ClaimCampaign{}
+	for ; iterator.Valid(); iterator.Next() {
+		campaign := types.ClaimCampaign{}
+		k.cdc.MustUnmarshal(iterator.Value(), &campaign)
+		campaigns = append(campaigns, campaign)
+	}
+	return campaigns
+}
+
+func (k Keeper) getClaimRecordStore(ctx sdk.Context, campaignID uint64) storetypes.KVStore {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), types.GetClaimRecordPrefix(campaignID))
+}
+
+// GetClaimRecord returns the amount claimed by an address in a campaign, if it has claimed
+func (k Keeper) GetClaimRecord(ctx sdk.Context, campaignID uint64, address string) (sdkmath.Int, bool) {
+	bz := k.getClaimRecordStore(ctx, campaignID).Get([]byte(address))
+	if bz == nil {
+		return sdkmath.ZeroInt(), false
+	}
+
+	var amount sdkmath.Int
+	if err := amount.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+	return amount, true
+}
+
+func (k Keeper) setClaimRecord(ctx sdk.Context, record types.ClaimRecord) {
+	bz, err := record.Amount.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	k.getClaimRecordStore(ctx, record.CampaignId).Set([]byte(record.Address), bz)
+}
+
+// GetAllClaimRecords returns the claims of all the campaigns
+func (k Keeper) GetAllClaimRecords(ctx sdk.Context) []types.ClaimRecord {
+	records := []types.ClaimRecord{}
+	for _, campaign := range k.GetAllClaimCampaigns(ctx) {
+		iterator := k.getClaimRecordStore(ctx, campaign.Id).Iterator(nil, nil)
+		for ; iterator.Valid(); iterator.Next() {
+			var amount sdkmath.Int
+			if err := amount.Unmarshal(iterator.Value()); err != nil {
+				panic(err)
+			}
+			records = append(records, types.ClaimRecord{
+				CampaignId: campaign.Id,
+				Address:    string(iterator.Key()),
+				Amount:     amount,
+			})
+		}
+		iterator.Close()
+	}
+	return records
+}
+
+// CreateClaimCampaign registers a claim campaign of a factory denom and returns its id. The
+// expiry, if any, must be after the block time.
+func (k Keeper) CreateClaimCampaign(ctx sdk.Context, creator, denom string, merkleRoot []byte, totalCap sdkmath.Int, expiry *time.Time) (uint64, error) {
+	if expiry != nil && !expiry.After(ctx.BlockTime()) {
+		return 0, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "expiry %s must be after the block time %s", expiry, ctx.BlockTime())
+	}
+
+	id := k.GetNextClaimCampaignID(ctx)
+	k.setNextClaimCampaignID(ctx, id+1)
+
+	k.setClaimCampaign(ctx, types.ClaimCampaign{
+		Id:         id,
+		Denom:      denom,
+		Creator:    creator,
+		MerkleRoot: merkleRoot,
+		TotalCap:   totalCap,
+		Claimed:    sdkmath.ZeroInt(),
+		Expiry:     expiry,
+	})
+	return id, nil
+}
+
+// Claim verifies the proof of the (claimer, amount) leaf against the merkle root of an active
+// campaign, and mints the amount to the claimer. Every address can claim once per campaign, and
+// claims are rejected once the denom has no admin.
+func (k Keeper) Claim(ctx sdk.Context, campaignID uint64, claimer string, amount sdkmath.Int, proof [][]byte) (sdk.Coin, error) {
+	campaign, found := k.GetClaimCampaign(ctx, campaignID)
+	if !found {
+		return sdk.Coin{}, errorsmod.Wrapf(types.ErrClaimCampaignNotFound, "id %d", campaignID)
+	}
+
+	if status := campaign.Status(ctx.BlockTime()); status != types.CLAIM_CAMPAIGN_STATUS_ACTIVE {
+		return sdk.Coin{}, errorsmod.Wrapf(types.ErrClaimCampaignInactive, "campaign %d is %s", campaignID, status)
+	}
+
+	authorityMetadata, err := k.GetAuthorityMetadata(ctx, campaign.Denom)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	// campaigns are created by the admin, they can not be claimed once the admin has been
+	// renounced
+	if authorityMetadata.GetAdmin() == "" {
+		return sdk.Coin{}, errorsmod.Wrapf(types.ErrUnauthorized, "denom %s has no admin", campaign.Denom)
+	}
+
+	if _, claimed := k.GetClaimRecord(ctx, campaignID, claimer); claimed {
+		return sdk.Coin{}, errorsmod.Wrapf(types.ErrAlreadyClaimed, "address %s, campaign %d", claimer, campaignID)
+	}
+
+	if !types.VerifyClaimProof(campaign.MerkleRoot, types.ClaimLeafHash(claimer, amount), proof) {
+		return sdk.Coin{}, errorsmod.Wrapf(types.ErrInvalidClaimProof, "address %s, amount %s", claimer, amount)
+	}
+
+	claimed := campaign.Claimed.Add(amount)
+	if claimed.GT(campaign.TotalCap) {
+		return sdk.Coin{}, errorsmod.Wrapf(types.ErrClaimCapExceeded, "claimed %s, cap %s", claimed, campaign.TotalCap)
+	}
+
+	coin := sdk.NewCoin(campaign.Denom, amount)
+	if err := k.mintTo(ctx, coin, claimer); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	campaign.Claimed = claimed
+	k.setClaimCampaign(ctx, campaign)
+	k.setClaimRecord(ctx, types.ClaimRecord{
+		CampaignId: campaignID,
+		Address:    claimer,
+		Amount:     amount,
+	})
+	return coin, nil
+}
+
+// CloseClaimCampaign closes a campaign, no more claims are accepted
+func (k Keeper) CloseClaimCampaign(ctx sdk.Context, campaignID uint64) (types.ClaimCampaign, error) {
+	campaign, found := k.GetClaimCampaign(ctx, campaignID)
+	if !found {
+		return types.ClaimCampaign{}, errorsmod.Wrapf(types.ErrClaimCampaignNotFound, "id %d", campaignID)
+	}
+
+	if campaign.Closed {
+		return types.ClaimCampaign{}, errorsmod.Wrapf(types.ErrClaimCampaignInactive, "campaign %d is already closed", campaignID)
+	}
+
+	campaign.Closed = true
+	k.setClaimCampaign(ctx, campaign)
+	return campaign, nil
+}
@@ -0,0 +1,194 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/cosmos/tokenfactory/x/tokenfactory/types"
+
+	"github.com/cosmos/gogoproto/proto"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// createClaimCampaign creates a campaign of the default denom for the amounts of the test
+// accounts 1 and 2, and returns its id and the proofs of the two claims.
+func (suite *KeeperTestSuite) createClaimCampaign(totalCap int64, expiry *time.Time) (uint64, [][][]byte) {
+	admin, claimer1, claimer2 := suite.TestAccs[0], suite.TestAccs[1], suite.TestAccs[2]
+
+	root, proofs := types.BuildClaimMerkleTree([][]byte{
+		types.ClaimLeafHash(claimer1.String(), sdkmath.NewInt(100)),
+		types.ClaimLeafHash(claimer2.String(), sdkmath.NewInt(50)),
+	})
+
+	res, err := suite.msgServer.CreateClaimCampaign(suite.Ctx, types.NewMsgCreateClaimCampaign(
+		admin.String(), suite.defaultDenom, root, sdkmath.NewInt(totalCap), expiry,
+	))
+	suite.Require().NoError(err)
+	return res.CampaignId, proofs
+}
+
+func (suite *KeeperTestSuite) TestCreateClaimCampaignMsg() {
+	suite.CreateDefaultDenom()
+	admin, other := suite.TestAccs[0], suite.TestAccs[1]
+	root := types.ClaimLeafHash(other.String(), sdkmath.NewInt(100))
+	past := suite.Ctx.BlockTime().Add(-time.Second)
+
+	for _, tc := range []struct {
+		desc   string
+		msg    *types.MsgCreateClaimCampaign
+		expErr error
+	}{
+		{
+			desc:   "denom does not exist",
+			msg:    types.NewMsgCreateClaimCampaign(admin.String(), suite.defaultDenom+"2", root, sdkmath.NewInt(100), nil),
+			expErr: types.ErrDenomDoesNotExist,
+		},
+		{
+			desc:   "sender is not the admin",
+			msg:    types.NewMsgCreateClaimCampaign(other.String(), suite.defaultDenom, root, sdkmath.NewInt(100), nil),
+			expErr: types.ErrUnauthorized,
+		},
+		{
+			desc:   "expiry in the past",
+			msg:    types.NewMsgCreateClaimCampaign(admin.String(), suite.defaultDenom, root, sdkmath.NewInt(100), &past),
+			expErr: sdkerrors.ErrInvalidRequest,
+		},
+	} {
+		suite.Run(tc.desc, func() {
+			ctx, _ := suite.Ctx.CacheContext()
+			_, err := suite.msgServer.CreateClaimCampaign(ctx, tc.msg)
+			suite.Require().ErrorIs(err, tc.expErr)
+		})
+	}
+
+	ctx := suite.Ctx.WithEventManager(sdk.NewEventManager())
+	for expectedID := uint64(1); expectedID <= 2; expectedID++ {
+		res, err := suite.msgServer.CreateClaimCampaign(ctx, types.NewMsgCreateClaimCampaign(admin.String(), suite.defaultDenom, root, sdkmath.NewInt(100), nil))
+		suite.Require().NoError(err)
+		suite.Require().Equal(expectedID, res.CampaignId)
+	}
+	suite.AssertEventEmitted(ctx, proto.MessageName(&types.EventCreateClaimCampaign{}), 2)
+
+	queryRes, err := suite.queryClient.ClaimCampaign(suite.Ctx.Context(), &types.QueryClaimCampaignRequest{CampaignId: 2})
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.CLAIM_CAMPAIGN_STATUS_ACTIVE, queryRes.Status)
+	suite.Require().Equal(types.ClaimCampaign{
+		Id:         2,
+		Denom:      suite.defaultDenom,
+		Creator:    admin.String(),
+		MerkleRoot: root,
+		TotalCap:   sdkmath.NewInt(100),
+		Claimed:    sdkmath.ZeroInt(),
+	}, queryRes.Campaign)
+
+	_, err = suite.queryClient.ClaimCampaign(suite.Ctx.Context(), &types.QueryClaimCampaignRequest{CampaignId: 3})
+	suite.Require().ErrorContains(err, types.ErrClaimCampaignNotFound.Error())
+}
+
+func (suite *KeeperTestSuite) TestClaimMsg() {
+	suite.CreateDefaultDenom()
+	claimer1, claimer2 := suite.TestAccs[1], suite.TestAccs[2]
+	campaignID, proofs := suite.createClaimCampaign(1000, nil)
+
+	for _, tc := range []struct {
+		desc   string
+		msg    *types.MsgClaim
+		expErr error
+	}{
+		{
+			desc:   "campaign not found",
+			msg:    types.NewMsgClaim(claimer1.String(), campaignID+1, sdkmath.NewInt(100), proofs[0]),
+			expErr: types.ErrClaimCampaignNotFound,
+		},
+		{
+			desc:   "wrong amount",
+			msg:    types.NewMsgClaim(claimer1.String(), campaignID, sdkmath.NewInt(101), proofs[0]),
+			expErr: types.ErrInvalidClaimProof,
+		},
+		{
+			desc:   "proof of another address",
+			msg:    types.NewMsgClaim(claimer1.String(), campaignID, sdkmath.NewInt(50), proofs[1]),
+			expErr: types.ErrInvalidClaimProof,
+		},
+	} {
+		suite.Run(tc.desc, func() {
+			ctx, _ := suite.Ctx.CacheContext()
+			_, err := suite.msgServer.Claim(ctx, tc.msg)
+			suite.Require().ErrorIs(err, tc.expErr)
+		})
+	}
+
+	// campaigns can not be claimed once the admin is renounced
+	renouncedCtx, _ := suite.Ctx.CacheContext()
+	_, err := suite.msgServer.ChangeAdmin(renouncedCtx, types.NewMsgChangeAdmin(suite.TestAccs[0].String(), suite.defaultDenom, ""))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.Claim(renouncedCtx, types.NewMsgClaim(claimer1.String(), campaignID, sdkmath.NewInt(100), proofs[0]))
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	ctx := suite.Ctx.WithEventManager(sdk.NewEventManager())
+	res, err := suite.msgServer.Claim(ctx, types.NewMsgClaim(claimer1.String(), campaignID, sdkmath.NewInt(100), proofs[0]))
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt64Coin(suite.defaultDenom, 100), res.Claimed)
+	suite.Require().Equal(res.Claimed, suite.App.BankKeeper.GetBalance(ctx, claimer1, suite.defaultDenom))
+	suite.AssertEventEmitted(ctx, proto.MessageName(&types.EventClaim{}), 1)
+	suite.AssertEventEmitted(ctx, proto.MessageName(&types.EventMint{}), 1)
+
+	// every address claims once
+	_, err = suite.msgServer.Claim(suite.Ctx, types.NewMsgClaim(claimer1.String(), campaignID, sdkmath.NewInt(100), proofs[0]))
+	suite.Require().ErrorIs(err, types.ErrAlreadyClaimed)
+
+	statusRes, err := suite.queryClient.ClaimStatus(suite.Ctx.Context(), &types.QueryClaimStatusRequest{CampaignId: campaignID, Address: claimer1.String()})
+	suite.Require().NoError(err)
+	suite.Require().True(statusRes.Claimed)
+	suite.Require().Equal(sdkmath.NewInt(100), statusRes.Amount)
+
+	statusRes, err = suite.queryClient.ClaimStatus(suite.Ctx.Context(), &types.QueryClaimStatusRequest{CampaignId: campaignID, Address: claimer2.String()})
+	suite.Require().NoError(err)
+	suite.Require().False(statusRes.Claimed)
+	suite.Require().Equal(sdkmath.ZeroInt(), statusRes.Amount)
+
+	campaign, found := suite.App.TokenFactoryKeeper.GetClaimCampaign(suite.Ctx, campaignID)
+	suite.Require().True(found)
+	suite.Require().Equal(sdkmath.NewInt(100), campaign.Claimed)
+}
+
+func (suite *KeeperTestSuite) TestClaimCampaignLimits() {
+	suite.CreateDefaultDenom()
+	admin, claimer1, claimer2 := suite.TestAccs[0], suite.TestAccs[1], suite.TestAccs[2]
+
+	// the cap is reached by the first claim
+	campaignID, proofs := suite.createClaimCampaign(120, nil)
+	_, err := suite.msgServer.Claim(suite.Ctx, types.NewMsgClaim(claimer1.String(), campaignID, sdkmath.NewInt(100), proofs[0]))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.Claim(suite.Ctx, types.NewMsgClaim(claimer2.String(), campaignID, sdkmath.NewInt(50), proofs[1]))
+	suite.Require().ErrorIs(err, types.ErrClaimCapExceeded)
+
+	// the campaign expires
+	expiry := suite.Ctx.BlockTime().Add(time.Hour)
+	campaignID, proofs = suite.createClaimCampaign(1000, &expiry)
+	ctx := suite.Ctx.WithBlockTime(expiry)
+	_, err = suite.msgServer.Claim(ctx, types.NewMsgClaim(claimer1.String(), campaignID, sdkmath.NewInt(100), proofs[0]))
+	suite.Require().ErrorIs(err, types.ErrClaimCampaignInactive)
+
+	queryRes, err := suite.App.TokenFactoryKeeper.ClaimCampaign(ctx, &types.QueryClaimCampaignRequest{CampaignId: campaignID})
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.CLAIM_CAMPAIGN_STATUS_EXPIRED, queryRes.Status)
+
+	// the campaign is closed by the denom admin
+	campaignID, proofs = suite.createClaimCampaign(1000, nil)
+	_, err = suite.msgServer.CloseClaimCampaign(suite.Ctx, types.NewMsgCloseClaimCampaign(claimer1.String(), campaignID))
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	ctx = suite.Ctx.WithEventManager(sdk.NewEventManager())
+	_, err = suite.msgServer.CloseClaimCampaign(ctx, types.NewMsgCloseClaimCampaign(admin.String(), campaignID))
+	suite.Require().NoError(err)
+	suite.AssertEventEmitted(ctx, proto.MessageName(&types.EventCloseClaimCampaign{}), 1)
+
+	_, err = suite.msgServer.CloseClaimCampaign(suite.Ctx, types.NewMsgCloseClaimCampaign(admin.String(), campaignID))
+	suite.Require().ErrorIs(err, types.ErrClaimCampaignInactive)
+	_, err = suite.msgServer.Claim(suite.Ctx, types.NewMsgClaim(claimer1.String(), campaignID, sdkmath.NewInt(100), proofs[0]))
+	suite.Require().ErrorIs(err, types.ErrClaimCampaignInactive)
+}
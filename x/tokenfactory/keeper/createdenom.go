@@ -49,7 +49,7 @@ func (k Keeper) createDenomAfterValidation(ctx sdk.Context, creatorAddr string,
 	}
 
 	k.addDenomFromCreator(ctx, creatorAddr, denom)
-	return nil
+	return k.Hooks().AfterDenomCreated(ctx, denom, creatorAddr)
 }
 
 func (k Keeper) validateCreateDenom(ctx sdk.Context, creatorAddr string, subdenom string) (newTokenDenom string, err error) {
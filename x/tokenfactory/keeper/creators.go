@@ -13,16 +13,26 @@ func (k Keeper) addDenomFromCreator(ctx context.Context, creator, denom string)
 }
 
 func (k Keeper) GetDenomsFromCreator(ctx context.Context, creator string) []string {
+	denoms := []string{}
+	k.IterateDenomsFromCreator(ctx, creator, func(denom string) bool {
+		denoms = append(denoms, denom)
+		return false
+	})
+	return denoms
+}
+
+// IterateDenomsFromCreator calls cb for every denom created by creator until cb returns true.
+func (k Keeper) IterateDenomsFromCreator(ctx context.Context, creator string, cb func(denom string) (stop bool)) {
 	store := k.GetCreatorPrefixStore(sdk.UnwrapSDKContext(ctx), creator)
 
 	iterator := store.Iterator(nil, nil)
 	defer iterator.Close()
 
-	denoms := []string{}
 	for ; iterator.Valid(); iterator.Next() {
-		denoms = append(denoms, string(iterator.Key()))
+		if cb(string(iterator.Key())) {
+			return
+		}
 	}
-	return denoms
 }
 
 func (k Keeper) GetAllDenomsIterator(ctx context.Context) store.Iterator {
@@ -0,0 +1,202 @@
+package keeper
+
+import (
+	"encoding/json"
+
+	"github.com/cosmos/tokenfactory/x/tokenfactory/types"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (k Keeper) getCW20BridgeStore(ctx sdk.Context) storetypes.KVStore {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), types.GetCW20BridgePrefix())
+}
+
+func (k Keeper) getCW20ContractStore(ctx sdk.Context) storetypes.KVStore {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), types.GetCW20ContractPrefix())
+}
+
+// GetCW20Bridge returns the CW20 bridge of a denom, if the denom is bound to a CW20 contract
+func (k Keeper) GetCW20Bridge(ctx sdk.Context, denom string) (types.CW20Bridge, bool) {
+	bz := k.getCW20BridgeStore(ctx).Get([]byte(denom))
+	if bz == nil {
+		return types.CW20Bridge{}, false
+	}
+
+	bridge := types.CW20Bridge{}
+	k.cdc.MustUnmarshal(bz, &bridge)
+	return bridge, true
+}
+
+// GetCW20BridgeByContract returns the CW20 bridge of the denom a CW20 contract is bound to
+func (k Keeper) GetCW20BridgeByContract(ctx sdk.Context, contractAddress string) (types.CW20Bridge, bool) {
+	denom := k.getCW20ContractStore(ctx).Get([]byte(contractAddress))
+	if denom == nil {
+		return types.CW20Bridge{}, false
+	}
+	return k.GetCW20Bridge(ctx, string(denom))
+}
+
+// setCW20Bridge stores the CW20 bridge of a denom, and indexes the denom under its contract
+func (k Keeper) setCW20Bridge(ctx sdk.Context, bridge types.CW20Bridge) {
+	k.getCW20BridgeStore(ctx).Set([]byte(bridge.Denom), k.cdc.MustMarshal(&bridge))
+	k.getCW20ContractStore(ctx).Set([]byte(bridge.ContractAddress), []byte(bridge.Denom))
+}
+
+// GetAllCW20Bridges returns all the CW20 bridges, ordered by denom
+func (k Keeper) GetAllCW20Bridges(ctx sdk.Context) []types.CW20Bridge {
+	iterator := k.getCW20BridgeStore(ctx).Iterator(nil, nil)
+	defer iterator.Close()
+
+	bridges := []types.CW20Bridge{}
+	for ; iterator.Valid(); iterator.Next() {
+		bridge := types.CW20Bridge{}
+		k.cdc.MustUnmarshal(iterator.Value(), &bridge)
+		bridges = append(bridges, bridge)
+	}
+	return bridges
+}
+
+// RegisterCW20 binds a factory denom to a CW20 contract. The denom must have no supply, so that
+// all of its supply is backed by the CW20 tokens sent to the bridge address, and neither the
+// denom nor the contract can already be bound.
+func (k Keeper) RegisterCW20(ctx sdk.Context, denom, contractAddress string) error {
+	if _, found := k.GetCW20Bridge(ctx, denom); found {
+		return errorsmod.Wrapf(types.ErrInvalidCW20Bridge, "denom %s is already bound to a cw20 contract", denom)
+	}
+	if bridge, found := k.GetCW20BridgeByContract(ctx, contractAddress); found {
+		return errorsmod.Wrapf(types.ErrInvalidCW20Bridge, "cw20 contract %s is already bound to denom %s", contractAddress, bridge.Denom)
+	}
+	if _, found := k.GetVault(ctx, denom); found {
+		return errorsmod.Wrapf(types.ErrInvalidCW20Bridge, "denom %s is backed by its vault", denom)
+	}
+	if _, found := k.GetBasket(ctx, denom); found {
+		return errorsmod.Wrapf(types.ErrInvalidCW20Bridge, "denom %s is backed by its basket", denom)
+	}
+	if supply := k.bankKeeper.GetSupply(ctx, denom); !supply.IsZero() {
+		return errorsmod.Wrapf(types.ErrInvalidCW20Bridge, "denom %s has a supply of %s", denom, supply.Amount)
+	}
+
+	bridge := types.CW20Bridge{
+		Denom:           denom,
+		ContractAddress: contractAddress,
+		Escrowed:        sdkmath.ZeroInt(),
+	}
+	if err := bridge.Validate(); err != nil {
+		return err
+	}
+
+	k.setCW20Bridge(ctx, bridge)
+	return nil
+}
+
+// DepositCW20 mints the denom bound to a CW20 contract for an amount of CW20 tokens the contract
+// sent to the bridge address on behalf of the depositor. It is called by the wasm bindings on the
+// `Send` hooks of the CW20 contracts, and emits EventMint and EventDepositCW20 as no message
+// server handles it.
+func (k Keeper) DepositCW20(ctx sdk.Context, contractAddress, depositor string, amount sdkmath.Int) (sdk.Coin, error) {
+	bridge, found := k.GetCW20BridgeByContract(ctx, contractAddress)
+	if !found {
+		return sdk.Coin{}, errorsmod.Wrapf(types.ErrCW20BridgeNotFound, "contract %s", contractAddress)
+	}
+
+	if amount.IsNil() || !amount.IsPositive() {
+		return sdk.Coin{}, errorsmod.Wrap(sdkerrors.ErrInvalidCoins, "amount must be positive")
+	}
+
+	// the escrow is updated before the mint, which checks the supply against it
+	bridge.Escrowed = bridge.Escrowed.Add(amount)
+	k.setCW20Bridge(ctx, bridge)
+
+	minted := sdk.NewCoin(bridge.Denom, amount)
+	if err := k.mintTo(ctx, minted, depositor); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventMint{
+		MintToAddress: depositor,
+		Amount:        minted,
+	}); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventDepositCW20{
+		ContractAddress: contractAddress,
+		Depositor:       depositor,
+		Minted:          minted,
+	}); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	return minted, nil
+}
+
+// WithdrawCW20 burns an amount of a denom bound to a CW20 contract from the withdrawer, and
+// transfers the same amount of CW20 tokens from the bridge address to the withdrawer. It returns
+// the bridge of the denom.
+func (k Keeper) WithdrawCW20(ctx sdk.Context, withdrawer string, amount sdk.Coin) (types.CW20Bridge, error) {
+	bridge, found := k.GetCW20Bridge(ctx, amount.Denom)
+	if !found {
+		return types.CW20Bridge{}, errorsmod.Wrapf(types.ErrCW20BridgeNotFound, "denom %s", amount.Denom)
+	}
+
+	if k.contractKeeper == nil {
+		return types.CW20Bridge{}, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "no contract keeper to transfer the cw20 tokens")
+	}
+
+	if amount.Amount.GT(bridge.Escrowed) {
+		return types.CW20Bridge{}, errorsmod.Wrapf(sdkerrors.ErrInsufficientFunds, "bridge of %s escrows %s", amount.Denom, bridge.Escrowed)
+	}
+
+	// the escrow is updated before the burn, which checks the supply against it
+	bridge.Escrowed = bridge.Escrowed.Sub(amount.Amount)
+	k.setCW20Bridge(ctx, bridge)
+
+	if err := k.burnFrom(ctx, amount, withdrawer); err != nil {
+		return types.CW20Bridge{}, err
+	}
+
+	msg, err := json.Marshal(types.CW20ExecuteMsg{
+		Transfer: &types.CW20Transfer{
+			Recipient: withdrawer,
+			Amount:    amount.Amount,
+		},
+	})
+	if err != nil {
+		return types.CW20Bridge{}, err
+	}
+
+	contractAddr, err := sdk.AccAddressFromBech32(bridge.ContractAddress)
+	if err != nil {
+		return types.CW20Bridge{}, err
+	}
+
+	if _, err := k.contractKeeper.Execute(ctx, contractAddr, types.CW20BridgeAddress, msg, sdk.NewCoins()); err != nil {
+		return types.CW20Bridge{}, errorsmod.Wrapf(err, "transferring the cw20 tokens of %s", bridge.ContractAddress)
+	}
+
+	return bridge, nil
+}
+
+// CheckCW20Backing returns an error if the supply of a denom bound to a CW20 contract is not its
+// escrowed amount of CW20 tokens. It is checked after every mint and burn, so that a bridged
+// denom can only be minted and burned through its bridge. Denoms without bridge are not checked.
+func (k Keeper) CheckCW20Backing(ctx sdk.Context, denom string) error {
+	bridge, found := k.GetCW20Bridge(ctx, denom)
+	if !found {
+		return nil
+	}
+
+	supply := k.bankKeeper.GetSupply(ctx, denom).Amount
+	if !supply.Equal(bridge.Escrowed) {
+		return errorsmod.Wrapf(types.ErrCW20Backing, "supply %s%s, escrowed %s of %s", supply, denom, bridge.Escrowed, bridge.ContractAddress)
+	}
+
+	return nil
+}
@@ -0,0 +1,175 @@
+package keeper_test
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/cosmos/tokenfactory/x/tokenfactory/keeper"
+	"github.com/cosmos/tokenfactory/x/tokenfactory/types"
+
+	"github.com/cosmos/gogoproto/proto"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+func (suite *KeeperTestSuite) TestCW20BridgeMsgs() {
+	contractKeeper := &mockContractKeeper{key: suite.App.GetKey(types.StoreKey)}
+	suite.App.TokenFactoryKeeper.SetContractKeeper(contractKeeper)
+	suite.OverrideMsgServer(suite.App.TokenFactoryKeeper)
+	suite.CreateDefaultDenom()
+	admin, holder, contract := suite.TestAccs[0], suite.TestAccs[1], suite.TestAccs[2]
+	denom := suite.defaultDenom
+
+	// only the admin can bind its denom
+	cacheCtx, _ := suite.Ctx.CacheContext()
+	_, err := suite.msgServer.RegisterCW20(cacheCtx, types.NewMsgRegisterCW20(holder.String(), denom, contract.String()))
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	ctx := suite.Ctx.WithEventManager(sdk.NewEventManager())
+	_, err = suite.msgServer.RegisterCW20(ctx, types.NewMsgRegisterCW20(admin.String(), denom, contract.String()))
+	suite.Require().NoError(err)
+	suite.AssertEventEmitted(ctx, proto.MessageName(&types.EventRegisterCW20{}), 1)
+
+	// neither the denom nor the contract can be bound twice
+	res, err := suite.msgServer.CreateDenom(suite.Ctx, types.NewMsgCreateDenom(admin.String(), "litecoin"))
+	suite.Require().NoError(err)
+	otherDenom := res.GetNewTokenDenom()
+	for _, tc := range []struct {
+		desc     string
+		denom    string
+		contract sdk.AccAddress
+	}{
+		{desc: "bound denom", denom: denom, contract: holder},
+		{desc: "bound contract", denom: otherDenom, contract: contract},
+	} {
+		suite.Run(tc.desc, func() {
+			ctx, _ := suite.Ctx.CacheContext()
+			_, err := suite.msgServer.RegisterCW20(ctx, types.NewMsgRegisterCW20(admin.String(), tc.denom, tc.contract.String()))
+			suite.Require().ErrorIs(err, types.ErrInvalidCW20Bridge)
+		})
+	}
+
+	// depositing cw20 tokens mints the bound denom to their sender
+	ctx = suite.Ctx.WithEventManager(sdk.NewEventManager())
+	minted, err := suite.App.TokenFactoryKeeper.DepositCW20(ctx, contract.String(), holder.String(), sdkmath.NewInt(100))
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt64Coin(denom, 100), minted)
+	suite.AssertEventEmitted(ctx, proto.MessageName(&types.EventMint{}), 1)
+	suite.AssertEventEmitted(ctx, proto.MessageName(&types.EventDepositCW20{}), 1)
+	suite.Require().Equal(sdkmath.NewInt(100), suite.App.BankKeeper.GetBalance(suite.Ctx, holder, denom).Amount)
+
+	cacheCtx, _ = suite.Ctx.CacheContext()
+	_, err = suite.App.TokenFactoryKeeper.DepositCW20(cacheCtx, holder.String(), holder.String(), sdkmath.NewInt(100))
+	suite.Require().ErrorIs(err, types.ErrCW20BridgeNotFound)
+
+	// the bridged denom can not be minted or burned by its admin
+	cacheCtx, _ = suite.Ctx.CacheContext()
+	_, err = suite.msgServer.Mint(cacheCtx, types.NewMsgMint(admin.String(), sdk.NewInt64Coin(denom, 100)))
+	suite.Require().ErrorIs(err, types.ErrCW20Backing)
+	cacheCtx, _ = suite.Ctx.CacheContext()
+	_, err = suite.msgServer.Burn(cacheCtx, types.NewMsgBurnFrom(admin.String(), sdk.NewInt64Coin(denom, 10), holder.String()))
+	suite.Require().ErrorIs(err, types.ErrCW20Backing)
+
+	// nor redeemed off-chain
+	_, err = suite.msgServer.RequestRedemption(cacheCtx, types.NewMsgRequestRedemption(holder.String(), sdk.NewInt64Coin(denom, 10), "iban"))
+	suite.Require().ErrorIs(err, sdkerrors.ErrInvalidRequest)
+
+	for _, tc := range []struct {
+		desc   string
+		amount sdk.Coin
+		expErr error
+	}{
+		{desc: "more than the escrow", amount: sdk.NewInt64Coin(denom, 101), expErr: sdkerrors.ErrInsufficientFunds},
+		{desc: "not bridged", amount: sdk.NewInt64Coin(otherDenom, 1), expErr: types.ErrCW20BridgeNotFound},
+	} {
+		suite.Run(tc.desc, func() {
+			ctx, _ := suite.Ctx.CacheContext()
+			_, err := suite.msgServer.WithdrawCW20(ctx, types.NewMsgWithdrawCW20(holder.String(), tc.amount))
+			suite.Require().ErrorIs(err, tc.expErr)
+		})
+	}
+	suite.Require().Empty(contractKeeper.executions)
+
+	// withdrawing burns the denom, and transfers the cw20 tokens from the bridge address
+	ctx = suite.Ctx.WithEventManager(sdk.NewEventManager())
+	_, err = suite.msgServer.WithdrawCW20(ctx, types.NewMsgWithdrawCW20(holder.String(), sdk.NewInt64Coin(denom, 40)))
+	suite.Require().NoError(err)
+	suite.AssertEventEmitted(ctx, proto.MessageName(&types.EventBurn{}), 1)
+	suite.AssertEventEmitted(ctx, proto.MessageName(&types.EventWithdrawCW20{}), 1)
+	suite.Require().Equal(sdkmath.NewInt(60), suite.App.BankKeeper.GetBalance(suite.Ctx, holder, denom).Amount)
+	suite.Require().Equal([]mockExecution{{
+		contract: contract,
+		caller:   types.CW20BridgeAddress,
+		msg:      []byte(fmt.Sprintf(`{"transfer":{"recipient":"%s","amount":"40"}}`, holder)),
+	}}, contractKeeper.executions)
+
+	// a failed cw20 transfer fails the withdrawal
+	contractKeeper.err = errors.New("insufficient cw20 balance")
+	cacheCtx, _ = suite.Ctx.CacheContext()
+	_, err = suite.msgServer.WithdrawCW20(cacheCtx, types.NewMsgWithdrawCW20(holder.String(), sdk.NewInt64Coin(denom, 10)))
+	suite.Require().ErrorIs(err, contractKeeper.err)
+	contractKeeper.err = nil
+
+	queryRes, err := suite.queryClient.CW20Bridge(suite.Ctx.Context(), &types.QueryCW20BridgeRequest{Denom: denom})
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.CW20Bridge{Denom: denom, ContractAddress: contract.String(), Escrowed: sdkmath.NewInt(60)}, queryRes.Cw20Bridge)
+	suite.Require().Equal(types.CW20BridgeAddress.String(), queryRes.Address)
+	suite.Require().Equal(sdkmath.NewInt(60), suite.App.BankKeeper.GetSupply(suite.Ctx, denom).Amount)
+
+	_, err = suite.queryClient.CW20Bridge(suite.Ctx.Context(), &types.QueryCW20BridgeRequest{Denom: otherDenom})
+	suite.Require().Error(err)
+
+	msg, broken := keeper.CW20BackingInvariant(suite.App.TokenFactoryKeeper)(suite.Ctx)
+	suite.Require().False(broken, msg)
+}
+
+func (suite *KeeperTestSuite) TestRegisterCW20Invalid() {
+	suite.CreateDefaultDenom()
+	admin := suite.TestAccs[0]
+
+	_, err := suite.msgServer.Mint(suite.Ctx, types.NewMsgMint(admin.String(), sdk.NewInt64Coin(suite.defaultDenom, 100)))
+	suite.Require().NoError(err)
+	vaultRes, err := suite.msgServer.CreateVault(suite.Ctx, types.NewMsgCreateVault(admin.String(), "wtwo", "utwo", sdkmath.OneInt()))
+	suite.Require().NoError(err)
+
+	for _, tc := range []struct {
+		desc  string
+		denom string
+	}{
+		{desc: "denom with supply", denom: suite.defaultDenom},
+		{desc: "vault denom", denom: vaultRes.GetNewTokenDenom()},
+	} {
+		suite.Run(tc.desc, func() {
+			ctx, _ := suite.Ctx.CacheContext()
+			_, err := suite.msgServer.RegisterCW20(ctx, types.NewMsgRegisterCW20(admin.String(), tc.denom, suite.TestAccs[2].String()))
+			suite.Require().ErrorIs(err, types.ErrInvalidCW20Bridge)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestCW20BridgesQuery() {
+	for i, subdenom := range []string{"wone", "wtwo", "wthree"} {
+		res, err := suite.msgServer.CreateDenom(suite.Ctx, types.NewMsgCreateDenom(suite.TestAccs[0].String(), subdenom))
+		suite.Require().NoError(err)
+		_, err = suite.msgServer.RegisterCW20(suite.Ctx, types.NewMsgRegisterCW20(suite.TestAccs[0].String(), res.GetNewTokenDenom(), suite.TestAccs[i].String()))
+		suite.Require().NoError(err)
+	}
+
+	res, err := suite.queryClient.CW20Bridges(suite.Ctx.Context(), &types.QueryCW20BridgesRequest{
+		Pagination: &query.PageRequest{Limit: 2, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Cw20Bridges, 2)
+	suite.Require().Equal(uint64(3), res.Pagination.Total)
+
+	res, err = suite.queryClient.CW20Bridges(suite.Ctx.Context(), &types.QueryCW20BridgesRequest{
+		Pagination: &query.PageRequest{Key: res.Pagination.NextKey},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Cw20Bridges, 1)
+	suite.Require().Equal(suite.App.TokenFactoryKeeper.GetAllCW20Bridges(suite.Ctx)[2], res.Cw20Bridges[0])
+}
@@ -0,0 +1,118 @@
+package keeper
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/cosmos/tokenfactory/x/tokenfactory/types"
+
+	"github.com/cosmos/gogoproto/proto"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// GetDenomHook returns the hook contract of a specific denom, if any
+func (k Keeper) GetDenomHook(ctx context.Context, denom string) (types.DenomHook, bool) {
+	bz := k.GetDenomPrefixStore(sdk.UnwrapSDKContext(ctx), denom).Get([]byte(types.DenomHookKey))
+	if bz == nil {
+		return types.DenomHook{}, false
+	}
+
+	hook := types.DenomHook{}
+	k.cdc.MustUnmarshal(bz, &hook)
+	return hook, true
+}
+
+// setDenomHook stores the hook contract of a specific denom, a nil hook removes it
+func (k Keeper) setDenomHook(ctx context.Context, denom string, hook *types.DenomHook) error {
+	store := k.GetDenomPrefixStore(sdk.UnwrapSDKContext(ctx), denom)
+
+	if hook == nil {
+		store.Delete([]byte(types.DenomHookKey))
+		return nil
+	}
+
+	if err := hook.Validate(); err != nil {
+		return err
+	}
+
+	bz, err := proto.Marshal(hook)
+	if err != nil {
+		return err
+	}
+
+	store.Set([]byte(types.DenomHookKey), bz)
+	return nil
+}
+
+// callDenomHook sends msg with sudo to the hook contract of denom, if any. The call is
+// bounded by the denom hook gas limit param and its state changes are discarded when it
+// fails. A failure is only returned for strict hooks, otherwise it is logged and
+// EventDenomHookFailed is emitted.
+func (k Keeper) callDenomHook(ctx sdk.Context, denom string, msg types.DenomHookSudoMsg) error {
+	hook, found := k.GetDenomHook(ctx, denom)
+	if !found || k.contractKeeper == nil {
+		return nil
+	}
+
+	err := k.sudoDenomHook(ctx, hook, msg)
+	if err == nil {
+		return nil
+	}
+
+	if hook.Strict {
+		return errorsmod.Wrapf(types.ErrDenomHookFailed, "denom %s, contract %s: %s", denom, hook.ContractAddress, err)
+	}
+
+	k.Logger(ctx).Error("denom hook failed", "denom", denom, "contract", hook.ContractAddress, "error", err)
+	return ctx.EventManager().EmitTypedEvent(&types.EventDenomHookFailed{
+		Denom:           denom,
+		ContractAddress: hook.ContractAddress,
+	})
+}
+
+func (k Keeper) sudoDenomHook(ctx sdk.Context, hook types.DenomHook, msg types.DenomHookSudoMsg) error {
+	contractAddr, err := sdk.AccAddressFromBech32(hook.ContractAddress)
+	if err != nil {
+		return err
+	}
+
+	return k.sudoWithGasLimit(ctx, contractAddr, msg, k.GetParams(ctx).DenomHookGasLimit, "tokenfactory denom hook")
+}
+
+// sudoWithGasLimit sends msg JSON encoded with sudo to the contract, in a cached context with
+// a gas meter bounded by gasLimit. The state changes of the contract are only written when
+// the call succeeds, and the gas it used is charged to ctx in every case.
+func (k Keeper) sudoWithGasLimit(ctx sdk.Context, contractAddr sdk.AccAddress, msg any, gasLimit uint64, descriptor string) (err error) {
+	bz, err := json.Marshal(msg)
+	if err != nil {
+		return err
+	}
+
+	cacheCtx, write := ctx.CacheContext()
+	cacheCtx = cacheCtx.WithGasMeter(storetypes.NewGasMeter(gasLimit))
+
+	defer func() {
+		// charge the gas used by the contract even when it ran out of gas
+		ctx.GasMeter().ConsumeGas(cacheCtx.GasMeter().GasConsumedToLimit(), descriptor)
+
+		if r := recover(); r != nil {
+			oog, ok := r.(storetypes.ErrorOutOfGas)
+			if !ok {
+				panic(r)
+			}
+			err = errorsmod.Wrapf(sdkerrors.ErrOutOfGas, "%s ran out of gas: %s", descriptor, oog.Descriptor)
+		}
+	}()
+
+	if _, err := k.contractKeeper.Sudo(cacheCtx, contractAddr, bz); err != nil {
+		return err
+	}
+
+	write()
+	return nil
+}
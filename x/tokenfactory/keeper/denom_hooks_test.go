@@ -0,0 +1,216 @@
+package keeper_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"github.com/cosmos/tokenfactory/x/tokenfactory/types"
+
+	"github.com/cosmos/gogoproto/proto"
+
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// mockContractKeeper records the sudo calls of the denom hooks and the before send hooks, and
+// the executions of the cw20 bridge.
+type mockContractKeeper struct {
+	key        *storetypes.KVStoreKey
+	calls      []types.DenomHookSudoMsg
+	rawMsgs    [][]byte
+	executions []mockExecution
+	err        error
+	gasUsed    uint64
+	// noContracts makes the mock report that no contract exists
+	noContracts bool
+}
+
+type mockExecution struct {
+	contract sdk.AccAddress
+	caller   sdk.AccAddress
+	msg      []byte
+}
+
+func (m *mockContractKeeper) Execute(_ sdk.Context, contractAddress, caller sdk.AccAddress, msg []byte, _ sdk.Coins) ([]byte, error) {
+	m.executions = append(m.executions, mockExecution{contract: contractAddress, caller: caller, msg: msg})
+	return nil, m.err
+}
+
+func (m *mockContractKeeper) HasContractInfo(_ context.Context, _ sdk.AccAddress) bool {
+	return !m.noContracts
+}
+
+func (m *mockContractKeeper) Sudo(ctx sdk.Context, _ sdk.AccAddress, msg []byte) ([]byte, error) {
+	m.rawMsgs = append(m.rawMsgs, msg)
+
+	var sudoMsg types.DenomHookSudoMsg
+	if err := json.Unmarshal(msg, &sudoMsg); err != nil {
+		return nil, err
+	}
+	m.calls = append(m.calls, sudoMsg)
+
+	// write to the store, to check it is discarded when the hook fails
+	ctx.KVStore(m.key).Set([]byte("hook"), []byte("called"))
+	ctx.GasMeter().ConsumeGas(m.gasUsed, "mock hook")
+	return nil, m.err
+}
+
+func (suite *KeeperTestSuite) setupDenomHook(strict bool) *mockContractKeeper {
+	suite.Require().NoError(suite.App.TokenFactoryKeeper.SetParams(suite.Ctx, types.DefaultParams()))
+
+	contractKeeper := &mockContractKeeper{key: suite.App.GetKey(types.StoreKey)}
+	suite.App.TokenFactoryKeeper.SetContractKeeper(contractKeeper)
+	suite.OverrideMsgServer(suite.App.TokenFactoryKeeper)
+
+	res, err := suite.msgServer.CreateDenom(suite.Ctx, &types.MsgCreateDenom{
+		Sender:   suite.TestAccs[0].String(),
+		Subdenom: "bitcoin",
+		DenomHook: &types.DenomHook{
+			ContractAddress: suite.TestAccs[2].String(),
+			Strict:          strict,
+		},
+	})
+	suite.Require().NoError(err)
+	suite.defaultDenom = res.GetNewTokenDenom()
+	return contractKeeper
+}
+
+func (suite *KeeperTestSuite) TestDenomHookPayloads() {
+	suite.SetupTest()
+	contractKeeper := suite.setupDenomHook(true)
+	admin, other := suite.TestAccs[0].String(), suite.TestAccs[1].String()
+
+	_, err := suite.msgServer.Mint(suite.Ctx, types.NewMsgMintTo(admin, sdk.NewInt64Coin(suite.defaultDenom, 100), other))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.ForceTransfer(suite.Ctx, types.NewMsgForceTransfer(admin, sdk.NewInt64Coin(suite.defaultDenom, 40), other, admin))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.Burn(suite.Ctx, types.NewMsgBurn(admin, sdk.NewInt64Coin(suite.defaultDenom, 10)))
+	suite.Require().NoError(err)
+
+	metadata := banktypes.Metadata{
+		Description: "bitcoin",
+		DenomUnits:  []*banktypes.DenomUnit{{Denom: suite.defaultDenom}},
+		Base:        suite.defaultDenom,
+		Display:     suite.defaultDenom,
+		Name:        "bitcoin",
+		Symbol:      "BTC",
+	}
+	_, err = suite.msgServer.SetDenomMetadata(suite.Ctx, types.NewMsgSetDenomMetadata(admin, metadata))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.ChangeAdmin(suite.Ctx, types.NewMsgChangeAdmin(admin, suite.defaultDenom, other))
+	suite.Require().NoError(err)
+
+	suite.Require().Equal([]types.DenomHookSudoMsg{
+		{DenomCreated: &types.DenomCreatedHook{Denom: suite.defaultDenom, Creator: admin}},
+		{DenomMinted: &types.DenomMintedHook{Denom: suite.defaultDenom, Amount: sdkmath.NewInt(100), MintToAddress: other}},
+		{DenomForceTransferred: &types.DenomForceTransferredHook{Denom: suite.defaultDenom, Amount: sdkmath.NewInt(40), FromAddress: other, ToAddress: admin}},
+		{DenomBurned: &types.DenomBurnedHook{Denom: suite.defaultDenom, Amount: sdkmath.NewInt(10), BurnFromAddress: admin}},
+		{DenomMetadataChanged: &types.DenomMetadataChangedHook{Denom: suite.defaultDenom, Metadata: metadata}},
+		{DenomAdminChanged: &types.DenomAdminChangedHook{Denom: suite.defaultDenom, PreviousAdmin: admin, NewAdmin: other}},
+	}, contractKeeper.calls)
+}
+
+func (suite *KeeperTestSuite) TestDenomHookFailure() {
+	for _, tc := range []struct {
+		desc    string
+		strict  bool
+		err     error
+		gasUsed uint64
+	}{
+		{
+			desc:   "non strict hook error",
+			strict: false,
+			err:    errors.New("hook error"),
+		},
+		{
+			desc:   "strict hook error",
+			strict: true,
+			err:    errors.New("hook error"),
+		},
+		{
+			desc:    "non strict hook out of gas",
+			strict:  false,
+			gasUsed: types.DefaultParams().DenomHookGasLimit + 1,
+		},
+		{
+			desc:    "strict hook out of gas",
+			strict:  true,
+			gasUsed: types.DefaultParams().DenomHookGasLimit + 1,
+		},
+	} {
+		suite.Run(tc.desc, func() {
+			suite.SetupTest()
+			contractKeeper := suite.setupDenomHook(tc.strict)
+			contractKeeper.err = tc.err
+			contractKeeper.gasUsed = tc.gasUsed
+
+			store := suite.Ctx.KVStore(suite.App.GetKey(types.StoreKey))
+			store.Delete([]byte("hook"))
+
+			ctx := suite.Ctx.WithEventManager(sdk.NewEventManager()).WithGasMeter(storetypes.NewInfiniteGasMeter())
+
+			_, err := suite.msgServer.Mint(ctx, types.NewMsgMint(suite.TestAccs[0].String(), sdk.NewInt64Coin(suite.defaultDenom, 10)))
+			if tc.strict {
+				suite.Require().ErrorIs(err, types.ErrDenomHookFailed)
+				suite.AssertEventEmitted(ctx, proto.MessageName(&types.EventDenomHookFailed{}), 0)
+			} else {
+				suite.Require().NoError(err)
+				suite.AssertEventEmitted(ctx, proto.MessageName(&types.EventDenomHookFailed{}), 1)
+			}
+
+			// the state changes of the failed hook are discarded
+			suite.Require().Nil(store.Get([]byte("hook")))
+
+			// the gas used by the hook is bounded by the denom hook gas limit
+			if tc.gasUsed > 0 {
+				gasUsed := ctx.GasMeter().GasConsumed()
+				suite.Require().GreaterOrEqual(gasUsed, types.DefaultParams().DenomHookGasLimit)
+				suite.Require().Less(gasUsed, tc.gasUsed+types.DefaultParams().DenomHookGasLimit)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestSetDenomHookMsg() {
+	suite.SetupTest()
+	suite.Require().NoError(suite.App.TokenFactoryKeeper.SetParams(suite.Ctx, types.DefaultParams()))
+	contractKeeper := &mockContractKeeper{key: suite.App.GetKey(types.StoreKey)}
+	suite.App.TokenFactoryKeeper.SetContractKeeper(contractKeeper)
+	suite.OverrideMsgServer(suite.App.TokenFactoryKeeper)
+	suite.CreateDefaultDenom()
+
+	hook := &types.DenomHook{ContractAddress: suite.TestAccs[2].String()}
+
+	// only the admin can set the hook
+	_, err := suite.msgServer.SetDenomHook(suite.Ctx, types.NewMsgSetDenomHook(suite.TestAccs[1].String(), suite.defaultDenom, hook))
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	ctx := suite.Ctx.WithEventManager(sdk.NewEventManager())
+	_, err = suite.msgServer.SetDenomHook(ctx, types.NewMsgSetDenomHook(suite.TestAccs[0].String(), suite.defaultDenom, hook))
+	suite.Require().NoError(err)
+	suite.AssertEventEmitted(ctx, proto.MessageName(&types.EventSetDenomHook{}), 1)
+
+	res, err := suite.queryClient.DenomHook(suite.Ctx.Context(), &types.QueryDenomHookRequest{Denom: suite.defaultDenom})
+	suite.Require().NoError(err)
+	suite.Require().Equal(hook, res.DenomHook)
+
+	_, err = suite.msgServer.Mint(suite.Ctx, types.NewMsgMint(suite.TestAccs[0].String(), sdk.NewInt64Coin(suite.defaultDenom, 10)))
+	suite.Require().NoError(err)
+	suite.Require().Len(contractKeeper.calls, 1)
+
+	// a nil hook removes it
+	_, err = suite.msgServer.SetDenomHook(suite.Ctx, types.NewMsgSetDenomHook(suite.TestAccs[0].String(), suite.defaultDenom, nil))
+	suite.Require().NoError(err)
+
+	res, err = suite.queryClient.DenomHook(suite.Ctx.Context(), &types.QueryDenomHookRequest{Denom: suite.defaultDenom})
+	suite.Require().NoError(err)
+	suite.Require().Nil(res.DenomHook)
+
+	_, err = suite.msgServer.Mint(suite.Ctx, types.NewMsgMint(suite.TestAccs[0].String(), sdk.NewInt64Coin(suite.defaultDenom, 10)))
+	suite.Require().NoError(err)
+	suite.Require().Len(contractKeeper.calls, 1)
+}
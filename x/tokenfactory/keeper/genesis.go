@@ -21,14 +21,76 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
 		if err != nil {
 			panic(err)
 		}
+		// x/bank is initialized first, the metadata it imported for the denom, such as the one set
+		// with MsgSetDenomMetadata before the export, is kept over the default creation metadata
+		bankMetadata, found := k.bankKeeper.GetDenomMetaData(ctx, genDenom.GetDenom())
 		err = k.createDenomAfterValidation(ctx, creator, genDenom.GetDenom())
 		if err != nil {
 			panic(err)
 		}
+		if found {
+			k.bankKeeper.SetDenomMetaData(ctx, bankMetadata)
+		}
 		err = k.setAuthorityMetadata(ctx, genDenom.GetDenom(), genDenom.GetAuthorityMetadata())
 		if err != nil {
 			panic(err)
 		}
+		err = k.setDenomHook(ctx, genDenom.GetDenom(), genDenom.GetDenomHook())
+		if err != nil {
+			panic(err)
+		}
+		err = k.setBeforeSendHook(ctx, genDenom.GetDenom(), genDenom.GetBeforeSendHookAddress())
+		if err != nil {
+			panic(err)
+		}
+		err = k.setVoucherSigner(ctx, genDenom.GetDenom(), genDenom.GetVoucherSigner())
+		if err != nil {
+			panic(err)
+		}
+		for _, nonce := range genDenom.GetUsedVoucherNonces() {
+			k.setVoucherNonceUsed(ctx, genDenom.GetDenom(), nonce)
+		}
+		for _, record := range genDenom.GetReferenceIds() {
+			k.setReferenceID(ctx, genDenom.GetDenom(), record.ReferenceId, record.Height)
+		}
+		if attestor := genDenom.GetReserveAttestor(); attestor != nil {
+			err = k.setReserveAttestor(ctx, genDenom.GetDenom(), *attestor)
+			if err != nil {
+				panic(err)
+			}
+		}
+		for _, attestation := range genDenom.GetReserveAttestations() {
+			k.setReserveAttestation(ctx, genDenom.GetDenom(), attestation)
+		}
+		for _, redemption := range genDenom.GetRedemptions() {
+			k.setRedemption(ctx, redemption)
+		}
+		if vault := genDenom.GetVault(); vault != nil {
+			k.setVault(ctx, *vault)
+		}
+		if bridge := genDenom.GetCw20Bridge(); bridge != nil {
+			k.setCW20Bridge(ctx, *bridge)
+		}
+		if basket := genDenom.GetBasket(); basket != nil {
+			k.setBasket(ctx, *basket)
+		}
+		for _, schedule := range genDenom.GetVestingSchedules() {
+			k.setVestingSchedule(ctx, schedule)
+		}
+	}
+
+	nextClaimCampaignID := uint64(1)
+	for _, campaign := range genState.GetClaimCampaigns() {
+		k.setClaimCampaign(ctx, campaign)
+		if campaign.Id >= nextClaimCampaignID {
+			nextClaimCampaignID = campaign.Id + 1
+		}
+	}
+	if len(genState.GetClaimCampaigns()) > 0 {
+		k.setNextClaimCampaignID(ctx, nextClaimCampaignID)
+	}
+	for _, record := range genState.GetClaimRecords() {
+		k.setClaimRecord(ctx, record)
 	}
 }
 
@@ -45,14 +107,50 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 			panic(err)
 		}
 
-		genDenoms = append(genDenoms, types.GenesisDenom{
+		genDenom := types.GenesisDenom{
 			Denom:             denom,
 			AuthorityMetadata: authorityMetadata,
-		})
+		}
+		if hook, found := k.GetDenomHook(ctx, denom); found {
+			genDenom.DenomHook = &hook
+		}
+		genDenom.BeforeSendHookAddress = k.GetBeforeSendHook(ctx, denom)
+		genDenom.VoucherSigner = k.GetVoucherSigner(ctx, denom)
+		if nonces := k.GetUsedVoucherNonces(ctx, denom); len(nonces) > 0 {
+			genDenom.UsedVoucherNonces = nonces
+		}
+		if records := k.GetAllReferenceIDs(ctx, denom); len(records) > 0 {
+			genDenom.ReferenceIds = records
+		}
+		if attestor, found := k.GetReserveAttestor(ctx, denom); found {
+			genDenom.ReserveAttestor = &attestor
+		}
+		if attestations := k.GetAllReserveAttestations(ctx, denom); len(attestations) > 0 {
+			genDenom.ReserveAttestations = attestations
+		}
+		if redemptions := k.GetAllRedemptions(ctx, denom); len(redemptions) > 0 {
+			genDenom.Redemptions = redemptions
+		}
+		if vault, found := k.GetVault(ctx, denom); found {
+			genDenom.Vault = &vault
+		}
+		if bridge, found := k.GetCW20Bridge(ctx, denom); found {
+			genDenom.Cw20Bridge = &bridge
+		}
+		if basket, found := k.GetBasket(ctx, denom); found {
+			genDenom.Basket = &basket
+		}
+		if schedules := k.GetAllVestingSchedules(ctx, denom); len(schedules) > 0 {
+			genDenom.VestingSchedules = schedules
+		}
+
+		genDenoms = append(genDenoms, genDenom)
 	}
 
 	return &types.GenesisState{
-		FactoryDenoms: genDenoms,
-		Params:        k.GetParams(ctx),
+		FactoryDenoms:  genDenoms,
+		Params:         k.GetParams(ctx),
+		ClaimCampaigns: k.GetAllClaimCampaigns(ctx),
+		ClaimRecords:   k.GetAllClaimRecords(ctx),
 	}
 }
@@ -1,17 +1,25 @@
 package keeper_test
 
 import (
+	"time"
+
 	"github.com/cosmos/tokenfactory/x/tokenfactory/types"
 
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
 func (suite *KeeperTestSuite) TestGenesis() {
+	expiry := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
 	genesisState := types.GenesisState{
 		Params: types.Params{
-			DenomCreationFee:        sdk.Coins{sdk.NewInt64Coin("stake", 10_000_000)},
-			DenomCreationGasConsume: 5_000_000,
+			DenomCreationFee:           sdk.Coins{sdk.NewInt64Coin("stake", 10_000_000)},
+			DenomCreationGasConsume:    5_000_000,
+			ReferenceIdRetentionBlocks: 100,
+			RedemptionTimeout:          72 * time.Hour,
 		},
 		FactoryDenoms: []types.GenesisDenom{
 			{
@@ -19,18 +27,131 @@ func (suite *KeeperTestSuite) TestGenesis() {
 				AuthorityMetadata: types.DenomAuthorityMetadata{
 					Admin: "cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8",
 				},
+				VoucherSigner:     secp256k1.GenPrivKey().PubKey().Bytes(),
+				UsedVoucherNonces: []uint64{1, 5},
+				ReserveAttestor: &types.ReserveAttestor{
+					Attestor:     "cosmos15czt5nhlnvayqq37xun9s9yus0d6y26dx74r5p",
+					MaxStaleness: 24 * time.Hour,
+				},
+				ReserveAttestations: []types.ReserveAttestation{
+					{
+						Id:         1,
+						Attestor:   "cosmos15czt5nhlnvayqq37xun9s9yus0d6y26dx74r5p",
+						Amount:     sdkmath.NewInt(1000),
+						Timestamp:  time.Date(2024, 6, 30, 0, 0, 0, 0, time.UTC),
+						ReportHash: make([]byte, types.ReportHashLength),
+						Height:     3,
+					},
+				},
+				Basket: &types.Basket{
+					Denom:               "factory/cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8/bitcoin",
+					Components:          sdk.NewCoins(sdk.NewInt64Coin("uatom", 10)),
+					Escrowed:            sdk.NewCoins(sdk.NewInt64Coin("uatom", 20)),
+					Supply:              sdkmath.NewInt(2),
+					CompositionTimelock: 24 * time.Hour,
+					PendingComposition: &types.PendingBasketComposition{
+						Components:   sdk.NewCoins(sdk.NewInt64Coin("uatom", 5), sdk.NewInt64Coin("uosmo", 5)),
+						ExecuteAfter: expiry,
+					},
+				},
 			},
 			{
 				Denom: "factory/cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8/diff-admin",
 				AuthorityMetadata: types.DenomAuthorityMetadata{
 					Admin: "cosmos15czt5nhlnvayqq37xun9s9yus0d6y26dx74r5p",
 				},
+				BeforeSendHookAddress: "cosmos15czt5nhlnvayqq37xun9s9yus0d6y26dx74r5p",
+				Vault: &types.Vault{
+					Denom:        "factory/cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8/diff-admin",
+					BackingDenom: "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2",
+					Ratio:        sdkmath.NewInt(1_000_000_000_000),
+					Escrowed:     sdkmath.ZeroInt(),
+				},
+				VestingSchedules: []types.VestingSchedule{
+					{
+						Denom:     "factory/cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8/diff-admin",
+						Recipient: "cosmos15czt5nhlnvayqq37xun9s9yus0d6y26dx74r5p",
+						Amount:    sdkmath.NewInt(1000),
+						StartTime: time.Date(2024, 6, 30, 0, 0, 0, 0, time.UTC),
+						EndTime:   expiry,
+					},
+					{
+						Denom:     "factory/cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8/diff-admin",
+						Recipient: "cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8",
+						Amount:    sdkmath.NewInt(300),
+						StartTime: time.Date(2024, 6, 30, 0, 0, 0, 0, time.UTC),
+						Periods: []types.VestingPeriod{
+							{Length: 720 * time.Hour, Amount: sdkmath.NewInt(100)},
+							{Length: 720 * time.Hour, Amount: sdkmath.NewInt(200)},
+						},
+					},
+				},
 			},
 			{
 				Denom: "factory/cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8/litecoin",
 				AuthorityMetadata: types.DenomAuthorityMetadata{
 					Admin: "cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8",
 				},
+				DenomHook: &types.DenomHook{
+					ContractAddress: "cosmos15czt5nhlnvayqq37xun9s9yus0d6y26dx74r5p",
+					Strict:          true,
+				},
+				ReferenceIds: []types.ReferenceIDRecord{
+					{ReferenceId: "wire-1", Height: 3},
+					{ReferenceId: "wire-2", Height: 7},
+				},
+				Redemptions: []types.RedemptionRequest{
+					{
+						Id:                  1,
+						Holder:              "cosmos15czt5nhlnvayqq37xun9s9yus0d6y26dx74r5p",
+						Amount:              sdk.NewInt64Coin("factory/cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8/litecoin", 100),
+						PayoutReference:     "IBAN:DE89370400440532013000",
+						Created:             time.Date(2024, 6, 30, 0, 0, 0, 0, time.UTC),
+						Status:              types.REDEMPTION_STATUS_FULFILLED,
+						SettlementReference: "wire-2",
+					},
+					{
+						Id:              2,
+						Holder:          "cosmos15czt5nhlnvayqq37xun9s9yus0d6y26dx74r5p",
+						Amount:          sdk.NewInt64Coin("factory/cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8/litecoin", 50),
+						PayoutReference: "IBAN:DE89370400440532013000",
+						Created:         time.Date(2024, 7, 1, 0, 0, 0, 0, time.UTC),
+						Timeout:         &expiry,
+						Status:          types.REDEMPTION_STATUS_OPEN,
+					},
+				},
+				Cw20Bridge: &types.CW20Bridge{
+					Denom:           "factory/cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8/litecoin",
+					ContractAddress: "cosmos14hj2tavq8fpesdwxxcu44rty3hh90vhujrvcmstl4zr3txmfvw9s4hmalr",
+					Escrowed:        sdkmath.ZeroInt(),
+				},
+			},
+		},
+		ClaimCampaigns: []types.ClaimCampaign{
+			{
+				Id:         1,
+				Denom:      "factory/cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8/bitcoin",
+				Creator:    "cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8",
+				MerkleRoot: types.ClaimLeafHash("cosmos15czt5nhlnvayqq37xun9s9yus0d6y26dx74r5p", sdkmath.NewInt(100)),
+				TotalCap:   sdkmath.NewInt(1000),
+				Claimed:    sdkmath.NewInt(100),
+			},
+			{
+				Id:         3,
+				Denom:      "factory/cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8/litecoin",
+				Creator:    "cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8",
+				MerkleRoot: types.ClaimLeafHash("cosmos15czt5nhlnvayqq37xun9s9yus0d6y26dx74r5p", sdkmath.NewInt(5)),
+				TotalCap:   sdkmath.NewInt(5),
+				Claimed:    sdkmath.ZeroInt(),
+				Expiry:     &expiry,
+				Closed:     true,
+			},
+		},
+		ClaimRecords: []types.ClaimRecord{
+			{
+				CampaignId: 1,
+				Address:    "cosmos15czt5nhlnvayqq37xun9s9yus0d6y26dx74r5p",
+				Amount:     sdkmath.NewInt(100),
 			},
 		},
 	}
@@ -49,9 +170,66 @@ func (suite *KeeperTestSuite) TestGenesis() {
 	if err := app.TokenFactoryKeeper.SetParams(suite.Ctx, genesisState.Params); err != nil {
 		panic(err)
 	}
+	// the before send hook must be an existing contract
+	app.TokenFactoryKeeper.SetContractKeeper(&mockContractKeeper{key: app.GetKey(types.StoreKey)})
 	app.TokenFactoryKeeper.InitGenesis(suite.Ctx, genesisState)
 
+	// the bank metadata set before the import is kept
+	metadata, found := app.BankKeeper.GetDenomMetaData(suite.Ctx, genesisState.FactoryDenoms[1].GetDenom())
+	suite.Require().True(found)
+	suite.Require().Equal(banktypes.Metadata{Base: genesisState.FactoryDenoms[1].GetDenom()}, metadata)
+
 	exportedGenesis := app.TokenFactoryKeeper.ExportGenesis(suite.Ctx)
 	suite.Require().NotNil(exportedGenesis)
 	suite.Require().Equal(genesisState, *exportedGenesis)
+
+	// only the open redemption requests are indexed as open
+	openRedemptions := app.TokenFactoryKeeper.GetOpenRedemptions(suite.Ctx, genesisState.FactoryDenoms[2].GetDenom())
+	suite.Require().Len(openRedemptions, 1)
+	suite.Require().Equal(uint64(2), openRedemptions[0].Id)
+
+	// the next campaign id follows the highest imported one
+	suite.Require().Equal(uint64(4), app.TokenFactoryKeeper.GetNextClaimCampaignID(suite.Ctx))
+
+	// the pending basket composition is indexed for its execution
+	app.TokenFactoryKeeper.ExecuteBasketCompositions(suite.Ctx.WithBlockTime(expiry))
+	basket, found := app.TokenFactoryKeeper.GetBasket(suite.Ctx, genesisState.FactoryDenoms[0].GetDenom())
+	suite.Require().True(found)
+	suite.Require().Equal(genesisState.FactoryDenoms[0].Basket.PendingComposition.Components, basket.Components)
+	suite.Require().Nil(basket.PendingComposition)
+}
+
+func (suite *KeeperTestSuite) TestGenesisKeepsBankMetadata() {
+	suite.SetupTest()
+	suite.CreateDefaultDenom()
+	admin := suite.TestAccs[0].String()
+	metadata := banktypes.Metadata{
+		DenomUnits: []*banktypes.DenomUnit{{Denom: suite.defaultDenom}, {Denom: "bitcoin", Exponent: 6}},
+		Base:       suite.defaultDenom,
+		Display:    "bitcoin",
+		Name:       "Bitcoin",
+		Symbol:     "BTC",
+	}
+	_, err := suite.msgServer.SetDenomMetadata(suite.Ctx, types.NewMsgSetDenomMetadata(admin, metadata))
+	suite.Require().NoError(err)
+	genesisState := suite.App.TokenFactoryKeeper.ExportGenesis(suite.Ctx)
+	otherDenom := "factory/" + admin + "/litecoin"
+	genesisState.FactoryDenoms = append(genesisState.FactoryDenoms, types.GenesisDenom{
+		Denom:             otherDenom,
+		AuthorityMetadata: types.DenomAuthorityMetadata{Admin: admin},
+	})
+
+	// x/bank imports its metadata before the tokenfactory genesis
+	suite.SetupTestForInitGenesis()
+	suite.App.BankKeeper.SetDenomMetaData(suite.Ctx, metadata)
+	suite.App.TokenFactoryKeeper.InitGenesis(suite.Ctx, *genesisState)
+
+	imported, found := suite.App.BankKeeper.GetDenomMetaData(suite.Ctx, suite.defaultDenom)
+	suite.Require().True(found)
+	suite.Require().Equal(metadata, imported)
+
+	// the denoms without bank metadata get the metadata set on creation
+	imported, found = suite.App.BankKeeper.GetDenomMetaData(suite.Ctx, otherDenom)
+	suite.Require().True(found)
+	suite.Require().Equal(otherDenom, imported.Symbol)
 }
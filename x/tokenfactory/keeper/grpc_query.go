@@ -5,7 +5,10 @@ import (
 
 	"github.com/cosmos/tokenfactory/x/tokenfactory/types"
 
+	errorsmod "cosmossdk.io/errors"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 )
 
 var _ types.QueryServer = Keeper{}
@@ -42,3 +45,243 @@ func (k Keeper) DenomsFromAdmin(ctx context.Context, req *types.QueryDenomsFromA
 	}
 	return &types.QueryDenomsFromAdminResponse{Denoms: denoms}, nil
 }
+
+func (k Keeper) DenomHook(ctx context.Context, req *types.QueryDenomHookRequest) (*types.QueryDenomHookResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	hook, found := k.GetDenomHook(sdkCtx, req.GetDenom())
+	if !found {
+		return &types.QueryDenomHookResponse{}, nil
+	}
+	return &types.QueryDenomHookResponse{DenomHook: &hook}, nil
+}
+
+func (k Keeper) BeforeSendHookAddress(ctx context.Context, req *types.QueryBeforeSendHookAddressRequest) (*types.QueryBeforeSendHookAddressResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	return &types.QueryBeforeSendHookAddressResponse{
+		CosmwasmAddress: k.GetBeforeSendHook(sdkCtx, req.GetDenom()),
+	}, nil
+}
+
+func (k Keeper) ClaimCampaign(ctx context.Context, req *types.QueryClaimCampaignRequest) (*types.QueryClaimCampaignResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	campaign, found := k.GetClaimCampaign(sdkCtx, req.GetCampaignId())
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrClaimCampaignNotFound, "id %d", req.GetCampaignId())
+	}
+	return &types.QueryClaimCampaignResponse{
+		Campaign: campaign,
+		Status:   campaign.Status(sdkCtx.BlockTime()),
+	}, nil
+}
+
+func (k Keeper) ClaimStatus(ctx context.Context, req *types.QueryClaimStatusRequest) (*types.QueryClaimStatusResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if _, found := k.GetClaimCampaign(sdkCtx, req.GetCampaignId()); !found {
+		return nil, errorsmod.Wrapf(types.ErrClaimCampaignNotFound, "id %d", req.GetCampaignId())
+	}
+	amount, claimed := k.GetClaimRecord(sdkCtx, req.GetCampaignId(), req.GetAddress())
+	return &types.QueryClaimStatusResponse{Claimed: claimed, Amount: amount}, nil
+}
+
+func (k Keeper) VoucherSigner(ctx context.Context, req *types.QueryVoucherSignerRequest) (*types.QueryVoucherSignerResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	return &types.QueryVoucherSignerResponse{PubKey: k.GetVoucherSigner(sdkCtx, req.GetDenom())}, nil
+}
+
+func (k Keeper) VoucherNonce(ctx context.Context, req *types.QueryVoucherNonceRequest) (*types.QueryVoucherNonceResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	return &types.QueryVoucherNonceResponse{Used: k.IsVoucherNonceUsed(sdkCtx, req.GetDenom(), req.GetNonce())}, nil
+}
+
+func (k Keeper) ReferenceID(ctx context.Context, req *types.QueryReferenceIDRequest) (*types.QueryReferenceIDResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	height, found := k.GetReferenceIDHeight(sdkCtx, req.GetDenom(), req.GetReferenceId())
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrReferenceIDNotFound, "denom %s, reference id %s", req.GetDenom(), req.GetReferenceId())
+	}
+	return &types.QueryReferenceIDResponse{Height: height}, nil
+}
+
+func (k Keeper) ReserveAttestor(ctx context.Context, req *types.QueryReserveAttestorRequest) (*types.QueryReserveAttestorResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	res := &types.QueryReserveAttestorResponse{}
+	if attestor, found := k.GetReserveAttestor(sdkCtx, req.GetDenom()); found {
+		res.ReserveAttestor = &attestor
+	}
+	if latest, found := k.GetLatestReserveAttestation(sdkCtx, req.GetDenom()); found {
+		res.LatestAttestation = &latest
+	}
+	return res, nil
+}
+
+func (k Keeper) ReserveAttestations(ctx context.Context, req *types.QueryReserveAttestationsRequest) (*types.QueryReserveAttestationsResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	attestations := []types.ReserveAttestation{}
+	pageRes, err := query.Paginate(k.getReserveAttestationStore(sdkCtx, req.GetDenom()), req.GetPagination(), func(_, value []byte) error {
+		attestation := types.ReserveAttestation{}
+		if err := k.cdc.Unmarshal(value, &attestation); err != nil {
+			return err
+		}
+		attestations = append(attestations, attestation)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryReserveAttestationsResponse{Attestations: attestations, Pagination: pageRes}, nil
+}
+
+func (k Keeper) Redemption(ctx context.Context, req *types.QueryRedemptionRequest) (*types.QueryRedemptionResponse, error) {
+	redemption, found := k.GetRedemption(sdk.UnwrapSDKContext(ctx), req.GetDenom(), req.GetId())
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrRedemptionNotFound, "denom %s, id %d", req.GetDenom(), req.GetId())
+	}
+
+	return &types.QueryRedemptionResponse{Redemption: redemption}, nil
+}
+
+func (k Keeper) OpenRedemptions(ctx context.Context, req *types.QueryOpenRedemptionsRequest) (*types.QueryOpenRedemptionsResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	redemptions := []types.RedemptionRequest{}
+	pageRes, err := query.Paginate(k.getOpenRedemptionStore(sdkCtx, req.GetDenom()), req.GetPagination(), func(key, _ []byte) error {
+		redemption, found := k.GetRedemption(sdkCtx, req.GetDenom(), sdk.BigEndianToUint64(key))
+		if !found {
+			return errorsmod.Wrapf(types.ErrRedemptionNotFound, "denom %s, id %d", req.GetDenom(), sdk.BigEndianToUint64(key))
+		}
+		redemptions = append(redemptions, redemption)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryOpenRedemptionsResponse{Redemptions: redemptions, Pagination: pageRes}, nil
+}
+
+func (k Keeper) Vault(ctx context.Context, req *types.QueryVaultRequest) (*types.QueryVaultResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	vault, found := k.GetVault(sdkCtx, req.GetDenom())
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrVaultNotFound, "denom %s", req.GetDenom())
+	}
+
+	return &types.QueryVaultResponse{Vault: vault, Address: types.VaultAddress(vault.Denom).String()}, nil
+}
+
+func (k Keeper) Vaults(ctx context.Context, req *types.QueryVaultsRequest) (*types.QueryVaultsResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	vaults := []types.Vault{}
+	pageRes, err := query.Paginate(k.getVaultStore(sdkCtx), req.GetPagination(), func(_, value []byte) error {
+		vault := types.Vault{}
+		if err := k.cdc.Unmarshal(value, &vault); err != nil {
+			return err
+		}
+		vaults = append(vaults, vault)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryVaultsResponse{Vaults: vaults, Pagination: pageRes}, nil
+}
+
+func (k Keeper) CW20Bridge(ctx context.Context, req *types.QueryCW20BridgeRequest) (*types.QueryCW20BridgeResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	bridge, found := k.GetCW20Bridge(sdkCtx, req.GetDenom())
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrCW20BridgeNotFound, "denom %s", req.GetDenom())
+	}
+
+	return &types.QueryCW20BridgeResponse{Cw20Bridge: bridge, Address: types.CW20BridgeAddress.String()}, nil
+}
+
+func (k Keeper) CW20Bridges(ctx context.Context, req *types.QueryCW20BridgesRequest) (*types.QueryCW20BridgesResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	bridges := []types.CW20Bridge{}
+	pageRes, err := query.Paginate(k.getCW20BridgeStore(sdkCtx), req.GetPagination(), func(_, value []byte) error {
+		bridge := types.CW20Bridge{}
+		if err := k.cdc.Unmarshal(value, &bridge); err != nil {
+			return err
+		}
+		bridges = append(bridges, bridge)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryCW20BridgesResponse{Cw20Bridges: bridges, Pagination: pageRes}, nil
+}
+
+func (k Keeper) Basket(ctx context.Context, req *types.QueryBasketRequest) (*types.QueryBasketResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	basket, found := k.GetBasket(sdkCtx, req.GetDenom())
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrBasketNotFound, "denom %s", req.GetDenom())
+	}
+
+	return &types.QueryBasketResponse{Basket: basket, Address: types.BasketAddress(basket.Denom).String()}, nil
+}
+
+func (k Keeper) Baskets(ctx context.Context, req *types.QueryBasketsRequest) (*types.QueryBasketsResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	baskets := []types.Basket{}
+	pageRes, err := query.Paginate(k.getBasketStore(sdkCtx), req.GetPagination(), func(_, value []byte) error {
+		basket := types.Basket{}
+		if err := k.cdc.Unmarshal(value, &basket); err != nil {
+			return err
+		}
+		baskets = append(baskets, basket)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryBasketsResponse{Baskets: baskets, Pagination: pageRes}, nil
+}
+
+func (k Keeper) VestingSchedule(ctx context.Context, req *types.QueryVestingScheduleRequest) (*types.QueryVestingScheduleResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	schedule, found := k.GetVestingSchedule(sdkCtx, req.GetDenom(), req.GetAddress())
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrVestingNotFound, "denom %s, holder %s", req.GetDenom(), req.GetAddress())
+	}
+
+	return &types.QueryVestingScheduleResponse{
+		Schedule: schedule,
+		Vested:   schedule.VestedAmount(sdkCtx.BlockTime()),
+		Locked:   schedule.LockedAmount(sdkCtx.BlockTime()),
+	}, nil
+}
+
+func (k Keeper) VestingSchedules(ctx context.Context, req *types.QueryVestingSchedulesRequest) (*types.QueryVestingSchedulesResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	schedules := []types.VestingSchedule{}
+	pageRes, err := query.Paginate(k.getVestingStore(sdkCtx, req.GetDenom()), req.GetPagination(), func(_, value []byte) error {
+		schedule := types.VestingSchedule{}
+		if err := k.cdc.Unmarshal(value, &schedule); err != nil {
+			return err
+		}
+		schedules = append(schedules, schedule)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryVestingSchedulesResponse{Schedules: schedules, Pagination: pageRes}, nil
+}
@@ -0,0 +1,150 @@
+package keeper_test
+
+import (
+	"context"
+	"errors"
+
+	"github.com/cosmos/tokenfactory/x/tokenfactory/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+var _ types.TokenFactoryHooks = &mockTokenFactoryHooks{}
+
+// mockTokenFactoryHooks records the hook calls, and fails them when err is set.
+type mockTokenFactoryHooks struct {
+	calls []string
+	err   error
+}
+
+func (h *mockTokenFactoryHooks) AfterDenomCreated(_ context.Context, denom string, creator string) error {
+	h.calls = append(h.calls, "created "+denom+" "+creator)
+	return h.err
+}
+
+func (h *mockTokenFactoryHooks) AfterMint(_ context.Context, amount sdk.Coin, mintToAddress string) error {
+	h.calls = append(h.calls, "mint "+amount.String()+" "+mintToAddress)
+	return h.err
+}
+
+func (h *mockTokenFactoryHooks) AfterBurn(_ context.Context, amount sdk.Coin, burnFromAddress string) error {
+	h.calls = append(h.calls, "burn "+amount.String()+" "+burnFromAddress)
+	return h.err
+}
+
+func (h *mockTokenFactoryHooks) AfterForceTransfer(_ context.Context, amount sdk.Coin, fromAddress, toAddress string) error {
+	h.calls = append(h.calls, "force transfer "+amount.String()+" "+fromAddress+" "+toAddress)
+	return h.err
+}
+
+func (h *mockTokenFactoryHooks) AfterAdminChanged(_ context.Context, denom string, previousAdmin, newAdmin string) error {
+	h.calls = append(h.calls, "admin "+denom+" "+previousAdmin+" "+newAdmin)
+	return h.err
+}
+
+func (h *mockTokenFactoryHooks) AfterMetadataSet(_ context.Context, denom string, metadata banktypes.Metadata) error {
+	h.calls = append(h.calls, "metadata "+denom+" "+metadata.Symbol)
+	return h.err
+}
+
+func (suite *KeeperTestSuite) TestTokenFactoryHooks() {
+	suite.SetupTest()
+	first, second := &mockTokenFactoryHooks{}, &mockTokenFactoryHooks{}
+	suite.App.TokenFactoryKeeper.SetHooks(types.NewMultiTokenFactoryHooks(first, second))
+	suite.OverrideMsgServer(suite.App.TokenFactoryKeeper)
+
+	// hooks can only be set once
+	suite.Require().Panics(func() {
+		suite.App.TokenFactoryKeeper.SetHooks(first)
+	})
+
+	admin, other := suite.TestAccs[0].String(), suite.TestAccs[1].String()
+	suite.CreateDefaultDenom()
+	denom := suite.defaultDenom
+
+	_, err := suite.msgServer.Mint(suite.Ctx, types.NewMsgMintTo(admin, sdk.NewInt64Coin(denom, 100), other))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.ForceTransfer(suite.Ctx, types.NewMsgForceTransfer(admin, sdk.NewInt64Coin(denom, 40), other, admin))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.Burn(suite.Ctx, types.NewMsgBurn(admin, sdk.NewInt64Coin(denom, 10)))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.SetDenomMetadata(suite.Ctx, types.NewMsgSetDenomMetadata(admin, banktypes.Metadata{
+		DenomUnits: []*banktypes.DenomUnit{{Denom: denom}},
+		Base:       denom,
+		Display:    denom,
+		Name:       "bitcoin",
+		Symbol:     "BTC",
+	}))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.ChangeAdmin(suite.Ctx, types.NewMsgChangeAdmin(admin, denom, other))
+	suite.Require().NoError(err)
+
+	expectedCalls := []string{
+		"created " + denom + " " + admin,
+		"mint 100" + denom + " " + other,
+		"force transfer 40" + denom + " " + other + " " + admin,
+		"burn 10" + denom + " " + admin,
+		"metadata " + denom + " BTC",
+		"admin " + denom + " " + admin + " " + other,
+	}
+	suite.Require().Equal(expectedCalls, first.calls)
+	suite.Require().Equal(expectedCalls, second.calls)
+}
+
+func (suite *KeeperTestSuite) TestTokenFactoryHooksError() {
+	suite.SetupTest()
+	hooks := &mockTokenFactoryHooks{}
+	suite.App.TokenFactoryKeeper.SetHooks(hooks)
+	suite.OverrideMsgServer(suite.App.TokenFactoryKeeper)
+	suite.CreateDefaultDenom()
+
+	hookErr := errors.New("hook error")
+	hooks.err = hookErr
+	admin := suite.TestAccs[0].String()
+
+	_, err := suite.msgServer.CreateDenom(suite.Ctx, types.NewMsgCreateDenom(admin, "litecoin"))
+	suite.Require().ErrorIs(err, hookErr)
+	_, err = suite.msgServer.Mint(suite.Ctx, types.NewMsgMint(admin, sdk.NewInt64Coin(suite.defaultDenom, 10)))
+	suite.Require().ErrorIs(err, hookErr)
+	_, err = suite.msgServer.ChangeAdmin(suite.Ctx, types.NewMsgChangeAdmin(admin, suite.defaultDenom, ""))
+	suite.Require().ErrorIs(err, hookErr)
+}
+
+func (suite *KeeperTestSuite) TestAdminChangedHooksOnlyOnChangeAdmin() {
+	suite.SetupTest()
+	hooks := &mockTokenFactoryHooks{}
+	contractKeeper := &mockContractKeeper{key: suite.App.GetKey(types.StoreKey)}
+	suite.App.TokenFactoryKeeper.SetHooks(hooks)
+	suite.App.TokenFactoryKeeper.SetContractKeeper(contractKeeper)
+	admin, other := suite.TestAccs[0].String(), suite.TestAccs[1].String()
+	denomHook := &types.DenomHook{ContractAddress: suite.TestAccs[2].String()}
+
+	// the admin imported with the genesis state is not an admin change
+	genesisDenom := "factory/" + admin + "/genesis"
+	suite.App.TokenFactoryKeeper.InitGenesis(suite.Ctx, types.GenesisState{
+		Params: types.DefaultParams(),
+		FactoryDenoms: []types.GenesisDenom{{
+			Denom:             genesisDenom,
+			AuthorityMetadata: types.DenomAuthorityMetadata{Admin: other},
+			DenomHook:         denomHook,
+		}},
+	})
+	suite.Require().Equal([]string{"created " + genesisDenom + " " + admin}, hooks.calls)
+	suite.Require().Empty(contractKeeper.calls)
+
+	// neither is the admin set on creation
+	suite.OverrideMsgServer(suite.App.TokenFactoryKeeper)
+	res, err := suite.msgServer.CreateDenom(suite.Ctx, &types.MsgCreateDenom{Sender: admin, Subdenom: "bitcoin", DenomHook: denomHook})
+	suite.Require().NoError(err)
+	denom := res.GetNewTokenDenom()
+	suite.Require().Equal([]string{"created " + genesisDenom + " " + admin, "created " + denom + " " + admin}, hooks.calls)
+	suite.Require().Equal([]types.DenomHookSudoMsg{
+		{DenomCreated: &types.DenomCreatedHook{Denom: denom, Creator: admin}},
+	}, contractKeeper.calls)
+
+	_, err = suite.msgServer.ChangeAdmin(suite.Ctx, types.NewMsgChangeAdmin(admin, denom, other))
+	suite.Require().NoError(err)
+	suite.Require().Equal("admin "+denom+" "+admin+" "+other, hooks.calls[len(hooks.calls)-1])
+	suite.Require().Equal(&types.DenomAdminChangedHook{Denom: denom, PreviousAdmin: admin, NewAdmin: other}, contractKeeper.calls[len(contractKeeper.calls)-1].DenomAdminChanged)
+}
@@ -0,0 +1,226 @@
+package keeper
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/tokenfactory/x/tokenfactory/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// RegisterInvariants registers all the x/tokenfactory invariants.
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "denom-metadata", DenomMetadataInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "creator-prefix", CreatorPrefixInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "module-account-balance", ModuleAccountBalanceInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "vault-backing", VaultBackingInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "cw20-backing", CW20BackingInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "basket-backing", BasketBackingInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "vesting-locked", VestingLockedInvariant(k))
+}
+
+// AllInvariants runs all the x/tokenfactory invariants.
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		for _, invariant := range []sdk.Invariant{
+			DenomMetadataInvariant(k),
+			CreatorPrefixInvariant(k),
+			ModuleAccountBalanceInvariant(k),
+			VaultBackingInvariant(k),
+			CW20BackingInvariant(k),
+			BasketBackingInvariant(k),
+			VestingLockedInvariant(k),
+		} {
+			if res, broken := invariant(ctx); broken {
+				return res, broken
+			}
+		}
+
+		return "", false
+	}
+}
+
+// DenomMetadataInvariant checks that every denom indexed by creator has bank metadata and
+// authority metadata.
+func DenomMetadataInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		count := 0
+
+		iterator := k.GetAllDenomsIterator(ctx)
+		defer iterator.Close()
+
+		for ; iterator.Valid(); iterator.Next() {
+			denom := string(iterator.Value())
+
+			if _, found := k.bankKeeper.GetDenomMetaData(ctx, denom); !found {
+				count++
+				msg += fmt.Sprintf("\tdenom %s has no bank metadata\n", denom)
+			}
+
+			if !k.GetDenomPrefixStore(ctx, denom).Has([]byte(types.DenomAuthorityMetadataKey)) {
+				count++
+				msg += fmt.Sprintf("\tdenom %s has no authority metadata\n", denom)
+			}
+		}
+
+		broken := count != 0
+		return sdk.FormatInvariant(types.ModuleName, "denom-metadata",
+			fmt.Sprintf("found %d missing denom metadata\n%s", count, msg)), broken
+	}
+}
+
+// CreatorPrefixInvariant checks that every denom is indexed under the creator returned by
+// DeconstructDenom.
+func CreatorPrefixInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		count := 0
+
+		iterator := k.GetAllDenomsIterator(ctx)
+		defer iterator.Close()
+
+		for ; iterator.Valid(); iterator.Next() {
+			key, denom := string(iterator.Key()), string(iterator.Value())
+
+			creator, _, err := types.DeconstructDenom(denom)
+			if err != nil {
+				count++
+				msg += fmt.Sprintf("\tindexed denom %s is invalid: %s\n", denom, err)
+				continue
+			}
+
+			if key != strings.Join([]string{creator, denom}, types.KeySeparator) {
+				count++
+				msg += fmt.Sprintf("\tdenom %s of creator %s is indexed under %s\n", denom, creator, key)
+			}
+		}
+
+		broken := count != 0
+		return sdk.FormatInvariant(types.ModuleName, "creator-prefix",
+			fmt.Sprintf("found %d mismatching creator index entries\n%s", count, msg)), broken
+	}
+}
+
+// ModuleAccountBalanceInvariant checks that the tokenfactory module account only holds the tokens
+// escrowed by the open redemption requests, as minted tokens are sent out and burned tokens are
+// burned in the same operation.
+func ModuleAccountBalanceInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		balance := k.bankKeeper.GetAllBalances(ctx, authtypes.NewModuleAddress(types.ModuleName))
+		escrowed := k.GetEscrowedRedemptions(ctx)
+
+		broken := !balance.Equal(escrowed)
+		return sdk.FormatInvariant(types.ModuleName, "module-account-balance",
+			fmt.Sprintf("the module account holds %s, the open redemptions escrow %s\n", balance, escrowed)), broken
+	}
+}
+
+// VaultBackingInvariant checks that the supply of every vault denom is its escrowed backing
+// amount times its ratio, and that the escrow account of the vault holds the escrowed amount.
+func VaultBackingInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		count := 0
+
+		for _, vault := range k.GetAllVaults(ctx) {
+			if err := k.CheckVaultBacking(ctx, vault.Denom); err != nil {
+				count++
+				msg += fmt.Sprintf("\tvault %s: %s\n", vault.Denom, err)
+			}
+
+			balance := k.bankKeeper.GetBalance(ctx, types.VaultAddress(vault.Denom), vault.BackingDenom)
+			if balance.Amount.LT(vault.Escrowed) {
+				count++
+				msg += fmt.Sprintf("\tvault %s escrows %s%s, its account holds %s\n", vault.Denom, vault.Escrowed, vault.BackingDenom, balance)
+			}
+		}
+
+		broken := count != 0
+		return sdk.FormatInvariant(types.ModuleName, "vault-backing",
+			fmt.Sprintf("found %d unbacked vaults\n%s", count, msg)), broken
+	}
+}
+
+// CW20BackingInvariant checks that the supply of every denom bound to a CW20 contract is its
+// escrowed amount of CW20 tokens.
+func CW20BackingInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		count := 0
+
+		for _, bridge := range k.GetAllCW20Bridges(ctx) {
+			if err := k.CheckCW20Backing(ctx, bridge.Denom); err != nil {
+				count++
+				msg += fmt.Sprintf("\tbridge %s: %s\n", bridge.Denom, err)
+			}
+		}
+
+		broken := count != 0
+		return sdk.FormatInvariant(types.ModuleName, "cw20-backing",
+			fmt.Sprintf("found %d unbacked cw20 bridges\n%s", count, msg)), broken
+	}
+}
+
+// BasketBackingInvariant checks that the supply of every basket denom is the supply minted by
+// its deposits, and that the account of the basket holds the escrowed components.
+func BasketBackingInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		count := 0
+
+		for _, basket := range k.GetAllBaskets(ctx) {
+			if err := k.CheckBasketBacking(ctx, basket.Denom); err != nil {
+				count++
+				msg += fmt.Sprintf("\tbasket %s: %s\n", basket.Denom, err)
+			}
+
+			balances := k.bankKeeper.GetAllBalances(ctx, types.BasketAddress(basket.Denom))
+			if !balances.IsAllGTE(basket.Escrowed) {
+				count++
+				msg += fmt.Sprintf("\tbasket %s escrows %s, its account holds %s\n", basket.Denom, basket.Escrowed, balances)
+			}
+		}
+
+		broken := count != 0
+		return sdk.FormatInvariant(types.ModuleName, "basket-backing",
+			fmt.Sprintf("found %d unbacked baskets\n%s", count, msg)), broken
+	}
+}
+
+// VestingLockedInvariant checks that the holder of every vesting schedule holds at least the
+// amount locked by the schedule.
+func VestingLockedInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		count := 0
+
+		iterator := k.GetAllDenomsIterator(ctx)
+		defer iterator.Close()
+
+		for ; iterator.Valid(); iterator.Next() {
+			denom := string(iterator.Value())
+
+			for _, schedule := range k.GetAllVestingSchedules(ctx, denom) {
+				holder, err := sdk.AccAddressFromBech32(schedule.Recipient)
+				if err != nil {
+					count++
+					msg += fmt.Sprintf("\tvesting schedule of %s has an invalid holder %s\n", denom, schedule.Recipient)
+					continue
+				}
+
+				locked := schedule.LockedAmount(ctx.BlockTime())
+				if balance := k.bankKeeper.GetBalance(ctx, holder, denom); balance.Amount.LT(locked) {
+					count++
+					msg += fmt.Sprintf("\t%s has %s%s locked, its balance is %s\n", schedule.Recipient, locked, denom, balance)
+				}
+			}
+		}
+
+		broken := count != 0
+		return sdk.FormatInvariant(types.ModuleName, "vesting-locked",
+			fmt.Sprintf("found %d holders with less than their locked amount\n%s", count, msg)), broken
+	}
+}
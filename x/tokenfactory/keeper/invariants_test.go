@@ -0,0 +1,136 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/cosmos/tokenfactory/x/tokenfactory/keeper"
+	"github.com/cosmos/tokenfactory/x/tokenfactory/types"
+
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (suite *KeeperTestSuite) TestInvariants() {
+	for _, tc := range []struct {
+		desc      string
+		malleate  func()
+		invariant func(keeper.Keeper) sdk.Invariant
+	}{
+		{
+			desc: "indexed denom without metadata",
+			malleate: func() {
+				creator := suite.TestAccs[0].String()
+				suite.App.TokenFactoryKeeper.GetCreatorPrefixStore(suite.Ctx, creator).Set([]byte("factory/"+creator+"/ghost"), []byte("factory/"+creator+"/ghost"))
+			},
+			invariant: keeper.DenomMetadataInvariant,
+		},
+		{
+			desc: "denom indexed under another creator",
+			malleate: func() {
+				suite.App.TokenFactoryKeeper.GetCreatorPrefixStore(suite.Ctx, suite.TestAccs[1].String()).Set([]byte(suite.defaultDenom), []byte(suite.defaultDenom))
+			},
+			invariant: keeper.CreatorPrefixInvariant,
+		},
+		{
+			desc: "module account residual balance",
+			malleate: func() {
+				coins := sdk.NewCoins(sdk.NewInt64Coin(suite.defaultDenom, 10))
+				suite.Require().NoError(suite.App.BankKeeper.MintCoins(suite.Ctx, types.ModuleName, coins))
+			},
+			invariant: keeper.ModuleAccountBalanceInvariant,
+		},
+		{
+			desc: "vault denom minted outside of its vault",
+			malleate: func() {
+				vaultDenom := "factory/" + suite.TestAccs[1].String() + "/wtwo"
+				coins := sdk.NewCoins(sdk.NewInt64Coin(vaultDenom, 10))
+				suite.Require().NoError(suite.App.BankKeeper.MintCoins(suite.Ctx, types.ModuleName, coins))
+				suite.Require().NoError(suite.App.BankKeeper.SendCoinsFromModuleToAccount(suite.Ctx, types.ModuleName, suite.TestAccs[1], coins))
+			},
+			invariant: keeper.VaultBackingInvariant,
+		},
+		{
+			desc: "bridged denom minted outside of its bridge",
+			malleate: func() {
+				bridgedDenom := "factory/" + suite.TestAccs[1].String() + "/renounced"
+				coins := sdk.NewCoins(sdk.NewInt64Coin(bridgedDenom, 10))
+				suite.Require().NoError(suite.App.BankKeeper.MintCoins(suite.Ctx, types.ModuleName, coins))
+				suite.Require().NoError(suite.App.BankKeeper.SendCoinsFromModuleToAccount(suite.Ctx, types.ModuleName, suite.TestAccs[1], coins))
+			},
+			invariant: keeper.CW20BackingInvariant,
+		},
+		{
+			desc: "basket denom minted outside of its basket",
+			malleate: func() {
+				basketDenom := "factory/" + suite.TestAccs[1].String() + "/index"
+				coins := sdk.NewCoins(sdk.NewInt64Coin(basketDenom, 10))
+				suite.Require().NoError(suite.App.BankKeeper.MintCoins(suite.Ctx, types.ModuleName, coins))
+				suite.Require().NoError(suite.App.BankKeeper.SendCoinsFromModuleToAccount(suite.Ctx, types.ModuleName, suite.TestAccs[1], coins))
+			},
+			invariant: keeper.BasketBackingInvariant,
+		},
+		{
+			desc: "basket components moved out of its account",
+			malleate: func() {
+				basketAddr := types.BasketAddress("factory/" + suite.TestAccs[1].String() + "/index")
+				coins := sdk.NewCoins(sdk.NewInt64Coin("utwo", 1))
+				suite.Require().NoError(suite.App.BankKeeper.SendCoins(suite.Ctx, basketAddr, suite.TestAccs[1], coins))
+			},
+			invariant: keeper.BasketBackingInvariant,
+		},
+		{
+			desc: "vesting schedule locking more than the balance",
+			malleate: func() {
+				schedule, found := suite.App.TokenFactoryKeeper.GetVestingSchedule(suite.Ctx, suite.defaultDenom, suite.TestAccs[2].String())
+				suite.Require().True(found)
+				schedule.Amount = sdkmath.NewInt(1000)
+				store := prefix.NewStore(suite.App.TokenFactoryKeeper.GetDenomPrefixStore(suite.Ctx, suite.defaultDenom), types.GetVestingPrefix())
+				store.Set([]byte(schedule.Recipient), suite.App.AppCodec().MustMarshal(&schedule))
+			},
+			invariant: keeper.VestingLockedInvariant,
+		},
+	} {
+		suite.Run(tc.desc, func() {
+			suite.SetupTest()
+			suite.CreateDefaultDenom()
+			_, err := suite.msgServer.Mint(suite.Ctx, types.NewMsgMint(suite.TestAccs[0].String(), sdk.NewInt64Coin(suite.defaultDenom, 100)))
+			suite.Require().NoError(err)
+			_, err = suite.msgServer.Burn(suite.Ctx, types.NewMsgBurn(suite.TestAccs[0].String(), sdk.NewInt64Coin(suite.defaultDenom, 50)))
+			suite.Require().NoError(err)
+			_, err = suite.msgServer.MintVesting(suite.Ctx, types.NewMsgMintVesting(suite.TestAccs[0].String(), sdk.NewInt64Coin(suite.defaultDenom, 100), suite.TestAccs[2].String(), time.Time{}, suite.Ctx.BlockTime().Add(time.Hour), nil))
+			suite.Require().NoError(err)
+			_, err = suite.msgServer.RequestRedemption(suite.Ctx, types.NewMsgRequestRedemption(suite.TestAccs[0].String(), sdk.NewInt64Coin(suite.defaultDenom, 10), "iban"))
+			suite.Require().NoError(err)
+
+			// a renounced denom still has authority metadata
+			res, err := suite.msgServer.CreateDenom(suite.Ctx, types.NewMsgCreateDenom(suite.TestAccs[1].String(), "renounced"))
+			suite.Require().NoError(err)
+			_, err = suite.msgServer.RegisterCW20(suite.Ctx, types.NewMsgRegisterCW20(suite.TestAccs[1].String(), res.GetNewTokenDenom(), suite.TestAccs[2].String()))
+			suite.Require().NoError(err)
+			_, err = suite.msgServer.ChangeAdmin(suite.Ctx, types.NewMsgChangeAdmin(suite.TestAccs[1].String(), res.GetNewTokenDenom(), ""))
+			suite.Require().NoError(err)
+
+			vaultRes, err := suite.msgServer.CreateVault(suite.Ctx, types.NewMsgCreateVault(suite.TestAccs[1].String(), "wtwo", "utwo", sdkmath.NewInt(100)))
+			suite.Require().NoError(err)
+			_, err = suite.msgServer.VaultDeposit(suite.Ctx, types.NewMsgVaultDeposit(suite.TestAccs[1].String(), vaultRes.GetNewTokenDenom(), sdkmath.NewInt(5)))
+			suite.Require().NoError(err)
+
+			basketRes, err := suite.msgServer.CreateBasket(suite.Ctx, types.NewMsgCreateBasket(suite.TestAccs[1].String(), "index", sdk.NewCoins(sdk.NewInt64Coin("utwo", 10)), types.MinBasketCompositionTimelock))
+			suite.Require().NoError(err)
+			_, err = suite.msgServer.BasketMint(suite.Ctx, types.NewMsgBasketMint(suite.TestAccs[1].String(), basketRes.GetNewTokenDenom(), sdkmath.NewInt(5)))
+			suite.Require().NoError(err)
+
+			msg, broken := keeper.AllInvariants(suite.App.TokenFactoryKeeper)(suite.Ctx)
+			suite.Require().False(broken, msg)
+
+			tc.malleate()
+
+			msg, broken = tc.invariant(suite.App.TokenFactoryKeeper)(suite.Ctx)
+			suite.Require().True(broken, msg)
+			_, broken = keeper.AllInvariants(suite.App.TokenFactoryKeeper)(suite.Ctx)
+			suite.Require().True(broken)
+		})
+	}
+}
@@ -16,13 +16,16 @@ import (
 
 type (
 	Keeper struct {
-		cdc       codec.BinaryCodec
-		storeKey  store.StoreKey
-		permAddrs map[string]authtypes.PermissionsForAddress
+		cdc               codec.BinaryCodec
+		storeKey          store.StoreKey
+		transientStoreKey store.StoreKey
+		permAddrs         map[string]authtypes.PermissionsForAddress
 
 		accountKeeper       types.AccountKeeper
 		bankKeeper          types.BankKeeper
 		communityPoolKeeper types.CommunityPoolKeeper
+		contractKeeper      types.ContractKeeper
+		hooks               types.TokenFactoryHooks
 
 		enabledCapabilities []string
 
@@ -36,6 +39,7 @@ type (
 func NewKeeper(
 	cdc codec.BinaryCodec,
 	storeKey store.StoreKey,
+	transientStoreKey store.StoreKey,
 	maccPerms map[string][]string,
 	accountKeeper types.AccountKeeper,
 	bankKeeper types.BankKeeper,
@@ -49,9 +53,10 @@ func NewKeeper(
 	}
 
 	return Keeper{
-		cdc:       cdc,
-		storeKey:  storeKey,
-		permAddrs: permAddrs,
+		cdc:               cdc,
+		storeKey:          storeKey,
+		transientStoreKey: transientStoreKey,
+		permAddrs:         permAddrs,
 
 		accountKeeper:       accountKeeper,
 		bankKeeper:          bankKeeper,
@@ -76,6 +81,31 @@ func (k *Keeper) SetEnabledCapabilities(_ sdk.Context, newCapabilities []string)
 	k.enabledCapabilities = newCapabilities
 }
 
+// SetContractKeeper sets the contract keeper used to call the denom hooks and the before send
+// hooks. It is set after the keeper creation as the wasm keeper depends on the tokenfactory
+// keeper.
+func (k *Keeper) SetContractKeeper(contractKeeper types.ContractKeeper) {
+	k.contractKeeper = contractKeeper
+}
+
+// SetHooks sets the tokenfactory hooks, it panics when the hooks are already set.
+func (k *Keeper) SetHooks(hooks types.TokenFactoryHooks) {
+	if k.hooks != nil {
+		panic("cannot set tokenfactory hooks twice")
+	}
+
+	k.hooks = hooks
+}
+
+// Hooks returns the tokenfactory hooks, or no-op hooks when none are set.
+func (k Keeper) Hooks() types.TokenFactoryHooks {
+	if k.hooks == nil {
+		return types.MultiTokenFactoryHooks{}
+	}
+
+	return k.hooks
+}
+
 // Logger returns a logger for the x/tokenfactory module
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	v2 "github.com/cosmos/tokenfactory/x/tokenfactory/migrations/v2"
+	"github.com/cosmos/tokenfactory/x/tokenfactory/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
@@ -45,6 +46,19 @@ func (m Migrator) Migrate1to2(ctx sdk.Context) error {
 	return v2.Migrate(ctx, ctx.KVStore(m.keeper.storeKey), m.keeper.cdc)
 }
 
+// Migrate2to3 migrates the x/tokenfactory module state from the consensus version 2 to
+// version 3. It sets the params added in version 3 to their default values.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	defaultParams := types.DefaultParams()
+
+	params := m.keeper.GetParams(ctx)
+	params.WasmQueryGasPerItem = defaultParams.WasmQueryGasPerItem
+	params.DenomHookGasLimit = defaultParams.DenomHookGasLimit
+	params.BeforeSendHookGasLimit = defaultParams.BeforeSendHookGasLimit
+	params.RedemptionTimeout = defaultParams.RedemptionTimeout
+	return m.keeper.SetParams(ctx, params)
+}
+
 func (m Migrator) SetMetadata(denomMetadata *banktypes.Metadata) {
 	if len(denomMetadata.Base) == 0 {
 		panic(fmt.Errorf("no base exists for denom %v", denomMetadata))
@@ -0,0 +1,24 @@
+package keeper_test
+
+import (
+	"github.com/cosmos/tokenfactory/x/tokenfactory/keeper"
+	"github.com/cosmos/tokenfactory/x/tokenfactory/types"
+)
+
+func (suite *KeeperTestSuite) TestMigrate2to3() {
+	params := types.DefaultParams()
+	params.WasmQueryGasPerItem = 0
+	params.DenomHookGasLimit = 0
+	params.BeforeSendHookGasLimit = 0
+	params.RedemptionTimeout = 0
+	suite.Require().NoError(suite.App.TokenFactoryKeeper.SetParams(suite.Ctx, params))
+
+	m := keeper.NewMigrator(suite.App.TokenFactoryKeeper)
+	suite.Require().NoError(m.Migrate2to3(suite.Ctx))
+
+	params.WasmQueryGasPerItem = types.DefaultParams().WasmQueryGasPerItem
+	params.DenomHookGasLimit = types.DefaultParams().DenomHookGasLimit
+	params.BeforeSendHookGasLimit = types.DefaultParams().BeforeSendHookGasLimit
+	params.RedemptionTimeout = types.DefaultParams().RedemptionTimeout
+	suite.Require().Equal(params, suite.App.TokenFactoryKeeper.GetParams(suite.Ctx))
+}
@@ -35,6 +35,28 @@ func (server msgServer) CreateDenom(goCtx context.Context, msg *types.MsgCreateD
 		return nil, err
 	}
 
+	if msg.DenomHook != nil {
+		if err := server.Keeper.setDenomHook(ctx, denom, msg.DenomHook); err != nil {
+			return nil, err
+		}
+
+		if err := ctx.EventManager().EmitTypedEvent(&types.EventSetDenomHook{
+			Denom:     denom,
+			DenomHook: msg.DenomHook,
+		}); err != nil {
+			return nil, err
+		}
+
+		if err := server.Keeper.callDenomHook(ctx, denom, types.DenomHookSudoMsg{
+			DenomCreated: &types.DenomCreatedHook{
+				Denom:   denom,
+				Creator: msg.Sender,
+			},
+		}); err != nil {
+			return nil, err
+		}
+	}
+
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			types.TypeMsgCreateDenom,
@@ -43,6 +65,13 @@ func (server msgServer) CreateDenom(goCtx context.Context, msg *types.MsgCreateD
 		),
 	})
 
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventCreateDenom{
+		Creator:       msg.Sender,
+		NewTokenDenom: denom,
+	}); err != nil {
+		return nil, err
+	}
+
 	return &types.MsgCreateDenomResponse{
 		NewTokenDenom: denom,
 	}, nil
@@ -72,11 +101,17 @@ func (server msgServer) Mint(goCtx context.Context, msg *types.MsgMint) (*types.
 		msg.MintToAddress = msg.Sender
 	}
 
+	if err := server.Keeper.checkReferenceID(ctx, msg.Amount.Denom, msg.ReferenceId); err != nil {
+		return nil, err
+	}
+
 	err = server.Keeper.mintTo(ctx, msg.Amount, msg.MintToAddress)
 	if err != nil {
 		return nil, err
 	}
 
+	server.Keeper.setReferenceID(ctx, msg.Amount.Denom, msg.ReferenceId, ctx.BlockHeight())
+
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			types.TypeMsgMint,
@@ -85,7 +120,23 @@ func (server msgServer) Mint(goCtx context.Context, msg *types.MsgMint) (*types.
 		),
 	})
 
-	return &types.MsgMintResponse{}, nil
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventMint{
+		MintToAddress: msg.MintToAddress,
+		Amount:        msg.Amount,
+		ReferenceId:   msg.ReferenceId,
+	}); err != nil {
+		return nil, err
+	}
+
+	mintToAddr, err := sdk.AccAddressFromBech32(msg.MintToAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgMintResponse{
+		TotalSupply:   server.bankKeeper.GetSupply(ctx, msg.Amount.Denom),
+		MintToBalance: server.bankKeeper.GetBalance(ctx, mintToAddr, msg.Amount.Denom),
+	}, nil
 }
 
 func (server msgServer) Burn(goCtx context.Context, msg *types.MsgBurn) (*types.MsgBurnResponse, error) {
@@ -106,11 +157,17 @@ func (server msgServer) Burn(goCtx context.Context, msg *types.MsgBurn) (*types.
 		return nil, types.ErrCapabilityNotEnabled
 	}
 
+	if err := server.Keeper.checkReferenceID(ctx, msg.Amount.Denom, msg.ReferenceId); err != nil {
+		return nil, err
+	}
+
 	err = server.Keeper.burnFrom(ctx, msg.Amount, msg.BurnFromAddress)
 	if err != nil {
 		return nil, err
 	}
 
+	server.Keeper.setReferenceID(ctx, msg.Amount.Denom, msg.ReferenceId, ctx.BlockHeight())
+
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			types.TypeMsgBurn,
@@ -119,7 +176,23 @@ func (server msgServer) Burn(goCtx context.Context, msg *types.MsgBurn) (*types.
 		),
 	})
 
-	return &types.MsgBurnResponse{}, nil
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventBurn{
+		BurnFromAddress: msg.BurnFromAddress,
+		Amount:          msg.Amount,
+		ReferenceId:     msg.ReferenceId,
+	}); err != nil {
+		return nil, err
+	}
+
+	burnFromAddr, err := sdk.AccAddressFromBech32(msg.BurnFromAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgBurnResponse{
+		TotalSupply:     server.bankKeeper.GetSupply(ctx, msg.Amount.Denom),
+		BurnFromBalance: server.bankKeeper.GetBalance(ctx, burnFromAddr, msg.Amount.Denom),
+	}, nil
 }
 
 func (server msgServer) ForceTransfer(goCtx context.Context, msg *types.MsgForceTransfer) (*types.MsgForceTransferResponse, error) {
@@ -152,7 +225,27 @@ func (server msgServer) ForceTransfer(goCtx context.Context, msg *types.MsgForce
 		),
 	})
 
-	return &types.MsgForceTransferResponse{}, nil
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventForceTransfer{
+		TransferFromAddress: msg.TransferFromAddress,
+		TransferToAddress:   msg.TransferToAddress,
+		Amount:              msg.Amount,
+	}); err != nil {
+		return nil, err
+	}
+
+	fromAddr, err := sdk.AccAddressFromBech32(msg.TransferFromAddress)
+	if err != nil {
+		return nil, err
+	}
+	toAddr, err := sdk.AccAddressFromBech32(msg.TransferToAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgForceTransferResponse{
+		TransferFromBalance: server.bankKeeper.GetBalance(ctx, fromAddr, msg.Amount.Denom),
+		TransferToBalance:   server.bankKeeper.GetBalance(ctx, toAddr, msg.Amount.Denom),
+	}, nil
 }
 
 func (server msgServer) ChangeAdmin(goCtx context.Context, msg *types.MsgChangeAdmin) (*types.MsgChangeAdminResponse, error) {
@@ -167,7 +260,7 @@ func (server msgServer) ChangeAdmin(goCtx context.Context, msg *types.MsgChangeA
 		return nil, types.ErrUnauthorized
 	}
 
-	err = server.Keeper.setAdmin(ctx, authorityMetadata, msg.Denom, msg.NewAdmin)
+	err = server.Keeper.changeAdmin(ctx, authorityMetadata, msg.Denom, msg.NewAdmin)
 	if err != nil {
 		return nil, err
 	}
@@ -179,7 +272,16 @@ func (server msgServer) ChangeAdmin(goCtx context.Context, msg *types.MsgChangeA
 		),
 	})
 
-	return &types.MsgChangeAdminResponse{}, nil
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventChangeAdmin{
+		Denom:    msg.Denom,
+		NewAdmin: msg.NewAdmin,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgChangeAdminResponse{
+		PreviousAdmin: authorityMetadata.GetAdmin(),
+	}, nil
 }
 
 func (server msgServer) SetDenomMetadata(goCtx context.Context, msg *types.MsgSetDenomMetadata) (*types.MsgSetDenomMetadataResponse, error) {
@@ -204,7 +306,9 @@ func (server msgServer) SetDenomMetadata(goCtx context.Context, msg *types.MsgSe
 		return nil, types.ErrUnauthorized
 	}
 
-	server.Keeper.bankKeeper.SetDenomMetaData(ctx, msg.Metadata)
+	if err := server.Keeper.SetDenomMetadata(ctx, msg.Metadata); err != nil {
+		return nil, err
+	}
 
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
@@ -214,16 +318,874 @@ func (server msgServer) SetDenomMetadata(goCtx context.Context, msg *types.MsgSe
 		),
 	})
 
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventSetDenomMetadata{
+		Denom:    msg.Metadata.Base,
+		Metadata: msg.Metadata,
+	}); err != nil {
+		return nil, err
+	}
+
 	return &types.MsgSetDenomMetadataResponse{}, nil
 }
 
-func (server msgServer) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
-	if server.authority != req.Authority {
-		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", server.authority, req.Authority)
+func (server msgServer) SetDenomHook(goCtx context.Context, msg *types.MsgSetDenomHook) (*types.MsgSetDenomHookResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
 	}
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
-	if err := server.SetParams(ctx, req.Params); err != nil {
+
+	authorityMetadata, err := server.Keeper.GetAuthorityMetadata(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Sender != authorityMetadata.GetAdmin() {
+		return nil, types.ErrUnauthorized
+	}
+
+	if err := server.Keeper.setDenomHook(ctx, msg.Denom, msg.DenomHook); err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventSetDenomHook{
+		Denom:     msg.Denom,
+		DenomHook: msg.DenomHook,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetDenomHookResponse{}, nil
+}
+
+func (server msgServer) SetBeforeSendHook(goCtx context.Context, msg *types.MsgSetBeforeSendHook) (*types.MsgSetBeforeSendHookResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !types.IsCapabilityEnabled(server.Keeper.enabledCapabilities, types.EnableBeforeSendHook) {
+		return nil, types.ErrCapabilityNotEnabled
+	}
+
+	authorityMetadata, err := server.Keeper.GetAuthorityMetadata(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Sender != authorityMetadata.GetAdmin() {
+		return nil, types.ErrUnauthorized
+	}
+
+	if err := server.Keeper.setBeforeSendHook(ctx, msg.Denom, msg.CosmwasmAddress); err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventSetBeforeSendHook{
+		Denom:           msg.Denom,
+		CosmwasmAddress: msg.CosmwasmAddress,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetBeforeSendHookResponse{}, nil
+}
+
+func (server msgServer) MultiMint(goCtx context.Context, msg *types.MsgMultiMint) (*types.MsgMultiMintResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Verify denom exists
+	_, denomExists := server.bankKeeper.GetDenomMetaData(ctx, msg.Denom)
+	if !denomExists {
+		return nil, types.ErrDenomDoesNotExist.Wrapf("denom: %s", msg.Denom)
+	}
+
+	// Verify sender is the denom admin, once for all the outputs
+	authorityMetadata, err := server.Keeper.GetAuthorityMetadata(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Sender != authorityMetadata.GetAdmin() {
+		return nil, types.ErrUnauthorized
+	}
+
+	if err := server.Keeper.multiMintTo(ctx, msg.Denom, msg.Outputs); err != nil {
+		return nil, err
+	}
+
+	for _, output := range msg.Outputs {
+		amount := sdk.NewCoin(msg.Denom, output.Amount)
+
+		ctx.EventManager().EmitEvents(sdk.Events{
+			sdk.NewEvent(
+				types.TypeMsgMint,
+				sdk.NewAttribute(types.AttributeMintToAddress, output.Address),
+				sdk.NewAttribute(types.AttributeAmount, amount.String()),
+			),
+		})
+
+		if err := ctx.EventManager().EmitTypedEvent(&types.EventMint{
+			MintToAddress: output.Address,
+			Amount:        amount,
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	return &types.MsgMultiMintResponse{
+		TotalSupply: server.bankKeeper.GetSupply(ctx, msg.Denom),
+	}, nil
+}
+
+func (server msgServer) MultiBurn(goCtx context.Context, msg *types.MsgMultiBurn) (*types.MsgMultiBurnResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !types.IsCapabilityEnabled(server.Keeper.enabledCapabilities, types.EnableBurnFrom) {
+		return nil, types.ErrCapabilityNotEnabled
+	}
+
+	// Verify sender is the denom admin, once for all the inputs
+	authorityMetadata, err := server.Keeper.GetAuthorityMetadata(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Sender != authorityMetadata.GetAdmin() {
+		return nil, types.ErrUnauthorized
+	}
+
+	if err := server.Keeper.multiBurnFrom(ctx, msg.Denom, msg.Inputs); err != nil {
+		return nil, err
+	}
+
+	for _, input := range msg.Inputs {
+		amount := sdk.NewCoin(msg.Denom, input.Amount)
+
+		ctx.EventManager().EmitEvents(sdk.Events{
+			sdk.NewEvent(
+				types.TypeMsgBurn,
+				sdk.NewAttribute(types.AttributeBurnFromAddress, input.Address),
+				sdk.NewAttribute(types.AttributeAmount, amount.String()),
+			),
+		})
+
+		if err := ctx.EventManager().EmitTypedEvent(&types.EventBurn{
+			BurnFromAddress: input.Address,
+			Amount:          amount,
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	return &types.MsgMultiBurnResponse{
+		TotalSupply: server.bankKeeper.GetSupply(ctx, msg.Denom),
+	}, nil
+}
+
+func (server msgServer) CreateClaimCampaign(goCtx context.Context, msg *types.MsgCreateClaimCampaign) (*types.MsgCreateClaimCampaignResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Verify denom exists
+	_, denomExists := server.bankKeeper.GetDenomMetaData(ctx, msg.Denom)
+	if !denomExists {
+		return nil, types.ErrDenomDoesNotExist.Wrapf("denom: %s", msg.Denom)
+	}
+
+	authorityMetadata, err := server.Keeper.GetAuthorityMetadata(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Sender != authorityMetadata.GetAdmin() {
+		return nil, types.ErrUnauthorized
+	}
+
+	campaignID, err := server.Keeper.CreateClaimCampaign(ctx, msg.Sender, msg.Denom, msg.MerkleRoot, msg.TotalCap, msg.Expiry)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventCreateClaimCampaign{
+		CampaignId: campaignID,
+		Denom:      msg.Denom,
+		MerkleRoot: msg.MerkleRoot,
+		TotalCap:   msg.TotalCap,
+		Expiry:     msg.Expiry,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreateClaimCampaignResponse{CampaignId: campaignID}, nil
+}
+
+func (server msgServer) Claim(goCtx context.Context, msg *types.MsgClaim) (*types.MsgClaimResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	claimed, err := server.Keeper.Claim(ctx, msg.CampaignId, msg.Sender, msg.Amount, msg.Proof)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventMint{
+		MintToAddress: msg.Sender,
+		Amount:        claimed,
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventClaim{
+		CampaignId: msg.CampaignId,
+		Address:    msg.Sender,
+		Amount:     claimed,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgClaimResponse{Claimed: claimed}, nil
+}
+
+func (server msgServer) CloseClaimCampaign(goCtx context.Context, msg *types.MsgCloseClaimCampaign) (*types.MsgCloseClaimCampaignResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	campaign, found := server.Keeper.GetClaimCampaign(ctx, msg.CampaignId)
+	if !found {
+		return nil, errors.Wrapf(types.ErrClaimCampaignNotFound, "id %d", msg.CampaignId)
+	}
+
+	// the current admin of the denom can close the campaign, even if it did not create it
+	authorityMetadata, err := server.Keeper.GetAuthorityMetadata(ctx, campaign.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Sender != authorityMetadata.GetAdmin() {
+		return nil, types.ErrUnauthorized
+	}
+
+	if _, err := server.Keeper.CloseClaimCampaign(ctx, msg.CampaignId); err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventCloseClaimCampaign{
+		CampaignId: msg.CampaignId,
+		Denom:      campaign.Denom,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCloseClaimCampaignResponse{}, nil
+}
+
+func (server msgServer) SetVoucherSigner(goCtx context.Context, msg *types.MsgSetVoucherSigner) (*types.MsgSetVoucherSignerResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	authorityMetadata, err := server.Keeper.GetAuthorityMetadata(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Sender != authorityMetadata.GetAdmin() {
+		return nil, types.ErrUnauthorized
+	}
+
+	if err := server.Keeper.setVoucherSigner(ctx, msg.Denom, msg.PubKey); err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventSetVoucherSigner{
+		Denom:  msg.Denom,
+		PubKey: msg.PubKey,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetVoucherSignerResponse{}, nil
+}
+
+func (server msgServer) RedeemMintVoucher(goCtx context.Context, msg *types.MsgRedeemMintVoucher) (*types.MsgRedeemMintVoucherResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	minted, err := server.Keeper.RedeemMintVoucher(ctx, msg.Voucher, msg.Signature)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventMint{
+		MintToAddress: msg.Voucher.Recipient,
+		Amount:        minted,
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventRedeemMintVoucher{
+		Sender:    msg.Sender,
+		Recipient: msg.Voucher.Recipient,
+		Amount:    minted,
+		Nonce:     msg.Voucher.Nonce,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRedeemMintVoucherResponse{Minted: minted}, nil
+}
+
+func (server msgServer) SetReserveAttestor(goCtx context.Context, msg *types.MsgSetReserveAttestor) (*types.MsgSetReserveAttestorResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	authorityMetadata, err := server.Keeper.GetAuthorityMetadata(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Sender != authorityMetadata.GetAdmin() {
+		return nil, types.ErrUnauthorized
+	}
+
+	if err := server.Keeper.setReserveAttestor(ctx, msg.Denom, types.ReserveAttestor{
+		Attestor:     msg.Attestor,
+		MaxStaleness: msg.MaxStaleness,
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventSetReserveAttestor{
+		Denom:        msg.Denom,
+		Attestor:     msg.Attestor,
+		MaxStaleness: msg.MaxStaleness,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetReserveAttestorResponse{}, nil
+}
+
+func (server msgServer) AttestReserve(goCtx context.Context, msg *types.MsgAttestReserve) (*types.MsgAttestReserveResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	attestation, err := server.Keeper.AttestReserve(ctx, msg.Sender, msg.Denom, msg.Amount, msg.Timestamp, msg.ReportHash)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventAttestReserve{
+		Denom:      msg.Denom,
+		Id:         attestation.Id,
+		Attestor:   attestation.Attestor,
+		Amount:     attestation.Amount,
+		Timestamp:  attestation.Timestamp,
+		ReportHash: attestation.ReportHash,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgAttestReserveResponse{Id: attestation.Id}, nil
+}
+
+func (server msgServer) RequestRedemption(goCtx context.Context, msg *types.MsgRequestRedemption) (*types.MsgRequestRedemptionResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	redemption, err := server.Keeper.RequestRedemption(ctx, msg.Sender, msg.Amount, msg.PayoutReference)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventRequestRedemption{
+		Denom:           msg.Amount.Denom,
+		Id:              redemption.Id,
+		Holder:          msg.Sender,
+		Amount:          msg.Amount,
+		PayoutReference: msg.PayoutReference,
+		Timeout:         redemption.Timeout,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRequestRedemptionResponse{Id: redemption.Id}, nil
+}
+
+func (server msgServer) FulfillRedemption(goCtx context.Context, msg *types.MsgFulfillRedemption) (*types.MsgFulfillRedemptionResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	redemption, err := server.Keeper.FulfillRedemption(ctx, msg.Sender, msg.Denom, msg.Id, msg.SettlementReference)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventBurn{
+		BurnFromAddress: redemption.Holder,
+		Amount:          redemption.Amount,
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventFulfillRedemption{
+		Denom:               msg.Denom,
+		Id:                  msg.Id,
+		SettlementReference: msg.SettlementReference,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgFulfillRedemptionResponse{}, nil
+}
+
+func (server msgServer) RejectRedemption(goCtx context.Context, msg *types.MsgRejectRedemption) (*types.MsgRejectRedemptionResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	redemption, err := server.Keeper.RejectRedemption(ctx, msg.Sender, msg.Denom, msg.Id, msg.Reason)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventRefundRedemption{
+		Denom:  msg.Denom,
+		Id:     msg.Id,
+		Holder: redemption.Holder,
+		Amount: redemption.Amount,
+		Status: redemption.Status,
+		Reason: msg.Reason,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRejectRedemptionResponse{}, nil
+}
+
+func (server msgServer) CreateVault(goCtx context.Context, msg *types.MsgCreateVault) (*types.MsgCreateVaultResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	vault, err := server.Keeper.CreateVault(ctx, msg.Sender, msg.Subdenom, msg.BackingDenom, msg.Ratio)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventCreateDenom{
+		Creator:       msg.Sender,
+		NewTokenDenom: vault.Denom,
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventCreateVault{
+		Denom:        vault.Denom,
+		BackingDenom: vault.BackingDenom,
+		Ratio:        vault.Ratio,
+		Address:      types.VaultAddress(vault.Denom).String(),
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreateVaultResponse{NewTokenDenom: vault.Denom}, nil
+}
+
+func (server msgServer) VaultDeposit(goCtx context.Context, msg *types.MsgVaultDeposit) (*types.MsgVaultDepositResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	vault, found := server.Keeper.GetVault(ctx, msg.Denom)
+	if !found {
+		return nil, errors.Wrapf(types.ErrVaultNotFound, "denom %s", msg.Denom)
+	}
+
+	minted, err := server.Keeper.VaultDeposit(ctx, msg.Sender, msg.Denom, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventMint{
+		MintToAddress: msg.Sender,
+		Amount:        minted,
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventVaultDeposit{
+		Denom:     msg.Denom,
+		Depositor: msg.Sender,
+		Backing:   sdk.NewCoin(vault.BackingDenom, msg.Amount),
+		Minted:    minted,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgVaultDepositResponse{Minted: minted}, nil
+}
+
+func (server msgServer) VaultWithdraw(goCtx context.Context, msg *types.MsgVaultWithdraw) (*types.MsgVaultWithdrawResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	vault, found := server.Keeper.GetVault(ctx, msg.Denom)
+	if !found {
+		return nil, errors.Wrapf(types.ErrVaultNotFound, "denom %s", msg.Denom)
+	}
+
+	burned, err := server.Keeper.VaultWithdraw(ctx, msg.Sender, msg.Denom, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventBurn{
+		BurnFromAddress: msg.Sender,
+		Amount:          burned,
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventVaultWithdraw{
+		Denom:      msg.Denom,
+		Withdrawer: msg.Sender,
+		Backing:    sdk.NewCoin(vault.BackingDenom, msg.Amount),
+		Burned:     burned,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgVaultWithdrawResponse{Burned: burned}, nil
+}
+
+func (server msgServer) RegisterCW20(goCtx context.Context, msg *types.MsgRegisterCW20) (*types.MsgRegisterCW20Response, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	authorityMetadata, err := server.Keeper.GetAuthorityMetadata(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Sender != authorityMetadata.GetAdmin() {
+		return nil, types.ErrUnauthorized
+	}
+
+	if err := server.Keeper.RegisterCW20(ctx, msg.Denom, msg.ContractAddress); err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventRegisterCW20{
+		Denom:           msg.Denom,
+		ContractAddress: msg.ContractAddress,
+		Address:         types.CW20BridgeAddress.String(),
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRegisterCW20Response{}, nil
+}
+
+func (server msgServer) WithdrawCW20(goCtx context.Context, msg *types.MsgWithdrawCW20) (*types.MsgWithdrawCW20Response, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	bridge, err := server.Keeper.WithdrawCW20(ctx, msg.Sender, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventBurn{
+		BurnFromAddress: msg.Sender,
+		Amount:          msg.Amount,
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventWithdrawCW20{
+		ContractAddress: bridge.ContractAddress,
+		Withdrawer:      msg.Sender,
+		Burned:          msg.Amount,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgWithdrawCW20Response{}, nil
+}
+
+func (server msgServer) CreateBasket(goCtx context.Context, msg *types.MsgCreateBasket) (*types.MsgCreateBasketResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	basket, err := server.Keeper.CreateBasket(ctx, msg.Sender, msg.Subdenom, msg.Components, msg.CompositionTimelock)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventCreateDenom{
+		Creator:       msg.Sender,
+		NewTokenDenom: basket.Denom,
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventCreateBasket{
+		Denom:               basket.Denom,
+		Components:          basket.Components,
+		CompositionTimelock: basket.CompositionTimelock,
+		Address:             types.BasketAddress(basket.Denom).String(),
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreateBasketResponse{NewTokenDenom: basket.Denom}, nil
+}
+
+func (server msgServer) BasketMint(goCtx context.Context, msg *types.MsgBasketMint) (*types.MsgBasketMintResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	deposited, err := server.Keeper.BasketMint(ctx, msg.Sender, msg.Denom, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	minted := sdk.NewCoin(msg.Denom, msg.Amount)
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventMint{
+		MintToAddress: msg.Sender,
+		Amount:        minted,
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventBasketMint{
+		Denom:     msg.Denom,
+		Minter:    msg.Sender,
+		Deposited: deposited,
+		Minted:    minted,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgBasketMintResponse{Deposited: deposited}, nil
+}
+
+func (server msgServer) BasketRedeem(goCtx context.Context, msg *types.MsgBasketRedeem) (*types.MsgBasketRedeemResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	redeemed, err := server.Keeper.BasketRedeem(ctx, msg.Sender, msg.Denom, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	burned := sdk.NewCoin(msg.Denom, msg.Amount)
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventBurn{
+		BurnFromAddress: msg.Sender,
+		Amount:          burned,
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventBasketRedeem{
+		Denom:    msg.Denom,
+		Redeemer: msg.Sender,
+		Burned:   burned,
+		Redeemed: redeemed,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgBasketRedeemResponse{Redeemed: redeemed}, nil
+}
+
+func (server msgServer) SetBasketComposition(goCtx context.Context, msg *types.MsgSetBasketComposition) (*types.MsgSetBasketCompositionResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	authorityMetadata, err := server.Keeper.GetAuthorityMetadata(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Sender != authorityMetadata.GetAdmin() {
+		return nil, types.ErrUnauthorized
+	}
+
+	executeAfter, err := server.Keeper.SetBasketComposition(ctx, msg.Denom, msg.Components)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventSetBasketComposition{
+		Denom:        msg.Denom,
+		Components:   msg.Components,
+		ExecuteAfter: executeAfter,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetBasketCompositionResponse{ExecuteAfter: executeAfter}, nil
+}
+
+func (server msgServer) MintVesting(goCtx context.Context, msg *types.MsgMintVesting) (*types.MsgMintVestingResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Verify denom exists
+	_, denomExists := server.bankKeeper.GetDenomMetaData(ctx, msg.Amount.Denom)
+	if !denomExists {
+		return nil, types.ErrDenomDoesNotExist.Wrapf("denom: %s", msg.Amount.Denom)
+	}
+
+	authorityMetadata, err := server.Keeper.GetAuthorityMetadata(ctx, msg.Amount.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Sender != authorityMetadata.GetAdmin() {
+		return nil, types.ErrUnauthorized
+	}
+
+	startTime := msg.StartTime
+	if startTime.IsZero() {
+		startTime = ctx.BlockTime()
+	}
+
+	schedule := msg.Schedule(startTime)
+	if err := server.Keeper.MintVesting(ctx, schedule); err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventMint{
+		MintToAddress: msg.Recipient,
+		Amount:        msg.Amount,
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventMintVesting{
+		Schedule: schedule,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgMintVestingResponse{}, nil
+}
+
+func (server msgServer) ClawbackVesting(goCtx context.Context, msg *types.MsgClawbackVesting) (*types.MsgClawbackVestingResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	authorityMetadata, err := server.Keeper.GetAuthorityMetadata(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Sender != authorityMetadata.GetAdmin() {
+		return nil, types.ErrUnauthorized
+	}
+
+	clawedBack, err := server.Keeper.ClawbackVesting(ctx, msg.Sender, msg.Denom, msg.Holder)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventClawbackVesting{
+		Admin:      msg.Sender,
+		Holder:     msg.Holder,
+		ClawedBack: clawedBack,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgClawbackVestingResponse{ClawedBack: clawedBack}, nil
+}
+
+func (server msgServer) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if server.authority != req.Authority {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", server.authority, req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := server.SetParams(ctx, req.Params); err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventUpdateParams{
+		Authority: req.Authority,
+		Params:    req.Params,
+	}); err != nil {
 		return nil, err
 	}
 
@@ -5,10 +5,15 @@ import (
 
 	"github.com/cosmos/tokenfactory/x/tokenfactory/types"
 
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	"github.com/cosmos/gogoproto/proto"
+
 	sdkmath "cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 )
 
 // TestMintDenomMsg tests TypeMsgMint message is emitted on a successful mint
@@ -48,6 +53,7 @@ func (suite *KeeperTestSuite) TestMintDenomMsg() {
 
 			// Ensure current number and type of event is emitted
 			suite.AssertEventEmitted(ctx, types.TypeMsgMint, tc.expectedMessageEvents)
+			suite.AssertEventEmitted(ctx, proto.MessageName(&types.EventMint{}), tc.expectedMessageEvents)
 		})
 	}
 }
@@ -88,6 +94,7 @@ func (suite *KeeperTestSuite) TestBurnDenomMsg() {
 			suite.msgServer.Burn(ctx, types.NewMsgBurn(tc.admin, sdk.NewInt64Coin(tc.burnDenom, 10))) //nolint:errcheck
 			// Ensure current number and type of event is emitted
 			suite.AssertEventEmitted(ctx, types.TypeMsgBurn, tc.expectedMessageEvents)
+			suite.AssertEventEmitted(ctx, proto.MessageName(&types.EventBurn{}), tc.expectedMessageEvents)
 		})
 	}
 }
@@ -129,6 +136,7 @@ func (suite *KeeperTestSuite) TestCreateDenomMsg() {
 			suite.msgServer.CreateDenom(ctx, types.NewMsgCreateDenom(suite.TestAccs[0].String(), tc.subdenom)) //nolint:errcheck
 			// Ensure current number and type of event is emitted
 			suite.AssertEventEmitted(ctx, types.TypeMsgCreateDenom, tc.expectedMessageEvents)
+			suite.AssertEventEmitted(ctx, proto.MessageName(&types.EventCreateDenom{}), tc.expectedMessageEvents)
 		})
 	}
 }
@@ -180,6 +188,7 @@ func (suite *KeeperTestSuite) TestChangeAdminDenomMsg() {
 			suite.msgServer.ChangeAdmin(ctx, tc.msgChangeAdmin(testDenom)) //nolint:errcheck
 			// Ensure current number and type of event is emitted
 			suite.AssertEventEmitted(ctx, types.TypeMsgChangeAdmin, tc.expectedMessageEvents)
+			suite.AssertEventEmitted(ctx, proto.MessageName(&types.EventChangeAdmin{}), tc.expectedMessageEvents)
 		})
 	}
 }
@@ -248,6 +257,212 @@ func (suite *KeeperTestSuite) TestSetDenomMetaDataMsg() {
 			suite.msgServer.SetDenomMetadata(ctx, &tc.msgSetDenomMetadata) //nolint:errcheck
 			// Ensure current number and type of event is emitted
 			suite.AssertEventEmitted(ctx, types.TypeMsgSetDenomMetadata, tc.expectedMessageEvents)
+			suite.AssertEventEmitted(ctx, proto.MessageName(&types.EventSetDenomMetadata{}), tc.expectedMessageEvents)
+
+			// The typed event must carry the metadata in a parseable form
+			for _, event := range ctx.EventManager().Events() {
+				if event.Type != proto.MessageName(&types.EventSetDenomMetadata{}) {
+					continue
+				}
+				typedEvent, err := sdk.ParseTypedEvent(abci.Event(event))
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.msgSetDenomMetadata.Metadata.String(), typedEvent.(*types.EventSetDenomMetadata).Metadata.String())
+			}
+		})
+	}
+}
+
+// TestUpdateParamsMsg tests EventUpdateParams is emitted on a successful params update
+func (suite *KeeperTestSuite) TestUpdateParamsMsg() {
+	for _, tc := range []struct {
+		desc                  string
+		authority             string
+		expectedMessageEvents int
+	}{
+		{
+			desc:      "invalid authority",
+			authority: suite.TestAccs[0].String(),
+		},
+		{
+			desc:                  "success case",
+			authority:             suite.App.TokenFactoryKeeper.GetAuthority(),
+			expectedMessageEvents: 1,
+		},
+	} {
+		suite.Run(fmt.Sprintf("Case %s", tc.desc), func() {
+			ctx := suite.Ctx.WithEventManager(sdk.NewEventManager())
+			suite.Require().Equal(0, len(ctx.EventManager().Events()))
+			// Test update params message
+			suite.msgServer.UpdateParams(ctx, &types.MsgUpdateParams{ //nolint:errcheck
+				Authority: tc.authority,
+				Params:    types.DefaultParams(),
+			})
+			// Ensure current number and type of event is emitted
+			suite.AssertEventEmitted(ctx, proto.MessageName(&types.EventUpdateParams{}), tc.expectedMessageEvents)
+		})
+	}
+}
+
+// TestMsgResponses tests that the msg responses report the resulting state
+func (suite *KeeperTestSuite) TestMsgResponses() {
+	suite.CreateDefaultDenom()
+	admin, holder := suite.TestAccs[0], suite.TestAccs[1]
+
+	mintRes, err := suite.msgServer.Mint(suite.Ctx, types.NewMsgMintTo(admin.String(), sdk.NewInt64Coin(suite.defaultDenom, 100), holder.String()))
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt64Coin(suite.defaultDenom, 100), mintRes.TotalSupply)
+	suite.Require().Equal(sdk.NewInt64Coin(suite.defaultDenom, 100), mintRes.MintToBalance)
+
+	forceTransferRes, err := suite.msgServer.ForceTransfer(suite.Ctx, types.NewMsgForceTransfer(admin.String(), sdk.NewInt64Coin(suite.defaultDenom, 30), holder.String(), admin.String()))
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt64Coin(suite.defaultDenom, 70), forceTransferRes.TransferFromBalance)
+	suite.Require().Equal(sdk.NewInt64Coin(suite.defaultDenom, 30), forceTransferRes.TransferToBalance)
+
+	burnRes, err := suite.msgServer.Burn(suite.Ctx, types.NewMsgBurn(admin.String(), sdk.NewInt64Coin(suite.defaultDenom, 10)))
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt64Coin(suite.defaultDenom, 90), burnRes.TotalSupply)
+	suite.Require().Equal(sdk.NewInt64Coin(suite.defaultDenom, 20), burnRes.BurnFromBalance)
+
+	changeAdminRes, err := suite.msgServer.ChangeAdmin(suite.Ctx, types.NewMsgChangeAdmin(admin.String(), suite.defaultDenom, holder.String()))
+	suite.Require().NoError(err)
+	suite.Require().Equal(admin.String(), changeAdminRes.PreviousAdmin)
+}
+
+// TestMultiMintMsg tests that a multi mint checks the admin once and emits a mint event per recipient
+func (suite *KeeperTestSuite) TestMultiMintMsg() {
+	suite.CreateDefaultDenom()
+	admin, recipient1, recipient2 := suite.TestAccs[0], suite.TestAccs[1], suite.TestAccs[2]
+
+	outputs := []types.MultiMintOutput{
+		{Address: recipient1.String(), Amount: sdkmath.NewInt(100)},
+		{Address: recipient2.String(), Amount: sdkmath.NewInt(50)},
+	}
+
+	for _, tc := range []struct {
+		desc                  string
+		sender                string
+		denom                 string
+		outputs               []types.MultiMintOutput
+		expErr                error
+		expectedMessageEvents int
+	}{
+		{
+			desc:    "denom does not exist",
+			sender:  admin.String(),
+			denom:   fmt.Sprintf("factory/%s/evmos", admin.String()),
+			outputs: outputs,
+			expErr:  types.ErrDenomDoesNotExist,
+		},
+		{
+			desc:    "sender is not the admin",
+			sender:  recipient1.String(),
+			denom:   suite.defaultDenom,
+			outputs: outputs,
+			expErr:  types.ErrUnauthorized,
+		},
+		{
+			desc:                  "success case",
+			sender:                admin.String(),
+			denom:                 suite.defaultDenom,
+			outputs:               outputs,
+			expectedMessageEvents: 2,
+		},
+	} {
+		suite.Run(fmt.Sprintf("Case %s", tc.desc), func() {
+			ctx := suite.Ctx.WithEventManager(sdk.NewEventManager())
+
+			res, err := suite.msgServer.MultiMint(ctx, types.NewMsgMultiMint(tc.sender, tc.denom, tc.outputs))
+			if tc.expErr != nil {
+				suite.Require().ErrorIs(err, tc.expErr)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Equal(sdk.NewInt64Coin(suite.defaultDenom, 150), res.TotalSupply)
+				suite.Require().Equal(sdk.NewInt64Coin(suite.defaultDenom, 100), suite.App.BankKeeper.GetBalance(ctx, recipient1, suite.defaultDenom))
+				suite.Require().Equal(sdk.NewInt64Coin(suite.defaultDenom, 50), suite.App.BankKeeper.GetBalance(ctx, recipient2, suite.defaultDenom))
+			}
+
+			suite.AssertEventEmitted(ctx, types.TypeMsgMint, tc.expectedMessageEvents)
+			suite.AssertEventEmitted(ctx, proto.MessageName(&types.EventMint{}), tc.expectedMessageEvents)
+		})
+	}
+
+	// a blocked recipient fails the whole message
+	ctx, _ := suite.Ctx.CacheContext()
+	_, err := suite.msgServer.MultiMint(ctx, types.NewMsgMultiMint(admin.String(), suite.defaultDenom, []types.MultiMintOutput{
+		{Address: recipient1.String(), Amount: sdkmath.NewInt(100)},
+		{Address: suite.App.AccountKeeper.GetModuleAddress(distrtypes.ModuleName).String(), Amount: sdkmath.NewInt(100)},
+	}))
+	suite.Require().ErrorContains(err, "failed to mint to blocked address")
+}
+
+// TestMultiBurnMsg tests that a multi burn requires the burn from capability and burns from every input
+func (suite *KeeperTestSuite) TestMultiBurnMsg() {
+	suite.CreateDefaultDenom()
+	admin, holder1, holder2 := suite.TestAccs[0], suite.TestAccs[1], suite.TestAccs[2]
+
+	_, err := suite.msgServer.MultiMint(suite.Ctx, types.NewMsgMultiMint(admin.String(), suite.defaultDenom, []types.MultiMintOutput{
+		{Address: holder1.String(), Amount: sdkmath.NewInt(100)},
+		{Address: holder2.String(), Amount: sdkmath.NewInt(100)},
+	}))
+	suite.Require().NoError(err)
+
+	inputs := []types.MultiBurnInput{
+		{Address: holder1.String(), Amount: sdkmath.NewInt(40)},
+		{Address: holder2.String(), Amount: sdkmath.NewInt(10)},
+	}
+
+	for _, tc := range []struct {
+		desc                  string
+		sender                string
+		inputs                []types.MultiBurnInput
+		expErr                string
+		expectedMessageEvents int
+	}{
+		{
+			desc:   "sender is not the admin",
+			sender: holder1.String(),
+			inputs: inputs,
+			expErr: types.ErrUnauthorized.Error(),
+		},
+		{
+			desc:   "insufficient funds",
+			sender: admin.String(),
+			inputs: []types.MultiBurnInput{
+				{Address: holder1.String(), Amount: sdkmath.NewInt(40)},
+				{Address: holder2.String(), Amount: sdkmath.NewInt(1000)},
+			},
+			expErr: "insufficient funds",
+		},
+		{
+			desc:                  "success case",
+			sender:                admin.String(),
+			inputs:                inputs,
+			expectedMessageEvents: 2,
+		},
+	} {
+		suite.Run(fmt.Sprintf("Case %s", tc.desc), func() {
+			// messages are atomic, failed messages are run on a discarded cache context
+			ctx, write := suite.Ctx.WithEventManager(sdk.NewEventManager()).CacheContext()
+
+			res, err := suite.msgServer.MultiBurn(ctx, types.NewMsgMultiBurn(tc.sender, suite.defaultDenom, tc.inputs))
+			if tc.expErr != "" {
+				suite.Require().ErrorContains(err, tc.expErr)
+				return
+			}
+			suite.Require().NoError(err)
+			write()
+
+			suite.Require().Equal(sdk.NewInt64Coin(suite.defaultDenom, 150), res.TotalSupply)
+			suite.Require().Equal(sdk.NewInt64Coin(suite.defaultDenom, 60), suite.App.BankKeeper.GetBalance(suite.Ctx, holder1, suite.defaultDenom))
+			suite.Require().Equal(sdk.NewInt64Coin(suite.defaultDenom, 90), suite.App.BankKeeper.GetBalance(suite.Ctx, holder2, suite.defaultDenom))
+			suite.AssertEventEmitted(ctx, types.TypeMsgBurn, tc.expectedMessageEvents)
+			suite.AssertEventEmitted(ctx, proto.MessageName(&types.EventBurn{}), tc.expectedMessageEvents)
 		})
 	}
+
+	// the message requires the burn from capability
+	suite.App.TokenFactoryKeeper.SetEnabledCapabilities(suite.Ctx, []string{})
+	suite.OverrideMsgServer(suite.App.TokenFactoryKeeper)
+	_, err = suite.msgServer.MultiBurn(suite.Ctx, types.NewMsgMultiBurn(admin.String(), suite.defaultDenom, inputs))
+	suite.Require().ErrorIs(err, types.ErrCapabilityNotEnabled)
 }
@@ -0,0 +1,294 @@
+package keeper
+
+import (
+	"bytes"
+	"time"
+
+	"github.com/cosmos/tokenfactory/x/tokenfactory/types"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (k Keeper) getRedemptionStore(ctx sdk.Context, denom string) storetypes.KVStore {
+	return prefix.NewStore(k.GetDenomPrefixStore(ctx, denom), types.GetRedemptionPrefix())
+}
+
+func (k Keeper) getOpenRedemptionStore(ctx sdk.Context, denom string) storetypes.KVStore {
+	return prefix.NewStore(k.GetDenomPrefixStore(ctx, denom), types.GetOpenRedemptionPrefix())
+}
+
+// GetRedemption returns a redemption request of a denom, whatever its status
+func (k Keeper) GetRedemption(ctx sdk.Context, denom string, id uint64) (types.RedemptionRequest, bool) {
+	bz := k.getRedemptionStore(ctx, denom).Get(sdk.Uint64ToBigEndian(id))
+	if bz == nil {
+		return types.RedemptionRequest{}, false
+	}
+
+	redemption := types.RedemptionRequest{}
+	k.cdc.MustUnmarshal(bz, &redemption)
+	return redemption, true
+}
+
+// setRedemption stores a redemption request of a denom, and indexes it as open and by timeout
+// while it is open
+func (k Keeper) setRedemption(ctx sdk.Context, redemption types.RedemptionRequest) {
+	denom := redemption.Amount.Denom
+	id := sdk.Uint64ToBigEndian(redemption.Id)
+	k.getRedemptionStore(ctx, denom).Set(id, k.cdc.MustMarshal(&redemption))
+
+	var timeoutKey []byte
+	if redemption.Timeout != nil {
+		timeoutKey = types.GetRedemptionTimeoutKey(*redemption.Timeout, denom, redemption.Id)
+	}
+
+	if redemption.IsOpen() {
+		k.getOpenRedemptionStore(ctx, denom).Set(id, []byte{})
+		if timeoutKey != nil {
+			ctx.KVStore(k.storeKey).Set(timeoutKey, []byte{})
+		}
+		return
+	}
+
+	k.getOpenRedemptionStore(ctx, denom).Delete(id)
+	if timeoutKey != nil {
+		ctx.KVStore(k.storeKey).Delete(timeoutKey)
+	}
+}
+
+// GetAllRedemptions returns the redemption requests of a denom, ordered by id
+func (k Keeper) GetAllRedemptions(ctx sdk.Context, denom string) []types.RedemptionRequest {
+	iterator := k.getRedemptionStore(ctx, denom).Iterator(nil, nil)
+	defer iterator.Close()
+
+	redemptions := []types.RedemptionRequest{}
+	for ; iterator.Valid(); iterator.Next() {
+		redemption := types.RedemptionRequest{}
+		k.cdc.MustUnmarshal(iterator.Value(), &redemption)
+		redemptions = append(redemptions, redemption)
+	}
+	return redemptions
+}
+
+// GetOpenRedemptions returns the open redemption requests of a denom, ordered by id
+func (k Keeper) GetOpenRedemptions(ctx sdk.Context, denom string) []types.RedemptionRequest {
+	iterator := k.getOpenRedemptionStore(ctx, denom).Iterator(nil, nil)
+	defer iterator.Close()
+
+	redemptions := []types.RedemptionRequest{}
+	for ; iterator.Valid(); iterator.Next() {
+		redemption, found := k.GetRedemption(ctx, denom, sdk.BigEndianToUint64(iterator.Key()))
+		if found {
+			redemptions = append(redemptions, redemption)
+		}
+	}
+	return redemptions
+}
+
+// RequestRedemption escrows the amount of the holder in the module account in a new open
+// redemption request, which times out after the redemption timeout param if it is set. Vault
+// denoms and bridged CW20 denoms are redeemed by withdrawing their backing instead.
+func (k Keeper) RequestRedemption(ctx sdk.Context, holder string, amount sdk.Coin, payoutReference string) (types.RedemptionRequest, error) {
+	authorityMetadata, err := k.GetAuthorityMetadata(ctx, amount.Denom)
+	if err != nil {
+		return types.RedemptionRequest{}, err
+	}
+	if authorityMetadata.GetAdmin() == "" {
+		return types.RedemptionRequest{}, errorsmod.Wrapf(types.ErrUnauthorized, "denom %s has no admin to fulfill redemptions", amount.Denom)
+	}
+	if _, found := k.GetVault(ctx, amount.Denom); found {
+		return types.RedemptionRequest{}, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "denom %s is redeemed by withdrawing from its vault", amount.Denom)
+	}
+	if _, found := k.GetBasket(ctx, amount.Denom); found {
+		return types.RedemptionRequest{}, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "denom %s is redeemed from its basket", amount.Denom)
+	}
+	if _, found := k.GetCW20Bridge(ctx, amount.Denom); found {
+		return types.RedemptionRequest{}, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "denom %s is redeemed by withdrawing its cw20 tokens", amount.Denom)
+	}
+
+	holderAddr, err := sdk.AccAddressFromBech32(holder)
+	if err != nil {
+		return types.RedemptionRequest{}, err
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, holderAddr, types.ModuleName, sdk.NewCoins(amount)); err != nil {
+		return types.RedemptionRequest{}, err
+	}
+
+	id := uint64(1)
+	iterator := k.getRedemptionStore(ctx, amount.Denom).ReverseIterator(nil, nil)
+	if iterator.Valid() {
+		id = sdk.BigEndianToUint64(iterator.Key()) + 1
+	}
+	iterator.Close()
+
+	redemption := types.RedemptionRequest{
+		Id:              id,
+		Holder:          holder,
+		Amount:          amount,
+		PayoutReference: payoutReference,
+		Created:         ctx.BlockTime(),
+		Timeout:         redemptionTimeout(ctx.BlockTime(), k.GetParams(ctx).RedemptionTimeout),
+		Status:          types.REDEMPTION_STATUS_OPEN,
+	}
+	if err := redemption.Validate(); err != nil {
+		return types.RedemptionRequest{}, err
+	}
+
+	k.setRedemption(ctx, redemption)
+	return redemption, nil
+}
+
+// getOpenRedemption returns an open redemption request of a denom, after checking that the sender
+// is the admin of the denom
+func (k Keeper) getOpenRedemption(ctx sdk.Context, sender, denom string, id uint64) (types.RedemptionRequest, error) {
+	authorityMetadata, err := k.GetAuthorityMetadata(ctx, denom)
+	if err != nil {
+		return types.RedemptionRequest{}, err
+	}
+	if sender != authorityMetadata.GetAdmin() {
+		return types.RedemptionRequest{}, types.ErrUnauthorized
+	}
+
+	redemption, found := k.GetRedemption(ctx, denom, id)
+	if !found {
+		return types.RedemptionRequest{}, errorsmod.Wrapf(types.ErrRedemptionNotFound, "denom %s, id %d", denom, id)
+	}
+	if !redemption.IsOpen() {
+		return types.RedemptionRequest{}, errorsmod.Wrapf(types.ErrRedemptionNotOpen, "denom %s, id %d is %s", denom, id, redemption.Status)
+	}
+	return redemption, nil
+}
+
+// FulfillRedemption burns the escrowed tokens of an open redemption request paid out off-chain,
+// and records the settlement reference of the payout. The sender must be the admin of the denom.
+func (k Keeper) FulfillRedemption(ctx sdk.Context, sender, denom string, id uint64, settlementReference string) (types.RedemptionRequest, error) {
+	redemption, err := k.getOpenRedemption(ctx, sender, denom, id)
+	if err != nil {
+		return types.RedemptionRequest{}, err
+	}
+
+	if err := k.burnFromModule(ctx, redemption.Amount, redemption.Holder); err != nil {
+		return types.RedemptionRequest{}, err
+	}
+
+	redemption.Status = types.REDEMPTION_STATUS_FULFILLED
+	redemption.SettlementReference = settlementReference
+	k.setRedemption(ctx, redemption)
+	return redemption, nil
+}
+
+// RejectRedemption returns the escrowed tokens of an open redemption request to its holder. The
+// sender must be the admin of the denom.
+func (k Keeper) RejectRedemption(ctx sdk.Context, sender, denom string, id uint64, reason string) (types.RedemptionRequest, error) {
+	redemption, err := k.getOpenRedemption(ctx, sender, denom, id)
+	if err != nil {
+		return types.RedemptionRequest{}, err
+	}
+
+	redemption.RejectReason = reason
+	if err := k.refundRedemption(ctx, &redemption, types.REDEMPTION_STATUS_REJECTED); err != nil {
+		return types.RedemptionRequest{}, err
+	}
+	return redemption, nil
+}
+
+// refundRedemption returns the escrowed tokens of an open redemption request to its holder, and
+// closes it with the status.
+func (k Keeper) refundRedemption(ctx sdk.Context, redemption *types.RedemptionRequest, status types.RedemptionStatus) error {
+	holderAddr, err := sdk.AccAddressFromBech32(redemption.Holder)
+	if err != nil {
+		return err
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, holderAddr, sdk.NewCoins(redemption.Amount)); err != nil {
+		return err
+	}
+
+	redemption.Status = status
+	k.setRedemption(ctx, *redemption)
+	return nil
+}
+
+// RefundTimedOutRedemptions returns the escrowed tokens of the open redemption requests that
+// reached their timeout to their holders, at most MaxRedemptionRefundsPerBlock per block. A
+// refund failing, for instance because a before send hook rejects it, is logged and the request
+// is dropped from the timeout index: it stays open until it is fulfilled or rejected by the
+// admin of the denom, so that it does not cost every following end blocker a failing refund.
+func (k Keeper) RefundTimedOutRedemptions(ctx sdk.Context) {
+	// the index keys are ordered by timeout, every key up to the block time has timed out
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.RedemptionTimeoutPrefix+types.KeySeparator))
+	iterator := indexStore.Iterator(nil, storetypes.PrefixEndBytes(sdk.FormatTimeBytes(ctx.BlockTime())))
+	defer iterator.Close()
+
+	var timedOut [][]byte
+	for ; iterator.Valid() && len(timedOut) < types.MaxRedemptionRefundsPerBlock; iterator.Next() {
+		timedOut = append(timedOut, iterator.Key())
+	}
+
+	for _, key := range timedOut {
+		// key is {timeBytes}|{denom}|{bigEndian(id)}, neither the time nor the denom contain the
+		// separator
+		i := bytes.Index(key, []byte(types.KeySeparator))
+		if i < 0 || len(key) < i+len(types.KeySeparator)+8+len(types.KeySeparator) {
+			indexStore.Delete(key)
+			continue
+		}
+		denom := string(key[i+len(types.KeySeparator) : len(key)-8-len(types.KeySeparator)])
+		id := sdk.BigEndianToUint64(key[len(key)-8:])
+
+		redemption, found := k.GetRedemption(ctx, denom, id)
+		if !found || !redemption.IsOpen() {
+			indexStore.Delete(key)
+			continue
+		}
+
+		// closing the request deletes its timeout key, which is only written with the refund
+		cacheCtx, write := ctx.CacheContext()
+		if err := k.refundRedemption(cacheCtx, &redemption, types.REDEMPTION_STATUS_TIMED_OUT); err != nil {
+			k.Logger(ctx).Error("failed to refund timed out redemption, left open to the denom admin", "denom", denom, "id", id, "error", err)
+			indexStore.Delete(key)
+			continue
+		}
+		write()
+
+		if err := ctx.EventManager().EmitTypedEvent(&types.EventRefundRedemption{
+			Denom:  denom,
+			Id:     id,
+			Holder: redemption.Holder,
+			Amount: redemption.Amount,
+			Status: types.REDEMPTION_STATUS_TIMED_OUT,
+		}); err != nil {
+			k.Logger(ctx).Error("failed to emit redemption refund event", "error", err)
+		}
+	}
+}
+
+// GetEscrowedRedemptions returns the sum of the amounts escrowed by the open redemption requests
+// of all the denoms
+func (k Keeper) GetEscrowedRedemptions(ctx sdk.Context) sdk.Coins {
+	iterator := k.GetAllDenomsIterator(ctx)
+	defer iterator.Close()
+
+	escrowed := sdk.NewCoins()
+	for ; iterator.Valid(); iterator.Next() {
+		for _, redemption := range k.GetOpenRedemptions(ctx, string(iterator.Value())) {
+			escrowed = escrowed.Add(redemption.Amount)
+		}
+	}
+	return escrowed
+}
+
+// redemptionTimeout returns the timeout of a redemption request created at the block time, if
+// redemption requests time out
+func redemptionTimeout(blockTime time.Time, timeout time.Duration) *time.Time {
+	if timeout <= 0 {
+		return nil
+	}
+	timeoutTime := blockTime.Add(timeout)
+	return &timeoutTime
+}
@@ -0,0 +1,236 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/cosmos/tokenfactory/x/tokenfactory/types"
+
+	"github.com/cosmos/gogoproto/proto"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+func (suite *KeeperTestSuite) TestRedemptionMsgs() {
+	suite.CreateDefaultDenom()
+	admin, holder := suite.TestAccs[0], suite.TestAccs[1]
+
+	_, err := suite.msgServer.Mint(suite.Ctx, types.NewMsgMintTo(admin.String(), sdk.NewInt64Coin(suite.defaultDenom, 1000), holder.String()))
+	suite.Require().NoError(err)
+
+	request := func(ctx sdk.Context, amount int64) (*types.MsgRequestRedemptionResponse, error) {
+		return suite.msgServer.RequestRedemption(ctx, types.NewMsgRequestRedemption(holder.String(), sdk.NewInt64Coin(suite.defaultDenom, amount), "IBAN:DE89370400440532013000"))
+	}
+
+	// the holder can not escrow more than its balance
+	cacheCtx, _ := suite.Ctx.CacheContext()
+	_, err = request(cacheCtx, 1001)
+	suite.Require().Error(err)
+
+	ctx := suite.Ctx.WithEventManager(sdk.NewEventManager())
+	res, err := request(ctx, 300)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(1), res.Id)
+	suite.AssertEventEmitted(ctx, proto.MessageName(&types.EventRequestRedemption{}), 1)
+
+	res, err = request(suite.Ctx, 200)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(2), res.Id)
+
+	// the tokens are escrowed in the module account
+	moduleAddr := suite.App.AccountKeeper.GetModuleAddress(types.ModuleName)
+	suite.Require().Equal(sdkmath.NewInt(500), suite.App.BankKeeper.GetBalance(suite.Ctx, moduleAddr, suite.defaultDenom).Amount)
+	suite.Require().Equal(sdkmath.NewInt(500), suite.App.BankKeeper.GetBalance(suite.Ctx, holder, suite.defaultDenom).Amount)
+
+	for _, tc := range []struct {
+		desc   string
+		sender sdk.AccAddress
+		id     uint64
+		expErr error
+	}{
+		{desc: "not the admin", sender: holder, id: 1, expErr: types.ErrUnauthorized},
+		{desc: "unknown request", sender: admin, id: 3, expErr: types.ErrRedemptionNotFound},
+	} {
+		suite.Run(tc.desc, func() {
+			ctx, _ := suite.Ctx.CacheContext()
+			_, err := suite.msgServer.FulfillRedemption(ctx, types.NewMsgFulfillRedemption(tc.sender.String(), suite.defaultDenom, tc.id, "wire-1"))
+			suite.Require().ErrorIs(err, tc.expErr)
+			_, err = suite.msgServer.RejectRedemption(ctx, types.NewMsgRejectRedemption(tc.sender.String(), suite.defaultDenom, tc.id, ""))
+			suite.Require().ErrorIs(err, tc.expErr)
+		})
+	}
+
+	// fulfilling burns the escrowed tokens
+	ctx = suite.Ctx.WithEventManager(sdk.NewEventManager())
+	_, err = suite.msgServer.FulfillRedemption(ctx, types.NewMsgFulfillRedemption(admin.String(), suite.defaultDenom, 1, "wire-1"))
+	suite.Require().NoError(err)
+	suite.AssertEventEmitted(ctx, proto.MessageName(&types.EventBurn{}), 1)
+	suite.AssertEventEmitted(ctx, proto.MessageName(&types.EventFulfillRedemption{}), 1)
+	suite.Require().Equal(sdkmath.NewInt(700), suite.App.BankKeeper.GetSupply(suite.Ctx, suite.defaultDenom).Amount)
+
+	redemption, found := suite.App.TokenFactoryKeeper.GetRedemption(suite.Ctx, suite.defaultDenom, 1)
+	suite.Require().True(found)
+	suite.Require().Equal(types.REDEMPTION_STATUS_FULFILLED, redemption.Status)
+	suite.Require().Equal("wire-1", redemption.SettlementReference)
+
+	// a closed request can not be fulfilled or rejected again
+	cacheCtx, _ = suite.Ctx.CacheContext()
+	_, err = suite.msgServer.FulfillRedemption(cacheCtx, types.NewMsgFulfillRedemption(admin.String(), suite.defaultDenom, 1, "wire-2"))
+	suite.Require().ErrorIs(err, types.ErrRedemptionNotOpen)
+	_, err = suite.msgServer.RejectRedemption(cacheCtx, types.NewMsgRejectRedemption(admin.String(), suite.defaultDenom, 1, ""))
+	suite.Require().ErrorIs(err, types.ErrRedemptionNotOpen)
+
+	// rejecting returns the escrowed tokens
+	ctx = suite.Ctx.WithEventManager(sdk.NewEventManager())
+	_, err = suite.msgServer.RejectRedemption(ctx, types.NewMsgRejectRedemption(admin.String(), suite.defaultDenom, 2, "unknown payout account"))
+	suite.Require().NoError(err)
+	suite.AssertEventEmitted(ctx, proto.MessageName(&types.EventRefundRedemption{}), 1)
+	suite.Require().Equal(sdkmath.NewInt(700), suite.App.BankKeeper.GetBalance(suite.Ctx, holder, suite.defaultDenom).Amount)
+	suite.Require().True(suite.App.BankKeeper.GetBalance(suite.Ctx, moduleAddr, suite.defaultDenom).IsZero())
+
+	queryRes, err := suite.queryClient.Redemption(suite.Ctx.Context(), &types.QueryRedemptionRequest{Denom: suite.defaultDenom, Id: 2})
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.REDEMPTION_STATUS_REJECTED, queryRes.Redemption.Status)
+	suite.Require().Equal("unknown payout account", queryRes.Redemption.RejectReason)
+
+	_, err = suite.queryClient.Redemption(suite.Ctx.Context(), &types.QueryRedemptionRequest{Denom: suite.defaultDenom, Id: 3})
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperTestSuite) TestRequestRedemptionWithoutAdmin() {
+	suite.CreateDefaultDenom()
+	admin := suite.TestAccs[0]
+
+	_, err := suite.msgServer.Mint(suite.Ctx, types.NewMsgMint(admin.String(), sdk.NewInt64Coin(suite.defaultDenom, 100)))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.ChangeAdmin(suite.Ctx, types.NewMsgChangeAdmin(admin.String(), suite.defaultDenom, ""))
+	suite.Require().NoError(err)
+
+	// nobody could fulfill the request of a denom without admin
+	_, err = suite.msgServer.RequestRedemption(suite.Ctx, types.NewMsgRequestRedemption(admin.String(), sdk.NewInt64Coin(suite.defaultDenom, 100), "iban"))
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+}
+
+func (suite *KeeperTestSuite) TestOpenRedemptionsQuery() {
+	suite.CreateDefaultDenom()
+	admin := suite.TestAccs[0]
+
+	_, err := suite.msgServer.Mint(suite.Ctx, types.NewMsgMint(admin.String(), sdk.NewInt64Coin(suite.defaultDenom, 100)))
+	suite.Require().NoError(err)
+	for i := 0; i < 3; i++ {
+		_, err = suite.msgServer.RequestRedemption(suite.Ctx, types.NewMsgRequestRedemption(admin.String(), sdk.NewInt64Coin(suite.defaultDenom, 10), "iban"))
+		suite.Require().NoError(err)
+	}
+	_, err = suite.msgServer.FulfillRedemption(suite.Ctx, types.NewMsgFulfillRedemption(admin.String(), suite.defaultDenom, 2, "wire-2"))
+	suite.Require().NoError(err)
+
+	res, err := suite.queryClient.OpenRedemptions(suite.Ctx.Context(), &types.QueryOpenRedemptionsRequest{
+		Denom:      suite.defaultDenom,
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Redemptions, 1)
+	suite.Require().Equal(uint64(1), res.Redemptions[0].Id)
+	suite.Require().Equal(uint64(2), res.Pagination.Total)
+
+	res, err = suite.queryClient.OpenRedemptions(suite.Ctx.Context(), &types.QueryOpenRedemptionsRequest{
+		Denom:      suite.defaultDenom,
+		Pagination: &query.PageRequest{Key: res.Pagination.NextKey},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Redemptions, 1)
+	suite.Require().Equal(uint64(3), res.Redemptions[0].Id)
+}
+
+func (suite *KeeperTestSuite) TestRefundTimedOutRedemptions() {
+	suite.CreateDefaultDenom()
+	admin := suite.TestAccs[0]
+	blockTime := suite.Ctx.BlockTime()
+
+	params := suite.App.TokenFactoryKeeper.GetParams(suite.Ctx)
+	params.RedemptionTimeout = time.Hour
+	suite.Require().NoError(suite.App.TokenFactoryKeeper.SetParams(suite.Ctx, params))
+
+	_, err := suite.msgServer.Mint(suite.Ctx, types.NewMsgMint(admin.String(), sdk.NewInt64Coin(suite.defaultDenom, 100)))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.RequestRedemption(suite.Ctx, types.NewMsgRequestRedemption(admin.String(), sdk.NewInt64Coin(suite.defaultDenom, 60), "iban"))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.RequestRedemption(suite.Ctx.WithBlockTime(blockTime.Add(time.Minute)), types.NewMsgRequestRedemption(admin.String(), sdk.NewInt64Coin(suite.defaultDenom, 40), "iban"))
+	suite.Require().NoError(err)
+
+	// nothing has timed out yet
+	suite.App.TokenFactoryKeeper.RefundTimedOutRedemptions(suite.Ctx.WithBlockTime(blockTime.Add(time.Hour - time.Second)))
+	suite.Require().Len(suite.App.TokenFactoryKeeper.GetOpenRedemptions(suite.Ctx, suite.defaultDenom), 2)
+
+	// the first request times out exactly at its timeout
+	ctx := suite.Ctx.WithBlockTime(blockTime.Add(time.Hour)).WithEventManager(sdk.NewEventManager())
+	suite.App.TokenFactoryKeeper.RefundTimedOutRedemptions(ctx)
+	suite.AssertEventEmitted(ctx, proto.MessageName(&types.EventRefundRedemption{}), 1)
+	suite.Require().Equal(sdkmath.NewInt(60), suite.App.BankKeeper.GetBalance(suite.Ctx, admin, suite.defaultDenom).Amount)
+
+	redemption, found := suite.App.TokenFactoryKeeper.GetRedemption(suite.Ctx, suite.defaultDenom, 1)
+	suite.Require().True(found)
+	suite.Require().Equal(types.REDEMPTION_STATUS_TIMED_OUT, redemption.Status)
+
+	openRedemptions := suite.App.TokenFactoryKeeper.GetOpenRedemptions(suite.Ctx, suite.defaultDenom)
+	suite.Require().Len(openRedemptions, 1)
+	suite.Require().Equal(uint64(2), openRedemptions[0].Id)
+
+	// a failing refund is not retried in the next blocks, the request is left open to the admin
+	escrowed := sdk.NewCoins(sdk.NewInt64Coin(suite.defaultDenom, 40))
+	suite.Require().NoError(suite.App.BankKeeper.BurnCoins(suite.Ctx, types.ModuleName, escrowed))
+	ctx = suite.Ctx.WithBlockTime(blockTime.Add(time.Hour + time.Minute)).WithEventManager(sdk.NewEventManager())
+	suite.App.TokenFactoryKeeper.RefundTimedOutRedemptions(ctx)
+	suite.AssertEventEmitted(ctx, proto.MessageName(&types.EventRefundRedemption{}), 0)
+	suite.Require().Len(suite.App.TokenFactoryKeeper.GetOpenRedemptions(suite.Ctx, suite.defaultDenom), 1)
+
+	suite.Require().NoError(suite.App.BankKeeper.MintCoins(suite.Ctx, types.ModuleName, escrowed))
+	ctx = suite.Ctx.WithBlockTime(blockTime.Add(time.Hour + 2*time.Minute)).WithEventManager(sdk.NewEventManager())
+	suite.App.TokenFactoryKeeper.RefundTimedOutRedemptions(ctx)
+	suite.AssertEventEmitted(ctx, proto.MessageName(&types.EventRefundRedemption{}), 0)
+	suite.Require().Len(suite.App.TokenFactoryKeeper.GetOpenRedemptions(suite.Ctx, suite.defaultDenom), 1)
+
+	// a request closed before its timeout is not refunded, and the admin can still close the
+	// request dropped from the timeout index
+	_, err = suite.msgServer.RequestRedemption(suite.Ctx, types.NewMsgRequestRedemption(admin.String(), sdk.NewInt64Coin(suite.defaultDenom, 10), "iban"))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.FulfillRedemption(suite.Ctx, types.NewMsgFulfillRedemption(admin.String(), suite.defaultDenom, 3, "wire-3"))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.FulfillRedemption(suite.Ctx, types.NewMsgFulfillRedemption(admin.String(), suite.defaultDenom, 2, "wire-2"))
+	suite.Require().NoError(err)
+	ctx = suite.Ctx.WithBlockTime(blockTime.Add(2 * time.Hour)).WithEventManager(sdk.NewEventManager())
+	suite.App.TokenFactoryKeeper.RefundTimedOutRedemptions(ctx)
+	suite.AssertEventEmitted(ctx, proto.MessageName(&types.EventRefundRedemption{}), 0)
+	suite.Require().Equal(sdkmath.NewInt(50), suite.App.BankKeeper.GetSupply(suite.Ctx, suite.defaultDenom).Amount)
+}
+
+func (suite *KeeperTestSuite) TestRefundTimedOutRedemptionsPerBlock() {
+	suite.CreateDefaultDenom()
+	admin := suite.TestAccs[0].String()
+	blockTime := suite.Ctx.BlockTime()
+
+	params := suite.App.TokenFactoryKeeper.GetParams(suite.Ctx)
+	params.RedemptionTimeout = time.Hour
+	suite.Require().NoError(suite.App.TokenFactoryKeeper.SetParams(suite.Ctx, params))
+
+	requests := types.MaxRedemptionRefundsPerBlock + 1
+	_, err := suite.msgServer.Mint(suite.Ctx, types.NewMsgMint(admin, sdk.NewInt64Coin(suite.defaultDenom, int64(requests))))
+	suite.Require().NoError(err)
+	for i := 0; i < requests; i++ {
+		_, err = suite.msgServer.RequestRedemption(suite.Ctx, types.NewMsgRequestRedemption(admin, sdk.NewInt64Coin(suite.defaultDenom, 1), "iban"))
+		suite.Require().NoError(err)
+	}
+
+	// the requests timed out in the same block are refunded over several blocks
+	ctx := suite.Ctx.WithBlockTime(blockTime.Add(time.Hour)).WithEventManager(sdk.NewEventManager())
+	suite.App.TokenFactoryKeeper.RefundTimedOutRedemptions(ctx)
+	suite.AssertEventEmitted(ctx, proto.MessageName(&types.EventRefundRedemption{}), types.MaxRedemptionRefundsPerBlock)
+	suite.Require().Len(suite.App.TokenFactoryKeeper.GetOpenRedemptions(suite.Ctx, suite.defaultDenom), 1)
+
+	ctx = suite.Ctx.WithBlockTime(blockTime.Add(time.Hour + time.Second)).WithEventManager(sdk.NewEventManager())
+	suite.App.TokenFactoryKeeper.RefundTimedOutRedemptions(ctx)
+	suite.AssertEventEmitted(ctx, proto.MessageName(&types.EventRefundRedemption{}), 1)
+	suite.Require().Empty(suite.App.TokenFactoryKeeper.GetOpenRedemptions(suite.Ctx, suite.defaultDenom))
+}
@@ -0,0 +1,99 @@
+package keeper
+
+import (
+	"bytes"
+
+	"github.com/cosmos/tokenfactory/x/tokenfactory/types"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k Keeper) getReferenceIDStore(ctx sdk.Context, denom string) storetypes.KVStore {
+	return prefix.NewStore(k.GetDenomPrefixStore(ctx, denom), types.GetReferenceIDPrefix())
+}
+
+// GetReferenceIDHeight returns the height of the block where a reference id of a denom was used
+// by a MsgMint or a MsgBurn, if it has been used and not pruned yet
+func (k Keeper) GetReferenceIDHeight(ctx sdk.Context, denom, referenceID string) (int64, bool) {
+	bz := k.getReferenceIDStore(ctx, denom).Get([]byte(referenceID))
+	if bz == nil {
+		return 0, false
+	}
+	return int64(sdk.BigEndianToUint64(bz)), true
+}
+
+// checkReferenceID returns ErrDuplicateReferenceID if the reference id has already been used for
+// the denom. An empty reference id is never a duplicate.
+func (k Keeper) checkReferenceID(ctx sdk.Context, denom, referenceID string) error {
+	if referenceID == "" {
+		return nil
+	}
+
+	if height, found := k.GetReferenceIDHeight(ctx, denom, referenceID); found {
+		return errorsmod.Wrapf(types.ErrDuplicateReferenceID, "denom %s, reference id %s used at height %d", denom, referenceID, height)
+	}
+	return nil
+}
+
+// setReferenceID records a reference id of a denom as used at a height, and indexes it by height
+// for pruning. An empty reference id is not recorded.
+func (k Keeper) setReferenceID(ctx sdk.Context, denom, referenceID string, height int64) {
+	if referenceID == "" {
+		return
+	}
+
+	k.getReferenceIDStore(ctx, denom).Set([]byte(referenceID), sdk.Uint64ToBigEndian(uint64(height)))
+	ctx.KVStore(k.storeKey).Set(types.GetReferenceIDHeightKey(height, denom, referenceID), []byte{})
+}
+
+// GetAllReferenceIDs returns the reference ids used for a denom, ordered by reference id
+func (k Keeper) GetAllReferenceIDs(ctx sdk.Context, denom string) []types.ReferenceIDRecord {
+	iterator := k.getReferenceIDStore(ctx, denom).Iterator(nil, nil)
+	defer iterator.Close()
+
+	records := []types.ReferenceIDRecord{}
+	for ; iterator.Valid(); iterator.Next() {
+		records = append(records, types.ReferenceIDRecord{
+			ReferenceId: string(iterator.Key()),
+			Height:      int64(sdk.BigEndianToUint64(iterator.Value())),
+		})
+	}
+	return records
+}
+
+// PruneReferenceIDs deletes the reference ids used reference_id_retention_blocks blocks or more
+// before the current block, so that they can be used again. Nothing is pruned when the retention
+// is zero.
+func (k Keeper) PruneReferenceIDs(ctx sdk.Context) {
+	retention := k.GetParams(ctx).ReferenceIdRetentionBlocks
+	if retention == 0 || ctx.BlockHeight() < 0 || uint64(ctx.BlockHeight()) < retention {
+		return
+	}
+
+	// the index keys are ordered by height, every key before the first height to keep is pruned
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.ReferenceIDHeightPrefix+types.KeySeparator))
+	end := sdk.Uint64ToBigEndian(uint64(ctx.BlockHeight()) - retention + 1)
+	iterator := indexStore.Iterator(nil, end)
+	defer iterator.Close()
+
+	var pruned [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		pruned = append(pruned, iterator.Key())
+	}
+
+	for _, key := range pruned {
+		indexStore.Delete(key)
+
+		// key is {bigEndian(height)}|{denom}|{reference id}, denoms do not contain the separator
+		denomAndID := key[8+len(types.KeySeparator):]
+		i := bytes.Index(denomAndID, []byte(types.KeySeparator))
+		if i < 0 {
+			continue
+		}
+		k.getReferenceIDStore(ctx, string(denomAndID[:i])).Delete(denomAndID[i+len(types.KeySeparator):])
+	}
+}
@@ -0,0 +1,112 @@
+package keeper_test
+
+import (
+	"github.com/cosmos/tokenfactory/x/tokenfactory/types"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (suite *KeeperTestSuite) TestReferenceIDs() {
+	suite.CreateDefaultDenom()
+	admin := suite.TestAccs[0]
+	amount := sdk.NewInt64Coin(suite.defaultDenom, 100)
+
+	mintMsg := types.NewMsgMint(admin.String(), amount)
+	mintMsg.ReferenceId = "wire-1"
+
+	ctx := suite.Ctx.WithBlockHeight(10).WithEventManager(sdk.NewEventManager())
+	_, err := suite.msgServer.Mint(ctx, mintMsg)
+	suite.Require().NoError(err)
+
+	// the reference id is returned in the event
+	var found bool
+	for _, event := range ctx.EventManager().Events() {
+		msg, err := sdk.ParseTypedEvent(abci.Event(event))
+		if err != nil {
+			continue
+		}
+		if mintEvent, ok := msg.(*types.EventMint); ok {
+			suite.Require().Equal("wire-1", mintEvent.ReferenceId)
+			found = true
+		}
+	}
+	suite.Require().True(found)
+
+	queryRes, err := suite.queryClient.ReferenceID(ctx.Context(), &types.QueryReferenceIDRequest{Denom: suite.defaultDenom, ReferenceId: "wire-1"})
+	suite.Require().NoError(err)
+	suite.Require().Equal(int64(10), queryRes.Height)
+
+	_, err = suite.queryClient.ReferenceID(ctx.Context(), &types.QueryReferenceIDRequest{Denom: suite.defaultDenom, ReferenceId: "wire-2"})
+	suite.Require().ErrorContains(err, types.ErrReferenceIDNotFound.Error())
+
+	// the reference id is rejected by any later mint or burn of the denom
+	cacheCtx, _ := ctx.CacheContext()
+	_, err = suite.msgServer.Mint(cacheCtx, mintMsg)
+	suite.Require().ErrorIs(err, types.ErrDuplicateReferenceID)
+
+	burnMsg := types.NewMsgBurn(admin.String(), amount)
+	burnMsg.ReferenceId = "wire-1"
+	cacheCtx, _ = ctx.CacheContext()
+	_, err = suite.msgServer.Burn(cacheCtx, burnMsg)
+	suite.Require().ErrorIs(err, types.ErrDuplicateReferenceID)
+
+	// mints and burns without reference id are never duplicates
+	_, err = suite.msgServer.Mint(ctx, types.NewMsgMint(admin.String(), amount))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.Mint(ctx, types.NewMsgMint(admin.String(), amount))
+	suite.Require().NoError(err)
+
+	burnMsg.ReferenceId = "wire-2"
+	_, err = suite.msgServer.Burn(ctx.WithBlockHeight(12), burnMsg)
+	suite.Require().NoError(err)
+
+	suite.Require().Equal([]types.ReferenceIDRecord{
+		{ReferenceId: "wire-1", Height: 10},
+		{ReferenceId: "wire-2", Height: 12},
+	}, suite.App.TokenFactoryKeeper.GetAllReferenceIDs(ctx, suite.defaultDenom))
+}
+
+func (suite *KeeperTestSuite) TestPruneReferenceIDs() {
+	suite.CreateDefaultDenom()
+	admin := suite.TestAccs[0]
+	keeper := suite.App.TokenFactoryKeeper
+
+	mint := func(ctx sdk.Context, referenceID string) error {
+		msg := types.NewMsgMint(admin.String(), sdk.NewInt64Coin(suite.defaultDenom, 100))
+		msg.ReferenceId = referenceID
+		_, err := suite.msgServer.Mint(ctx, msg)
+		return err
+	}
+
+	suite.Require().NoError(mint(suite.Ctx.WithBlockHeight(10), "wire-1"))
+	suite.Require().NoError(mint(suite.Ctx.WithBlockHeight(11), "wire-2"))
+
+	// nothing is pruned with a zero retention
+	keeper.PruneReferenceIDs(suite.Ctx.WithBlockHeight(1000))
+	suite.Require().Len(keeper.GetAllReferenceIDs(suite.Ctx, suite.defaultDenom), 2)
+
+	params := keeper.GetParams(suite.Ctx)
+	params.ReferenceIdRetentionBlocks = 5
+	suite.Require().NoError(keeper.SetParams(suite.Ctx, params))
+
+	// reference ids are kept for retention blocks
+	keeper.PruneReferenceIDs(suite.Ctx.WithBlockHeight(14))
+	suite.Require().Len(keeper.GetAllReferenceIDs(suite.Ctx, suite.defaultDenom), 2)
+
+	ctx := suite.Ctx.WithBlockHeight(15)
+	keeper.PruneReferenceIDs(ctx)
+	suite.Require().Equal([]types.ReferenceIDRecord{
+		{ReferenceId: "wire-2", Height: 11},
+	}, keeper.GetAllReferenceIDs(ctx, suite.defaultDenom))
+
+	// a pruned reference id can be used again
+	suite.Require().NoError(mint(ctx, "wire-1"))
+	suite.Require().ErrorIs(mint(ctx, "wire-2"), types.ErrDuplicateReferenceID)
+
+	keeper.PruneReferenceIDs(suite.Ctx.WithBlockHeight(19))
+	suite.Require().Equal([]types.ReferenceIDRecord{
+		{ReferenceId: "wire-1", Height: 15},
+	}, keeper.GetAllReferenceIDs(ctx, suite.defaultDenom))
+}
@@ -0,0 +1,145 @@
+package keeper
+
+import (
+	"time"
+
+	"github.com/cosmos/tokenfactory/x/tokenfactory/types"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetReserveAttestor returns the reserve attestor of a denom, if the denom has a reserve ceiling
+func (k Keeper) GetReserveAttestor(ctx sdk.Context, denom string) (types.ReserveAttestor, bool) {
+	bz := k.GetDenomPrefixStore(ctx, denom).Get([]byte(types.ReserveAttestorKey))
+	if bz == nil {
+		return types.ReserveAttestor{}, false
+	}
+
+	attestor := types.ReserveAttestor{}
+	k.cdc.MustUnmarshal(bz, &attestor)
+	return attestor, true
+}
+
+// setReserveAttestor stores the reserve attestor of a denom, an empty attestor removes it
+func (k Keeper) setReserveAttestor(ctx sdk.Context, denom string, attestor types.ReserveAttestor) error {
+	if err := types.ValidateReserveAttestor(attestor.Attestor, attestor.MaxStaleness); err != nil {
+		return err
+	}
+
+	store := k.GetDenomPrefixStore(ctx, denom)
+	if attestor.Attestor == "" {
+		store.Delete([]byte(types.ReserveAttestorKey))
+		return nil
+	}
+
+	store.Set([]byte(types.ReserveAttestorKey), k.cdc.MustMarshal(&attestor))
+	return nil
+}
+
+func (k Keeper) getReserveAttestationStore(ctx sdk.Context, denom string) storetypes.KVStore {
+	return prefix.NewStore(k.GetDenomPrefixStore(ctx, denom), types.GetReserveAttestationPrefix())
+}
+
+func (k Keeper) setReserveAttestation(ctx sdk.Context, denom string, attestation types.ReserveAttestation) {
+	k.getReserveAttestationStore(ctx, denom).Set(sdk.Uint64ToBigEndian(attestation.Id), k.cdc.MustMarshal(&attestation))
+}
+
+// GetLatestReserveAttestation returns the reserve attestation of a denom with the highest id, if
+// any reserve has been attested
+func (k Keeper) GetLatestReserveAttestation(ctx sdk.Context, denom string) (types.ReserveAttestation, bool) {
+	iterator := k.getReserveAttestationStore(ctx, denom).ReverseIterator(nil, nil)
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return types.ReserveAttestation{}, false
+	}
+
+	attestation := types.ReserveAttestation{}
+	k.cdc.MustUnmarshal(iterator.Value(), &attestation)
+	return attestation, true
+}
+
+// GetAllReserveAttestations returns the reserve attestations of a denom, ordered by id
+func (k Keeper) GetAllReserveAttestations(ctx sdk.Context, denom string) []types.ReserveAttestation {
+	iterator := k.getReserveAttestationStore(ctx, denom).Iterator(nil, nil)
+	defer iterator.Close()
+
+	attestations := []types.ReserveAttestation{}
+	for ; iterator.Valid(); iterator.Next() {
+		attestation := types.ReserveAttestation{}
+		k.cdc.MustUnmarshal(iterator.Value(), &attestation)
+		attestations = append(attestations, attestation)
+	}
+	return attestations
+}
+
+// AttestReserve records a reserve attestation of a denom posted by its reserve attestor. The
+// timestamp of the report can not be after the block time, nor before the timestamp of the
+// latest attestation.
+func (k Keeper) AttestReserve(ctx sdk.Context, sender, denom string, amount sdkmath.Int, timestamp time.Time, reportHash []byte) (types.ReserveAttestation, error) {
+	attestor, found := k.GetReserveAttestor(ctx, denom)
+	if !found {
+		return types.ReserveAttestation{}, errorsmod.Wrapf(types.ErrUnauthorized, "denom %s has no reserve attestor", denom)
+	}
+	if sender != attestor.Attestor {
+		return types.ReserveAttestation{}, errorsmod.Wrapf(types.ErrUnauthorized, "sender %s is not the reserve attestor of denom %s", sender, denom)
+	}
+
+	if timestamp.After(ctx.BlockTime()) {
+		return types.ReserveAttestation{}, errorsmod.Wrapf(types.ErrInvalidAttestation, "timestamp %s is after the block time %s", timestamp, ctx.BlockTime())
+	}
+
+	id := uint64(1)
+	if latest, found := k.GetLatestReserveAttestation(ctx, denom); found {
+		if timestamp.Before(latest.Timestamp) {
+			return types.ReserveAttestation{}, errorsmod.Wrapf(types.ErrInvalidAttestation, "timestamp %s is before the latest attestation %s", timestamp, latest.Timestamp)
+		}
+		id = latest.Id + 1
+	}
+
+	attestation := types.ReserveAttestation{
+		Id:         id,
+		Attestor:   sender,
+		Amount:     amount,
+		Timestamp:  timestamp,
+		ReportHash: reportHash,
+		Height:     ctx.BlockHeight(),
+	}
+	if err := attestation.Validate(); err != nil {
+		return types.ReserveAttestation{}, err
+	}
+
+	k.setReserveAttestation(ctx, denom, attestation)
+	return attestation, nil
+}
+
+// CheckReserveCeiling returns an error if minting the amount would push the supply of a denom
+// with a reserve attestor above its latest attested reserve, or if the latest attestation is
+// missing or older than the max staleness of the attestor. Denoms without reserve attestor have
+// no ceiling.
+func (k Keeper) CheckReserveCeiling(ctx sdk.Context, amount sdk.Coin) error {
+	attestor, found := k.GetReserveAttestor(ctx, amount.Denom)
+	if !found {
+		return nil
+	}
+
+	latest, found := k.GetLatestReserveAttestation(ctx, amount.Denom)
+	if !found {
+		return errorsmod.Wrapf(types.ErrStaleReserveAttestation, "denom %s has no reserve attestation", amount.Denom)
+	}
+	if latest.IsStale(ctx.BlockTime(), attestor.MaxStaleness) {
+		return errorsmod.Wrapf(types.ErrStaleReserveAttestation, "latest attestation of denom %s is from %s, max staleness %s", amount.Denom, latest.Timestamp, attestor.MaxStaleness)
+	}
+
+	supply := k.bankKeeper.GetSupply(ctx, amount.Denom).Amount.Add(amount.Amount)
+	if supply.GT(latest.Amount) {
+		return errorsmod.Wrapf(types.ErrReserveCeilingExceeded, "supply after mint %s%s, attested reserve %s%s", supply, amount.Denom, latest.Amount, amount.Denom)
+	}
+
+	return nil
+}
@@ -0,0 +1,168 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/cosmos/tokenfactory/x/tokenfactory/types"
+
+	"github.com/cosmos/gogoproto/proto"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+func (suite *KeeperTestSuite) TestSetReserveAttestorMsg() {
+	suite.CreateDefaultDenom()
+	admin, attestor := suite.TestAccs[0], suite.TestAccs[1]
+
+	_, err := suite.msgServer.SetReserveAttestor(suite.Ctx, types.NewMsgSetReserveAttestor(attestor.String(), suite.defaultDenom, attestor.String(), time.Hour))
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	ctx := suite.Ctx.WithEventManager(sdk.NewEventManager())
+	_, err = suite.msgServer.SetReserveAttestor(ctx, types.NewMsgSetReserveAttestor(admin.String(), suite.defaultDenom, attestor.String(), time.Hour))
+	suite.Require().NoError(err)
+	suite.AssertEventEmitted(ctx, proto.MessageName(&types.EventSetReserveAttestor{}), 1)
+
+	queryRes, err := suite.queryClient.ReserveAttestor(suite.Ctx.Context(), &types.QueryReserveAttestorRequest{Denom: suite.defaultDenom})
+	suite.Require().NoError(err)
+	suite.Require().Equal(&types.ReserveAttestor{Attestor: attestor.String(), MaxStaleness: time.Hour}, queryRes.ReserveAttestor)
+	suite.Require().Nil(queryRes.LatestAttestation)
+
+	// an empty attestor removes the reserve ceiling
+	_, err = suite.msgServer.SetReserveAttestor(suite.Ctx, types.NewMsgSetReserveAttestor(admin.String(), suite.defaultDenom, "", 0))
+	suite.Require().NoError(err)
+	_, found := suite.App.TokenFactoryKeeper.GetReserveAttestor(suite.Ctx, suite.defaultDenom)
+	suite.Require().False(found)
+}
+
+func (suite *KeeperTestSuite) TestAttestReserveMsg() {
+	suite.CreateDefaultDenom()
+	admin, attestor, other := suite.TestAccs[0], suite.TestAccs[1], suite.TestAccs[2]
+	blockTime := suite.Ctx.BlockTime()
+	reportHash := make([]byte, types.ReportHashLength)
+
+	attest := func(ctx sdk.Context, sender sdk.AccAddress, amount int64, timestamp time.Time) (*types.MsgAttestReserveResponse, error) {
+		return suite.msgServer.AttestReserve(ctx, types.NewMsgAttestReserve(sender.String(), suite.defaultDenom, sdkmath.NewInt(amount), timestamp, reportHash))
+	}
+
+	// the denom has no reserve attestor yet
+	_, err := attest(suite.Ctx, attestor, 1000, blockTime)
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	_, err = suite.msgServer.SetReserveAttestor(suite.Ctx, types.NewMsgSetReserveAttestor(admin.String(), suite.defaultDenom, attestor.String(), time.Hour))
+	suite.Require().NoError(err)
+
+	for _, tc := range []struct {
+		desc      string
+		sender    sdk.AccAddress
+		timestamp time.Time
+		expErr    error
+	}{
+		{desc: "not the attestor", sender: other, timestamp: blockTime, expErr: types.ErrUnauthorized},
+		{desc: "admin is not the attestor", sender: admin, timestamp: blockTime, expErr: types.ErrUnauthorized},
+		{desc: "timestamp after the block time", sender: attestor, timestamp: blockTime.Add(time.Second), expErr: types.ErrInvalidAttestation},
+	} {
+		suite.Run(tc.desc, func() {
+			ctx, _ := suite.Ctx.CacheContext()
+			_, err := attest(ctx, tc.sender, 1000, tc.timestamp)
+			suite.Require().ErrorIs(err, tc.expErr)
+		})
+	}
+
+	ctx := suite.Ctx.WithEventManager(sdk.NewEventManager())
+	res, err := attest(ctx, attestor, 1000, blockTime.Add(-time.Minute))
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(1), res.Id)
+	suite.AssertEventEmitted(ctx, proto.MessageName(&types.EventAttestReserve{}), 1)
+
+	// the timestamps of the attestations can not go backwards
+	cacheCtx, _ := suite.Ctx.CacheContext()
+	_, err = attest(cacheCtx, attestor, 2000, blockTime.Add(-time.Hour))
+	suite.Require().ErrorIs(err, types.ErrInvalidAttestation)
+
+	res, err = attest(suite.Ctx, attestor, 2000, blockTime)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(2), res.Id)
+
+	queryRes, err := suite.queryClient.ReserveAttestor(suite.Ctx.Context(), &types.QueryReserveAttestorRequest{Denom: suite.defaultDenom})
+	suite.Require().NoError(err)
+	suite.Require().Equal(&types.ReserveAttestation{
+		Id:         2,
+		Attestor:   attestor.String(),
+		Amount:     sdkmath.NewInt(2000),
+		Timestamp:  blockTime,
+		ReportHash: reportHash,
+		Height:     suite.Ctx.BlockHeight(),
+	}, queryRes.LatestAttestation)
+
+	// the history is kept, and paginated
+	historyRes, err := suite.queryClient.ReserveAttestations(suite.Ctx.Context(), &types.QueryReserveAttestationsRequest{
+		Denom:      suite.defaultDenom,
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(historyRes.Attestations, 1)
+	suite.Require().Equal(uint64(1), historyRes.Attestations[0].Id)
+	suite.Require().Equal(uint64(2), historyRes.Pagination.Total)
+}
+
+func (suite *KeeperTestSuite) TestReserveCeiling() {
+	suite.CreateDefaultDenom()
+	admin, attestor := suite.TestAccs[0], suite.TestAccs[1]
+	blockTime := suite.Ctx.BlockTime()
+
+	mint := func(ctx sdk.Context, amount int64) error {
+		_, err := suite.msgServer.Mint(ctx, types.NewMsgMint(admin.String(), sdk.NewInt64Coin(suite.defaultDenom, amount)))
+		return err
+	}
+
+	// mints are not capped without reserve attestor
+	suite.Require().NoError(mint(suite.Ctx, 100))
+
+	_, err := suite.msgServer.SetReserveAttestor(suite.Ctx, types.NewMsgSetReserveAttestor(admin.String(), suite.defaultDenom, attestor.String(), time.Hour))
+	suite.Require().NoError(err)
+
+	// no reserve has been attested yet
+	cacheCtx, _ := suite.Ctx.CacheContext()
+	suite.Require().ErrorIs(mint(cacheCtx, 1), types.ErrStaleReserveAttestation)
+
+	_, err = suite.msgServer.AttestReserve(suite.Ctx, types.NewMsgAttestReserve(attestor.String(), suite.defaultDenom, sdkmath.NewInt(1000), blockTime, make([]byte, types.ReportHashLength)))
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(mint(suite.Ctx, 500))
+
+	// the supply is 600, up to 400 more can be minted
+	cacheCtx, _ = suite.Ctx.CacheContext()
+	suite.Require().ErrorIs(mint(cacheCtx, 401), types.ErrReserveCeilingExceeded)
+
+	multiMint := func(ctx sdk.Context, amounts ...int64) error {
+		outputs := make([]types.MultiMintOutput, len(amounts))
+		for i, amount := range amounts {
+			outputs[i] = types.MultiMintOutput{Address: suite.TestAccs[i].String(), Amount: sdkmath.NewInt(amount)}
+		}
+		_, err := suite.msgServer.MultiMint(ctx, types.NewMsgMultiMint(admin.String(), suite.defaultDenom, outputs))
+		return err
+	}
+
+	// the ceiling applies to the sum of a multi mint
+	cacheCtx, _ = suite.Ctx.CacheContext()
+	suite.Require().ErrorIs(multiMint(cacheCtx, 200, 201), types.ErrReserveCeilingExceeded)
+	suite.Require().NoError(multiMint(suite.Ctx, 200, 200))
+	suite.Require().Equal(sdkmath.NewInt(1000), suite.App.BankKeeper.GetSupply(suite.Ctx, suite.defaultDenom).Amount)
+
+	// burns are not capped, and make room for new mints
+	_, err = suite.msgServer.Burn(suite.Ctx, types.NewMsgBurn(admin.String(), sdk.NewInt64Coin(suite.defaultDenom, 100)))
+	suite.Require().NoError(err)
+	suite.Require().NoError(mint(suite.Ctx.WithBlockTime(blockTime.Add(time.Hour)), 100))
+
+	// the attestation is stale after max staleness
+	cacheCtx, _ = suite.Ctx.WithBlockTime(blockTime.Add(time.Hour + time.Second)).CacheContext()
+	suite.Require().ErrorIs(mint(cacheCtx, 1), types.ErrStaleReserveAttestation)
+
+	// removing the attestor removes the ceiling
+	_, err = suite.msgServer.SetReserveAttestor(suite.Ctx, types.NewMsgSetReserveAttestor(admin.String(), suite.defaultDenom, "", 0))
+	suite.Require().NoError(err)
+	suite.Require().NoError(mint(suite.Ctx.WithBlockTime(blockTime.Add(24*time.Hour)), 1000))
+}
@@ -0,0 +1,166 @@
+package keeper
+
+import (
+	"github.com/cosmos/tokenfactory/x/tokenfactory/types"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (k Keeper) getVaultStore(ctx sdk.Context) storetypes.KVStore {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), types.GetVaultPrefix())
+}
+
+// GetVault returns the vault of a denom, if the denom was created as a vault
+func (k Keeper) GetVault(ctx sdk.Context, denom string) (types.Vault, bool) {
+	bz := k.getVaultStore(ctx).Get([]byte(denom))
+	if bz == nil {
+		return types.Vault{}, false
+	}
+
+	vault := types.Vault{}
+	k.cdc.MustUnmarshal(bz, &vault)
+	return vault, true
+}
+
+func (k Keeper) setVault(ctx sdk.Context, vault types.Vault) {
+	k.getVaultStore(ctx).Set([]byte(vault.Denom), k.cdc.MustMarshal(&vault))
+	k.setEscrowAddress(ctx, types.VaultAddress(vault.Denom), vault.Denom)
+}
+
+// GetAllVaults returns all the vaults, ordered by denom
+func (k Keeper) GetAllVaults(ctx sdk.Context) []types.Vault {
+	iterator := k.getVaultStore(ctx).Iterator(nil, nil)
+	defer iterator.Close()
+
+	vaults := []types.Vault{}
+	for ; iterator.Valid(); iterator.Next() {
+		vault := types.Vault{}
+		k.cdc.MustUnmarshal(iterator.Value(), &vault)
+		vaults = append(vaults, vault)
+	}
+	return vaults
+}
+
+// CreateVault creates a factory denom bound to a backing denom, whose admin is the creator. The
+// denom creation fee is charged as for any other denom.
+func (k Keeper) CreateVault(ctx sdk.Context, creatorAddr, subdenom, backingDenom string, ratio sdkmath.Int) (types.Vault, error) {
+	denom, err := k.CreateDenom(ctx, creatorAddr, subdenom)
+	if err != nil {
+		return types.Vault{}, err
+	}
+
+	vault := types.Vault{
+		Denom:        denom,
+		BackingDenom: backingDenom,
+		Ratio:        ratio,
+		Escrowed:     sdkmath.ZeroInt(),
+	}
+	if err := vault.Validate(); err != nil {
+		return types.Vault{}, err
+	}
+
+	k.setVault(ctx, vault)
+	return vault, nil
+}
+
+// VaultDeposit moves an amount of the backing denom of a vault from the depositor to the escrow
+// account of the vault, and mints the amount times the ratio of the vault denom to the depositor.
+// It returns the minted coin.
+func (k Keeper) VaultDeposit(ctx sdk.Context, depositor, denom string, amount sdkmath.Int) (sdk.Coin, error) {
+	vault, found := k.GetVault(ctx, denom)
+	if !found {
+		return sdk.Coin{}, errorsmod.Wrapf(types.ErrVaultNotFound, "denom %s", denom)
+	}
+
+	minted, err := vault.MintedAmount(amount)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	depositorAddr, err := sdk.AccAddressFromBech32(depositor)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	backing := sdk.NewCoins(sdk.NewCoin(vault.BackingDenom, amount))
+	if err := k.bankKeeper.SendCoins(ctx, depositorAddr, types.VaultAddress(denom), backing); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	// the escrow is updated before the mint, which checks the supply against it
+	vault.Escrowed = vault.Escrowed.Add(amount)
+	k.setVault(ctx, vault)
+
+	mintedCoin := sdk.NewCoin(denom, minted)
+	if err := k.mintTo(ctx, mintedCoin, depositor); err != nil {
+		return sdk.Coin{}, err
+	}
+	return mintedCoin, nil
+}
+
+// VaultWithdraw burns the amount times the ratio of a vault denom from the withdrawer, and moves
+// the amount of the backing denom from the escrow account of the vault to the withdrawer. It
+// returns the burned coin.
+func (k Keeper) VaultWithdraw(ctx sdk.Context, withdrawer, denom string, amount sdkmath.Int) (sdk.Coin, error) {
+	vault, found := k.GetVault(ctx, denom)
+	if !found {
+		return sdk.Coin{}, errorsmod.Wrapf(types.ErrVaultNotFound, "denom %s", denom)
+	}
+
+	if amount.GT(vault.Escrowed) {
+		return sdk.Coin{}, errorsmod.Wrapf(sdkerrors.ErrInsufficientFunds, "vault %s escrows %s%s", denom, vault.Escrowed, vault.BackingDenom)
+	}
+
+	burned, err := vault.MintedAmount(amount)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	withdrawerAddr, err := sdk.AccAddressFromBech32(withdrawer)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	// the escrow is updated before the burn, which checks the supply against it
+	vault.Escrowed = vault.Escrowed.Sub(amount)
+	k.setVault(ctx, vault)
+
+	backing := sdk.NewCoins(sdk.NewCoin(vault.BackingDenom, amount))
+	if err := k.bankKeeper.SendCoins(ctx, types.VaultAddress(denom), withdrawerAddr, backing); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	burnedCoin := sdk.NewCoin(denom, burned)
+	if err := k.burnFrom(ctx, burnedCoin, withdrawer); err != nil {
+		return sdk.Coin{}, err
+	}
+	return burnedCoin, nil
+}
+
+// CheckVaultBacking returns an error if the supply of a vault denom is not its escrowed backing
+// amount times its ratio. It is checked after every mint and burn, so that a vault denom can only
+// be minted and burned through its vault. Denoms without vault are not checked.
+func (k Keeper) CheckVaultBacking(ctx sdk.Context, denom string) error {
+	vault, found := k.GetVault(ctx, denom)
+	if !found {
+		return nil
+	}
+
+	expected, err := vault.MintedAmount(vault.Escrowed)
+	if err != nil {
+		return err
+	}
+
+	supply := k.bankKeeper.GetSupply(ctx, denom).Amount
+	if !supply.Equal(expected) {
+		return errorsmod.Wrapf(types.ErrVaultBacking, "supply %s%s, escrowed %s%s times ratio %s", supply, denom, vault.Escrowed, vault.BackingDenom, vault.Ratio)
+	}
+
+	return nil
+}
@@ -0,0 +1,198 @@
+package keeper_test
+
+import (
+	"github.com/cosmos/tokenfactory/x/tokenfactory/keeper"
+	"github.com/cosmos/tokenfactory/x/tokenfactory/types"
+
+	"github.com/cosmos/gogoproto/proto"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+func (suite *KeeperTestSuite) TestVaultMsgs() {
+	admin, holder := suite.TestAccs[0], suite.TestAccs[1]
+	createFee := types.DefaultParams().DenomCreationFee
+	suite.Require().NoError(suite.App.TokenFactoryKeeper.SetParams(suite.Ctx, types.Params{DenomCreationFee: createFee}))
+
+	// create a vault minting 100 tokens for every utwo
+	balanceBefore := suite.App.BankKeeper.GetAllBalances(suite.Ctx, admin)
+	ctx := suite.Ctx.WithEventManager(sdk.NewEventManager())
+	res, err := suite.msgServer.CreateVault(ctx, types.NewMsgCreateVault(admin.String(), "wtwo", "utwo", sdkmath.NewInt(100)))
+	suite.Require().NoError(err)
+	denom := res.GetNewTokenDenom()
+	suite.Require().Equal("factory/"+admin.String()+"/wtwo", denom)
+	suite.AssertEventEmitted(ctx, proto.MessageName(&types.EventCreateDenom{}), 1)
+	suite.AssertEventEmitted(ctx, proto.MessageName(&types.EventCreateVault{}), 1)
+
+	// the denom creation fee is charged
+	suite.Require().Equal(balanceBefore.Sub(createFee...), suite.App.BankKeeper.GetAllBalances(suite.Ctx, admin))
+
+	// depositing mints the amount times the ratio
+	ctx = suite.Ctx.WithEventManager(sdk.NewEventManager())
+	depositRes, err := suite.msgServer.VaultDeposit(ctx, types.NewMsgVaultDeposit(holder.String(), denom, sdkmath.NewInt(30)))
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt64Coin(denom, 3000), depositRes.Minted)
+	suite.AssertEventEmitted(ctx, proto.MessageName(&types.EventMint{}), 1)
+	suite.AssertEventEmitted(ctx, proto.MessageName(&types.EventVaultDeposit{}), 1)
+	suite.Require().Equal(sdkmath.NewInt(3000), suite.App.BankKeeper.GetBalance(suite.Ctx, holder, denom).Amount)
+	suite.Require().Equal(sdkmath.NewInt(30), suite.App.BankKeeper.GetBalance(suite.Ctx, types.VaultAddress(denom), "utwo").Amount)
+	suite.Require().Equal(sdkmath.NewInt(100000000-30), suite.App.BankKeeper.GetBalance(suite.Ctx, holder, "utwo").Amount)
+
+	// the vault denom can not be minted or burned by its admin
+	cacheCtx, _ := suite.Ctx.CacheContext()
+	_, err = suite.msgServer.Mint(cacheCtx, types.NewMsgMint(admin.String(), sdk.NewInt64Coin(denom, 100)))
+	suite.Require().ErrorIs(err, types.ErrVaultBacking)
+	cacheCtx, _ = suite.Ctx.CacheContext()
+	_, err = suite.msgServer.Burn(cacheCtx, types.NewMsgBurnFrom(admin.String(), sdk.NewInt64Coin(denom, 100), holder.String()))
+	suite.Require().ErrorIs(err, types.ErrVaultBacking)
+
+	// nor redeemed off-chain
+	_, err = suite.msgServer.RequestRedemption(cacheCtx, types.NewMsgRequestRedemption(holder.String(), sdk.NewInt64Coin(denom, 100), "iban"))
+	suite.Require().ErrorIs(err, sdkerrors.ErrInvalidRequest)
+
+	for _, tc := range []struct {
+		desc   string
+		denom  string
+		amount int64
+		expErr error
+	}{
+		{desc: "more than the escrow", denom: denom, amount: 31, expErr: sdkerrors.ErrInsufficientFunds},
+		{desc: "not a vault", denom: "factory/" + admin.String() + "/bitcoin", amount: 1, expErr: types.ErrVaultNotFound},
+	} {
+		suite.Run(tc.desc, func() {
+			ctx, _ := suite.Ctx.CacheContext()
+			_, err := suite.msgServer.VaultWithdraw(ctx, types.NewMsgVaultWithdraw(holder.String(), tc.denom, sdkmath.NewInt(tc.amount)))
+			suite.Require().ErrorIs(err, tc.expErr)
+		})
+	}
+
+	// the depositor can not deposit more than its balance
+	cacheCtx, _ = suite.Ctx.CacheContext()
+	_, err = suite.msgServer.VaultDeposit(cacheCtx, types.NewMsgVaultDeposit(admin.String(), denom, sdkmath.NewInt(100000001)))
+	suite.Require().Error(err)
+
+	// the vault tokens are fungible, any holder can withdraw
+	err = suite.App.BankKeeper.SendCoins(suite.Ctx, holder, admin, sdk.NewCoins(sdk.NewInt64Coin(denom, 1000)))
+	suite.Require().NoError(err)
+	ctx = suite.Ctx.WithEventManager(sdk.NewEventManager())
+	withdrawRes, err := suite.msgServer.VaultWithdraw(ctx, types.NewMsgVaultWithdraw(admin.String(), denom, sdkmath.NewInt(10)))
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt64Coin(denom, 1000), withdrawRes.Burned)
+	suite.AssertEventEmitted(ctx, proto.MessageName(&types.EventBurn{}), 1)
+	suite.AssertEventEmitted(ctx, proto.MessageName(&types.EventVaultWithdraw{}), 1)
+	suite.Require().True(suite.App.BankKeeper.GetBalance(suite.Ctx, admin, denom).IsZero())
+	suite.Require().Equal(sdkmath.NewInt(20), suite.App.BankKeeper.GetBalance(suite.Ctx, types.VaultAddress(denom), "utwo").Amount)
+
+	// the holder can not withdraw more than its vault tokens allow
+	cacheCtx, _ = suite.Ctx.CacheContext()
+	_, err = suite.msgServer.VaultWithdraw(cacheCtx, types.NewMsgVaultWithdraw(holder.String(), denom, sdkmath.NewInt(21)))
+	suite.Require().Error(err)
+
+	queryRes, err := suite.queryClient.Vault(suite.Ctx.Context(), &types.QueryVaultRequest{Denom: denom})
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.Vault{Denom: denom, BackingDenom: "utwo", Ratio: sdkmath.NewInt(100), Escrowed: sdkmath.NewInt(20)}, queryRes.Vault)
+	suite.Require().Equal(types.VaultAddress(denom).String(), queryRes.Address)
+	suite.Require().Equal(sdkmath.NewInt(2000), suite.App.BankKeeper.GetSupply(suite.Ctx, denom).Amount)
+
+	_, err = suite.queryClient.Vault(suite.Ctx.Context(), &types.QueryVaultRequest{Denom: "factory/" + admin.String() + "/bitcoin"})
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperTestSuite) TestCreateVaultInvalid() {
+	suite.CreateDefaultDenom()
+	admin := suite.TestAccs[0]
+
+	for _, tc := range []struct {
+		desc         string
+		subdenom     string
+		backingDenom string
+		ratio        sdkmath.Int
+	}{
+		{desc: "zero ratio", subdenom: "wtwo", backingDenom: "utwo", ratio: sdkmath.ZeroInt()},
+		{desc: "backed by itself", subdenom: "wtwo", backingDenom: "factory/" + admin.String() + "/wtwo", ratio: sdkmath.OneInt()},
+		{desc: "existing denom", subdenom: "bitcoin", backingDenom: "utwo", ratio: sdkmath.OneInt()},
+	} {
+		suite.Run(tc.desc, func() {
+			ctx, _ := suite.Ctx.CacheContext()
+			_, err := suite.msgServer.CreateVault(ctx, types.NewMsgCreateVault(admin.String(), tc.subdenom, tc.backingDenom, tc.ratio))
+			suite.Require().Error(err)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestVaultsQuery() {
+	for i, subdenom := range []string{"wone", "wtwo", "wthree"} {
+		_, err := suite.msgServer.CreateVault(suite.Ctx, types.NewMsgCreateVault(suite.TestAccs[i].String(), subdenom, "utwo", sdkmath.NewInt(int64(i+1))))
+		suite.Require().NoError(err)
+	}
+
+	res, err := suite.queryClient.Vaults(suite.Ctx.Context(), &types.QueryVaultsRequest{
+		Pagination: &query.PageRequest{Limit: 2, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Vaults, 2)
+	suite.Require().Equal(uint64(3), res.Pagination.Total)
+
+	res, err = suite.queryClient.Vaults(suite.Ctx.Context(), &types.QueryVaultsRequest{
+		Pagination: &query.PageRequest{Key: res.Pagination.NextKey},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Vaults, 1)
+	suite.Require().Equal(suite.App.TokenFactoryKeeper.GetAllVaults(suite.Ctx)[2], res.Vaults[0])
+}
+
+func (suite *KeeperTestSuite) TestVaultBackingWithStraySend() {
+	holder := suite.TestAccs[1]
+
+	res, err := suite.msgServer.CreateVault(suite.Ctx, types.NewMsgCreateVault(holder.String(), "wtwo", "utwo", sdkmath.NewInt(3)))
+	suite.Require().NoError(err)
+	denom := res.GetNewTokenDenom()
+	_, err = suite.msgServer.VaultDeposit(suite.Ctx, types.NewMsgVaultDeposit(holder.String(), denom, sdkmath.NewInt(50)))
+	suite.Require().NoError(err)
+
+	// coins sent directly to the escrow account are not escrowed, and can not be withdrawn
+	err = suite.App.BankKeeper.SendCoins(suite.Ctx, holder, types.VaultAddress(denom), sdk.NewCoins(sdk.NewInt64Coin("utwo", 25)))
+	suite.Require().NoError(err)
+	msg, broken := keeper.VaultBackingInvariant(suite.App.TokenFactoryKeeper)(suite.Ctx)
+	suite.Require().False(broken, msg)
+
+	_, err = suite.msgServer.VaultWithdraw(suite.Ctx, types.NewMsgVaultWithdraw(holder.String(), denom, sdkmath.NewInt(50)))
+	suite.Require().NoError(err)
+	suite.Require().True(suite.App.BankKeeper.GetSupply(suite.Ctx, denom).IsZero())
+	suite.Require().Equal(sdkmath.NewInt(25), suite.App.BankKeeper.GetBalance(suite.Ctx, types.VaultAddress(denom), "utwo").Amount)
+
+	msg, broken = keeper.VaultBackingInvariant(suite.App.TokenFactoryKeeper)(suite.Ctx)
+	suite.Require().False(broken, msg)
+}
+
+func (suite *KeeperTestSuite) TestVaultEscrowAccount() {
+	suite.CreateDefaultDenom()
+	admin, holder := suite.TestAccs[0], suite.TestAccs[1]
+
+	// a vault backed by the default denom
+	res, err := suite.msgServer.CreateVault(suite.Ctx, types.NewMsgCreateVault(holder.String(), "wdefault", suite.defaultDenom, sdkmath.NewInt(1)))
+	suite.Require().NoError(err)
+	denom := res.GetNewTokenDenom()
+	_, err = suite.msgServer.Mint(suite.Ctx, types.NewMsgMintTo(admin.String(), sdk.NewInt64Coin(suite.defaultDenom, 50), holder.String()))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.VaultDeposit(suite.Ctx, types.NewMsgVaultDeposit(holder.String(), denom, sdkmath.NewInt(50)))
+	suite.Require().NoError(err)
+
+	// the admin of the backing denom can not drain the escrow account
+	_, err = suite.msgServer.ForceTransfer(suite.Ctx, types.NewMsgForceTransfer(admin.String(), sdk.NewInt64Coin(suite.defaultDenom, 50), types.VaultAddress(denom).String(), admin.String()))
+	suite.Require().ErrorContains(err, "failed to force transfer from escrow address")
+	_, err = suite.msgServer.Burn(suite.Ctx, types.NewMsgBurnFrom(admin.String(), sdk.NewInt64Coin(suite.defaultDenom, 50), types.VaultAddress(denom).String()))
+	suite.Require().ErrorContains(err, "failed to burn from escrow address")
+	_, err = suite.msgServer.MultiBurn(suite.Ctx, types.NewMsgMultiBurn(admin.String(), suite.defaultDenom, []types.MultiBurnInput{
+		{Address: types.VaultAddress(denom).String(), Amount: sdkmath.NewInt(50)},
+	}))
+	suite.Require().ErrorContains(err, "failed to burn from escrow address")
+	suite.Require().Equal(sdkmath.NewInt(50), suite.App.BankKeeper.GetBalance(suite.Ctx, types.VaultAddress(denom), suite.defaultDenom).Amount)
+
+	msg, broken := keeper.VaultBackingInvariant(suite.App.TokenFactoryKeeper)(suite.Ctx)
+	suite.Require().False(broken, msg)
+}
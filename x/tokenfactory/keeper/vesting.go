@@ -0,0 +1,182 @@
+package keeper
+
+import (
+	"context"
+	"strings"
+
+	"github.com/cosmos/tokenfactory/x/tokenfactory/types"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func (k Keeper) getVestingStore(ctx sdk.Context, denom string) storetypes.KVStore {
+	return prefix.NewStore(k.GetDenomPrefixStore(ctx, denom), types.GetVestingPrefix())
+}
+
+// GetVestingSchedule returns the vesting schedule of a holder of a denom, if any
+func (k Keeper) GetVestingSchedule(ctx sdk.Context, denom, holder string) (types.VestingSchedule, bool) {
+	bz := k.getVestingStore(ctx, denom).Get([]byte(holder))
+	if bz == nil {
+		return types.VestingSchedule{}, false
+	}
+
+	schedule := types.VestingSchedule{}
+	k.cdc.MustUnmarshal(bz, &schedule)
+	return schedule, true
+}
+
+func (k Keeper) setVestingSchedule(ctx sdk.Context, schedule types.VestingSchedule) {
+	k.getVestingStore(ctx, schedule.Denom).Set([]byte(schedule.Recipient), k.cdc.MustMarshal(&schedule))
+}
+
+// GetAllVestingSchedules returns the vesting schedules of a denom, ordered by recipient
+func (k Keeper) GetAllVestingSchedules(ctx sdk.Context, denom string) []types.VestingSchedule {
+	iterator := k.getVestingStore(ctx, denom).Iterator(nil, nil)
+	defer iterator.Close()
+
+	schedules := []types.VestingSchedule{}
+	for ; iterator.Valid(); iterator.Next() {
+		schedule := types.VestingSchedule{}
+		k.cdc.MustUnmarshal(iterator.Value(), &schedule)
+		schedules = append(schedules, schedule)
+	}
+	return schedules
+}
+
+// MintVesting mints the amount of a vesting schedule to its recipient, and stores the schedule
+// locking the minted tokens. It fails if the recipient has tokens of the denom locked by a
+// previous schedule, and replaces a fully vested one.
+func (k Keeper) MintVesting(ctx sdk.Context, schedule types.VestingSchedule) error {
+	if err := schedule.Validate(); err != nil {
+		return err
+	}
+
+	if previous, found := k.GetVestingSchedule(ctx, schedule.Denom, schedule.Recipient); found {
+		if locked := previous.LockedAmount(ctx.BlockTime()); locked.IsPositive() {
+			return errorsmod.Wrapf(types.ErrInvalidVesting, "%s still has %s%s locked by a vesting schedule", schedule.Recipient, locked, schedule.Denom)
+		}
+	}
+
+	k.setVestingSchedule(ctx, schedule)
+	return k.mintTo(ctx, sdk.NewCoin(schedule.Denom, schedule.Amount), schedule.Recipient)
+}
+
+// ClawbackVesting removes the vesting schedule of a holder of a denom, and sends its unvested
+// tokens from the holder to the admin. It returns the clawed back coin.
+func (k Keeper) ClawbackVesting(ctx sdk.Context, admin, denom, holder string) (sdk.Coin, error) {
+	schedule, found := k.GetVestingSchedule(ctx, denom, holder)
+	if !found {
+		return sdk.Coin{}, errorsmod.Wrapf(types.ErrVestingNotFound, "denom %s, holder %s", denom, holder)
+	}
+
+	locked := schedule.LockedAmount(ctx.BlockTime())
+	if !locked.IsPositive() {
+		return sdk.Coin{}, errorsmod.Wrapf(types.ErrInvalidVesting, "the vesting schedule of %s is fully vested", holder)
+	}
+
+	holderAddr, err := sdk.AccAddressFromBech32(holder)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	adminAddr, err := sdk.AccAddressFromBech32(admin)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	// the schedule is removed before the send, which the send restriction would block otherwise
+	k.getVestingStore(ctx, denom).Delete([]byte(holder))
+
+	balance := k.bankKeeper.GetBalance(ctx, holderAddr, denom)
+	clawedBack := sdk.NewCoin(denom, sdkmath.MinInt(locked, balance.Amount))
+	if clawedBack.IsPositive() {
+		if err := k.bankKeeper.SendCoins(ctx, holderAddr, adminAddr, sdk.NewCoins(clawedBack)); err != nil {
+			return sdk.Coin{}, err
+		}
+	}
+
+	return clawedBack, nil
+}
+
+// getVestingSend returns the balance of a vesting holder observed by the send restriction in the
+// block, and the amount sent from it, which is not debited yet while the balance is unchanged
+func (k Keeper) getVestingSend(ctx sdk.Context, holder sdk.AccAddress, denom string) (sdkmath.Int, sdkmath.Int, bool) {
+	bz := ctx.TransientStore(k.transientStoreKey).Get(getVestingSendKey(holder, denom))
+	if bz == nil {
+		return sdkmath.Int{}, sdkmath.Int{}, false
+	}
+
+	balanceStr, sentStr, _ := strings.Cut(string(bz), types.KeySeparator)
+	balance, okBalance := sdkmath.NewIntFromString(balanceStr)
+	sent, okSent := sdkmath.NewIntFromString(sentStr)
+	return balance, sent, okBalance && okSent
+}
+
+func (k Keeper) setVestingSend(ctx sdk.Context, holder sdk.AccAddress, denom string, balance, sent sdkmath.Int) {
+	value := balance.String() + types.KeySeparator + sent.String()
+	ctx.TransientStore(k.transientStoreKey).Set(getVestingSendKey(holder, denom), []byte(value))
+}
+
+func getVestingSendKey(holder sdk.AccAddress, denom string) []byte {
+	return append(address.MustLengthPrefix(holder), denom...)
+}
+
+// VestingSendRestriction is the x/bank send restriction keeping the tokens locked by a vesting
+// schedule in the balance of the holder. A send fails when it would leave the holder with less
+// than the locked amount of the denom.
+//
+// InputOutputCoins applies the restrictions to every output before debiting the input, so the
+// amounts sent from an unchanged balance are added up in the transient store. The sends received
+// by the holder are added to the balance it observed, so that an amount already debited is not
+// counted again once the balance is back to its previous value.
+func (k Keeper) VestingSendRestriction(ctx context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) (sdk.AccAddress, error) {
+	// a send to itself leaves the balance of the holder unchanged
+	if fromAddr.Equals(toAddr) {
+		return toAddr, nil
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	for _, coin := range amt {
+		// avoid a store read for the denoms which cannot be vested
+		if !strings.HasPrefix(coin.Denom, types.ModuleDenomPrefix+"/") {
+			continue
+		}
+
+		// the recipient is credited after the restriction
+		if balance, sent, found := k.getVestingSend(sdkCtx, toAddr, coin.Denom); found {
+			k.setVestingSend(sdkCtx, toAddr, coin.Denom, balance.Add(coin.Amount), sent)
+		}
+
+		schedule, found := k.GetVestingSchedule(sdkCtx, coin.Denom, fromAddr.String())
+		if !found {
+			continue
+		}
+
+		locked := schedule.LockedAmount(sdkCtx.BlockTime())
+		if !locked.IsPositive() {
+			continue
+		}
+
+		balance := k.bankKeeper.GetBalance(sdkCtx, fromAddr, coin.Denom).Amount
+		sent := coin.Amount
+		if observed, previous, found := k.getVestingSend(sdkCtx, fromAddr, coin.Denom); found && observed.Equal(balance) {
+			sent = sent.Add(previous)
+		}
+
+		if balance.Sub(sent).LT(locked) {
+			return nil, errorsmod.Wrapf(types.ErrVestingLocked, "%s%s of %s are locked, sending %s%s from a balance of %s", locked, coin.Denom, fromAddr, sent, coin.Denom, balance)
+		}
+
+		k.setVestingSend(sdkCtx, fromAddr, coin.Denom, balance, sent)
+	}
+
+	return toAddr, nil
+}
+
+var _ banktypes.SendRestrictionFn = Keeper{}.VestingSendRestriction
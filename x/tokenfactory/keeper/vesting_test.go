@@ -0,0 +1,227 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/cosmos/tokenfactory/x/tokenfactory/keeper"
+	"github.com/cosmos/tokenfactory/x/tokenfactory/types"
+
+	"github.com/cosmos/gogoproto/proto"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func (suite *KeeperTestSuite) TestVestingMsgs() {
+	suite.CreateDefaultDenom()
+	admin, holder, other := suite.TestAccs[0], suite.TestAccs[1], suite.TestAccs[2]
+	start := suite.Ctx.BlockTime()
+	coins := func(amount int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewInt64Coin(suite.defaultDenom, amount))
+	}
+
+	// the schedule starts at the block time
+	msg := types.NewMsgMintVesting(admin.String(), sdk.NewInt64Coin(suite.defaultDenom, 1000), holder.String(), time.Time{}, start.Add(1000*time.Second), nil)
+	ctx := suite.Ctx.WithEventManager(sdk.NewEventManager())
+	_, err := suite.msgServer.MintVesting(ctx, msg)
+	suite.Require().NoError(err)
+	suite.AssertEventEmitted(ctx, proto.MessageName(&types.EventMint{}), 1)
+	suite.AssertEventEmitted(ctx, proto.MessageName(&types.EventMintVesting{}), 1)
+	suite.Require().Equal(sdkmath.NewInt(1000), suite.App.BankKeeper.GetBalance(suite.Ctx, holder, suite.defaultDenom).Amount)
+
+	queryRes, err := suite.queryClient.VestingSchedule(suite.Ctx.Context(), &types.QueryVestingScheduleRequest{Denom: suite.defaultDenom, Address: holder.String()})
+	suite.Require().NoError(err)
+	suite.Require().Equal(msg.Schedule(start), queryRes.Schedule)
+	suite.Require().Equal(int64(0), queryRes.Vested.Int64())
+	suite.Require().Equal(int64(1000), queryRes.Locked.Int64())
+
+	// a second schedule can not be minted while tokens are locked
+	cacheCtx, _ := suite.Ctx.CacheContext()
+	_, err = suite.msgServer.MintVesting(cacheCtx, msg)
+	suite.Require().ErrorIs(err, types.ErrInvalidVesting)
+
+	// the locked tokens can not be sent, burned or force transferred, the liquid ones can
+	_, err = suite.msgServer.Mint(suite.Ctx, types.NewMsgMintTo(admin.String(), sdk.NewInt64Coin(suite.defaultDenom, 100), holder.String()))
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.App.BankKeeper.SendCoins(suite.Ctx, holder, other, coins(100)))
+	cacheCtx, _ = suite.Ctx.CacheContext()
+	suite.Require().ErrorIs(suite.App.BankKeeper.SendCoins(cacheCtx, holder, other, coins(1)), types.ErrVestingLocked)
+	_, err = suite.msgServer.Burn(cacheCtx, types.NewMsgBurnFrom(admin.String(), sdk.NewInt64Coin(suite.defaultDenom, 1), holder.String()))
+	suite.Require().ErrorIs(err, types.ErrVestingLocked)
+	_, err = suite.msgServer.ForceTransfer(cacheCtx, types.NewMsgForceTransfer(admin.String(), sdk.NewInt64Coin(suite.defaultDenom, 1), holder.String(), other.String()))
+	suite.Require().ErrorIs(err, types.ErrVestingLocked)
+
+	// a quarter of the schedule vests after a quarter of its duration
+	ctx = suite.Ctx.WithBlockTime(start.Add(250 * time.Second))
+	vestingRes, err := suite.App.TokenFactoryKeeper.VestingSchedule(ctx, &types.QueryVestingScheduleRequest{Denom: suite.defaultDenom, Address: holder.String()})
+	suite.Require().NoError(err)
+	suite.Require().Equal(int64(250), vestingRes.Vested.Int64())
+	suite.Require().Equal(int64(750), vestingRes.Locked.Int64())
+	suite.Require().NoError(suite.App.BankKeeper.SendCoins(ctx, holder, other, coins(250)))
+	cacheCtx, _ = ctx.CacheContext()
+	suite.Require().ErrorIs(suite.App.BankKeeper.SendCoins(cacheCtx, holder, other, coins(1)), types.ErrVestingLocked)
+
+	// the outputs of a multi send are checked against the balance together
+	ctx = suite.Ctx.WithBlockTime(start.Add(500 * time.Second))
+	outputs := []banktypes.Output{banktypes.NewOutput(other, coins(150)), banktypes.NewOutput(admin, coins(150))}
+	cacheCtx, _ = ctx.CacheContext()
+	err = suite.App.BankKeeper.InputOutputCoins(cacheCtx, banktypes.NewInput(holder, coins(300)), outputs)
+	suite.Require().ErrorIs(err, types.ErrVestingLocked)
+	outputs = []banktypes.Output{banktypes.NewOutput(other, coins(100)), banktypes.NewOutput(admin, coins(100))}
+	suite.Require().NoError(suite.App.BankKeeper.InputOutputCoins(ctx, banktypes.NewInput(holder, coins(200)), outputs))
+	suite.Require().NoError(suite.App.BankKeeper.SendCoins(ctx, holder, other, coins(50)))
+	cacheCtx, _ = ctx.CacheContext()
+	suite.Require().ErrorIs(suite.App.BankKeeper.SendCoins(cacheCtx, holder, other, coins(1)), types.ErrVestingLocked)
+
+	// the tokens received back in the block can be sent again
+	suite.Require().NoError(suite.App.BankKeeper.SendCoins(ctx, other, holder, coins(50)))
+	suite.Require().NoError(suite.App.BankKeeper.SendCoins(ctx, holder, other, coins(50)))
+	cacheCtx, _ = ctx.CacheContext()
+	suite.Require().ErrorIs(suite.App.BankKeeper.SendCoins(cacheCtx, holder, other, coins(1)), types.ErrVestingLocked)
+
+	invariantMsg, broken := keeper.VestingLockedInvariant(suite.App.TokenFactoryKeeper)(ctx)
+	suite.Require().False(broken, invariantMsg)
+
+	// only the admin can claw back the unvested tokens
+	cacheCtx, _ = ctx.CacheContext()
+	_, err = suite.msgServer.ClawbackVesting(cacheCtx, types.NewMsgClawbackVesting(holder.String(), suite.defaultDenom, holder.String()))
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	adminBalance := suite.App.BankKeeper.GetBalance(ctx, admin, suite.defaultDenom)
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	clawbackRes, err := suite.msgServer.ClawbackVesting(ctx, types.NewMsgClawbackVesting(admin.String(), suite.defaultDenom, holder.String()))
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt64Coin(suite.defaultDenom, 500), clawbackRes.ClawedBack)
+	suite.AssertEventEmitted(ctx, proto.MessageName(&types.EventClawbackVesting{}), 1)
+	suite.Require().Equal(adminBalance.AddAmount(sdkmath.NewInt(500)), suite.App.BankKeeper.GetBalance(ctx, admin, suite.defaultDenom))
+	suite.Require().True(suite.App.BankKeeper.GetBalance(ctx, holder, suite.defaultDenom).IsZero())
+
+	// the schedule is removed
+	_, err = suite.App.TokenFactoryKeeper.VestingSchedule(ctx, &types.QueryVestingScheduleRequest{Denom: suite.defaultDenom, Address: holder.String()})
+	suite.Require().ErrorIs(err, types.ErrVestingNotFound)
+	_, err = suite.msgServer.ClawbackVesting(ctx, types.NewMsgClawbackVesting(admin.String(), suite.defaultDenom, holder.String()))
+	suite.Require().ErrorIs(err, types.ErrVestingNotFound)
+}
+
+func (suite *KeeperTestSuite) TestPeriodicVesting() {
+	suite.CreateDefaultDenom()
+	admin, holder, other := suite.TestAccs[0], suite.TestAccs[1], suite.TestAccs[2]
+	start := suite.Ctx.BlockTime().Add(time.Hour)
+	periods := []types.VestingPeriod{
+		{Length: time.Hour, Amount: sdkmath.NewInt(400)},
+		{Length: time.Hour, Amount: sdkmath.NewInt(600)},
+	}
+	msg := types.NewMsgMintVesting(admin.String(), sdk.NewInt64Coin(suite.defaultDenom, 1000), holder.String(), start, time.Time{}, periods)
+	_, err := suite.msgServer.MintVesting(suite.Ctx, msg)
+	suite.Require().NoError(err)
+
+	for _, tc := range []struct {
+		desc   string
+		time   time.Time
+		vested int64
+	}{
+		{desc: "before the start", time: suite.Ctx.BlockTime(), vested: 0},
+		{desc: "during the first period", time: start.Add(30 * time.Minute), vested: 0},
+		{desc: "after the first period", time: start.Add(time.Hour), vested: 400},
+		{desc: "after the last period", time: start.Add(2 * time.Hour), vested: 1000},
+	} {
+		suite.Run(tc.desc, func() {
+			ctx, _ := suite.Ctx.WithBlockTime(tc.time).CacheContext()
+			res, err := suite.App.TokenFactoryKeeper.VestingSchedule(ctx, &types.QueryVestingScheduleRequest{Denom: suite.defaultDenom, Address: holder.String()})
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.vested, res.Vested.Int64())
+			suite.Require().Equal(1000-tc.vested, res.Locked.Int64())
+
+			// exactly the vested amount can be sent
+			if tc.vested > 0 {
+				suite.Require().NoError(suite.App.BankKeeper.SendCoins(ctx, holder, other, sdk.NewCoins(sdk.NewInt64Coin(suite.defaultDenom, tc.vested))))
+			}
+			if tc.vested < 1000 {
+				err := suite.App.BankKeeper.SendCoins(ctx, holder, other, sdk.NewCoins(sdk.NewInt64Coin(suite.defaultDenom, 1)))
+				suite.Require().ErrorIs(err, types.ErrVestingLocked)
+			}
+		})
+	}
+
+	// a fully vested schedule can not be clawed back, and is replaced by a new one
+	ctx := suite.Ctx.WithBlockTime(start.Add(2 * time.Hour))
+	cacheCtx, _ := ctx.CacheContext()
+	_, err = suite.msgServer.ClawbackVesting(cacheCtx, types.NewMsgClawbackVesting(admin.String(), suite.defaultDenom, holder.String()))
+	suite.Require().ErrorIs(err, types.ErrInvalidVesting)
+
+	msg.StartTime = time.Time{}
+	_, err = suite.msgServer.MintVesting(ctx, msg)
+	suite.Require().NoError(err)
+	schedule, found := suite.App.TokenFactoryKeeper.GetVestingSchedule(ctx, suite.defaultDenom, holder.String())
+	suite.Require().True(found)
+	suite.Require().Equal(ctx.BlockTime(), schedule.StartTime)
+
+	// the tokens of the previous schedule are liquid
+	suite.Require().NoError(suite.App.BankKeeper.SendCoins(ctx, holder, other, sdk.NewCoins(sdk.NewInt64Coin(suite.defaultDenom, 1000))))
+	err = suite.App.BankKeeper.SendCoins(ctx, holder, other, sdk.NewCoins(sdk.NewInt64Coin(suite.defaultDenom, 1)))
+	suite.Require().ErrorIs(err, types.ErrVestingLocked)
+}
+
+func (suite *KeeperTestSuite) TestMintVestingInvalid() {
+	suite.CreateDefaultDenom()
+	admin, holder := suite.TestAccs[0], suite.TestAccs[1]
+	blockTime := suite.Ctx.BlockTime()
+
+	for _, tc := range []struct {
+		desc   string
+		msg    *types.MsgMintVesting
+		expErr error
+	}{
+		{
+			desc:   "denom does not exist",
+			msg:    types.NewMsgMintVesting(admin.String(), sdk.NewInt64Coin("factory/"+admin.String()+"/ghost", 10), holder.String(), time.Time{}, blockTime.Add(time.Hour), nil),
+			expErr: types.ErrDenomDoesNotExist,
+		},
+		{
+			desc:   "ends before the block time",
+			msg:    types.NewMsgMintVesting(admin.String(), sdk.NewInt64Coin(suite.defaultDenom, 10), holder.String(), time.Time{}, blockTime.Add(-time.Hour), nil),
+			expErr: types.ErrInvalidVesting,
+		},
+		{
+			desc:   "not the admin",
+			msg:    types.NewMsgMintVesting(holder.String(), sdk.NewInt64Coin(suite.defaultDenom, 10), holder.String(), time.Time{}, blockTime.Add(time.Hour), nil),
+			expErr: types.ErrUnauthorized,
+		},
+	} {
+		suite.Run(tc.desc, func() {
+			ctx, _ := suite.Ctx.CacheContext()
+			_, err := suite.msgServer.MintVesting(ctx, tc.msg)
+			suite.Require().ErrorIs(err, tc.expErr)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestVestingSchedulesQuery() {
+	suite.CreateDefaultDenom()
+	admin := suite.TestAccs[0]
+	endTime := suite.Ctx.BlockTime().Add(time.Hour)
+	for _, recipient := range suite.TestAccs[1:3] {
+		_, err := suite.msgServer.MintVesting(suite.Ctx, types.NewMsgMintVesting(admin.String(), sdk.NewInt64Coin(suite.defaultDenom, 10), recipient.String(), time.Time{}, endTime, nil))
+		suite.Require().NoError(err)
+	}
+
+	res, err := suite.queryClient.VestingSchedules(suite.Ctx.Context(), &types.QueryVestingSchedulesRequest{
+		Denom:      suite.defaultDenom,
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Schedules, 1)
+	suite.Require().Equal(uint64(2), res.Pagination.Total)
+
+	res, err = suite.queryClient.VestingSchedules(suite.Ctx.Context(), &types.QueryVestingSchedulesRequest{
+		Denom:      suite.defaultDenom,
+		Pagination: &query.PageRequest{Key: res.Pagination.NextKey},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Schedules, 1)
+
+	suite.Require().Equal(suite.App.TokenFactoryKeeper.GetAllVestingSchedules(suite.Ctx, suite.defaultDenom)[1], res.Schedules[0])
+}
@@ -0,0 +1,99 @@
+package keeper
+
+import (
+	"github.com/cosmos/tokenfactory/x/tokenfactory/types"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetVoucherSigner returns the public key signing the mint vouchers of a denom, or nil when the
+// denom has no voucher signer
+func (k Keeper) GetVoucherSigner(ctx sdk.Context, denom string) []byte {
+	return k.GetDenomPrefixStore(ctx, denom).Get([]byte(types.VoucherSignerKey))
+}
+
+// setVoucherSigner stores the voucher signer of a denom, an empty public key removes it
+func (k Keeper) setVoucherSigner(ctx sdk.Context, denom string, pubKey []byte) error {
+	if err := types.ValidateVoucherSigner(pubKey); err != nil {
+		return err
+	}
+
+	store := k.GetDenomPrefixStore(ctx, denom)
+	if len(pubKey) == 0 {
+		store.Delete([]byte(types.VoucherSignerKey))
+		return nil
+	}
+
+	store.Set([]byte(types.VoucherSignerKey), pubKey)
+	return nil
+}
+
+func (k Keeper) getVoucherNonceStore(ctx sdk.Context, denom string) storetypes.KVStore {
+	return prefix.NewStore(k.GetDenomPrefixStore(ctx, denom), types.GetVoucherNoncePrefix())
+}
+
+// IsVoucherNonceUsed returns whether a mint voucher with this nonce has been redeemed for the denom
+func (k Keeper) IsVoucherNonceUsed(ctx sdk.Context, denom string, nonce uint64) bool {
+	return k.getVoucherNonceStore(ctx, denom).Has(sdk.Uint64ToBigEndian(nonce))
+}
+
+func (k Keeper) setVoucherNonceUsed(ctx sdk.Context, denom string, nonce uint64) {
+	k.getVoucherNonceStore(ctx, denom).Set(sdk.Uint64ToBigEndian(nonce), []byte{})
+}
+
+// GetUsedVoucherNonces returns the redeemed mint voucher nonces of a denom, in increasing order
+func (k Keeper) GetUsedVoucherNonces(ctx sdk.Context, denom string) []uint64 {
+	iterator := k.getVoucherNonceStore(ctx, denom).Iterator(nil, nil)
+	defer iterator.Close()
+
+	nonces := []uint64{}
+	for ; iterator.Valid(); iterator.Next() {
+		nonces = append(nonces, sdk.BigEndianToUint64(iterator.Key()))
+	}
+	return nonces
+}
+
+// RedeemMintVoucher verifies the signature of a mint voucher against the voucher signer of its
+// denom and the chain id, and mints the voucher amount to its recipient. Every nonce is
+// redeemed once, and vouchers are rejected from their expiry and once the denom has no admin.
+func (k Keeper) RedeemMintVoucher(ctx sdk.Context, voucher types.MintVoucher, signature []byte) (sdk.Coin, error) {
+	authorityMetadata, err := k.GetAuthorityMetadata(ctx, voucher.Denom)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	// vouchers are issued on behalf of the admin, they can not be redeemed once the admin has
+	// been renounced
+	if authorityMetadata.GetAdmin() == "" {
+		return sdk.Coin{}, errorsmod.Wrapf(types.ErrUnauthorized, "denom %s has no admin", voucher.Denom)
+	}
+
+	pubKey := k.GetVoucherSigner(ctx, voucher.Denom)
+	if len(pubKey) == 0 {
+		return sdk.Coin{}, errorsmod.Wrapf(types.ErrVoucherSignerNotSet, "denom: %s", voucher.Denom)
+	}
+
+	if voucher.IsExpired(ctx.BlockTime()) {
+		return sdk.Coin{}, errorsmod.Wrapf(types.ErrVoucherExpired, "expiry %s, block time %s", voucher.Expiry, ctx.BlockTime())
+	}
+
+	if k.IsVoucherNonceUsed(ctx, voucher.Denom, voucher.Nonce) {
+		return sdk.Coin{}, errorsmod.Wrapf(types.ErrVoucherNonceUsed, "denom %s, nonce %d", voucher.Denom, voucher.Nonce)
+	}
+
+	if !types.VerifyVoucherSignature(pubKey, ctx.ChainID(), voucher, signature) {
+		return sdk.Coin{}, errorsmod.Wrapf(types.ErrInvalidVoucherSignature, "denom %s, nonce %d", voucher.Denom, voucher.Nonce)
+	}
+
+	coin := sdk.NewCoin(voucher.Denom, voucher.Amount)
+	if err := k.mintTo(ctx, coin, voucher.Recipient); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	k.setVoucherNonceUsed(ctx, voucher.Denom, voucher.Nonce)
+	return coin, nil
+}
@@ -0,0 +1,140 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/cosmos/tokenfactory/x/tokenfactory/types"
+
+	"github.com/cosmos/gogoproto/proto"
+
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// signMintVoucher returns a voucher of the default denom for the test account 1, signed by the
+// private key for the chain of the suite context.
+func (suite *KeeperTestSuite) signMintVoucher(signer *secp256k1.PrivKey, amount int64, nonce uint64) (types.MintVoucher, []byte) {
+	voucher := types.MintVoucher{
+		Denom:     suite.defaultDenom,
+		Amount:    sdkmath.NewInt(amount),
+		Recipient: suite.TestAccs[1].String(),
+		Nonce:     nonce,
+		Expiry:    suite.Ctx.BlockTime().Add(time.Hour),
+	}
+
+	signBytes, err := types.VoucherSignBytes(suite.Ctx.ChainID(), voucher)
+	suite.Require().NoError(err)
+	signature, err := signer.Sign(signBytes)
+	suite.Require().NoError(err)
+	return voucher, signature
+}
+
+func (suite *KeeperTestSuite) TestSetVoucherSignerMsg() {
+	suite.CreateDefaultDenom()
+	admin, other := suite.TestAccs[0], suite.TestAccs[1]
+	pubKey := secp256k1.GenPrivKey().PubKey().Bytes()
+
+	_, err := suite.msgServer.SetVoucherSigner(suite.Ctx, types.NewMsgSetVoucherSigner(other.String(), suite.defaultDenom, pubKey))
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	ctx := suite.Ctx.WithEventManager(sdk.NewEventManager())
+	_, err = suite.msgServer.SetVoucherSigner(ctx, types.NewMsgSetVoucherSigner(admin.String(), suite.defaultDenom, pubKey))
+	suite.Require().NoError(err)
+	suite.AssertEventEmitted(ctx, proto.MessageName(&types.EventSetVoucherSigner{}), 1)
+
+	queryRes, err := suite.queryClient.VoucherSigner(suite.Ctx.Context(), &types.QueryVoucherSignerRequest{Denom: suite.defaultDenom})
+	suite.Require().NoError(err)
+	suite.Require().Equal(pubKey, queryRes.PubKey)
+
+	// an empty public key removes the signer
+	_, err = suite.msgServer.SetVoucherSigner(suite.Ctx, types.NewMsgSetVoucherSigner(admin.String(), suite.defaultDenom, nil))
+	suite.Require().NoError(err)
+	suite.Require().Empty(suite.App.TokenFactoryKeeper.GetVoucherSigner(suite.Ctx, suite.defaultDenom))
+}
+
+func (suite *KeeperTestSuite) TestRedeemMintVoucherMsg() {
+	suite.CreateDefaultDenom()
+	admin, recipient, redeemer := suite.TestAccs[0], suite.TestAccs[1], suite.TestAccs[2]
+	signer := secp256k1.GenPrivKey()
+
+	voucher, signature := suite.signMintVoucher(signer, 100, 1)
+
+	// the denom has no voucher signer yet
+	_, err := suite.msgServer.RedeemMintVoucher(suite.Ctx, types.NewMsgRedeemMintVoucher(redeemer.String(), voucher, signature))
+	suite.Require().ErrorIs(err, types.ErrVoucherSignerNotSet)
+
+	_, err = suite.msgServer.SetVoucherSigner(suite.Ctx, types.NewMsgSetVoucherSigner(admin.String(), suite.defaultDenom, signer.PubKey().Bytes()))
+	suite.Require().NoError(err)
+
+	otherVoucher, otherSignature := suite.signMintVoucher(secp256k1.GenPrivKey(), 100, 2)
+	tamperedVoucher := voucher
+	tamperedVoucher.Amount = sdkmath.NewInt(1000)
+
+	for _, tc := range []struct {
+		desc   string
+		ctx    sdk.Context
+		msg    *types.MsgRedeemMintVoucher
+		expErr error
+	}{
+		{
+			desc:   "signed by another key",
+			ctx:    suite.Ctx,
+			msg:    types.NewMsgRedeemMintVoucher(redeemer.String(), otherVoucher, otherSignature),
+			expErr: types.ErrInvalidVoucherSignature,
+		},
+		{
+			desc:   "tampered amount",
+			ctx:    suite.Ctx,
+			msg:    types.NewMsgRedeemMintVoucher(redeemer.String(), tamperedVoucher, signature),
+			expErr: types.ErrInvalidVoucherSignature,
+		},
+		{
+			desc:   "signed for another chain",
+			ctx:    suite.Ctx.WithChainID("other-chain"),
+			msg:    types.NewMsgRedeemMintVoucher(redeemer.String(), voucher, signature),
+			expErr: types.ErrInvalidVoucherSignature,
+		},
+		{
+			desc:   "expired",
+			ctx:    suite.Ctx.WithBlockTime(voucher.Expiry),
+			msg:    types.NewMsgRedeemMintVoucher(redeemer.String(), voucher, signature),
+			expErr: types.ErrVoucherExpired,
+		},
+	} {
+		suite.Run(tc.desc, func() {
+			ctx, _ := tc.ctx.CacheContext()
+			_, err := suite.msgServer.RedeemMintVoucher(ctx, tc.msg)
+			suite.Require().ErrorIs(err, tc.expErr)
+		})
+	}
+
+	// anyone can redeem the voucher, the amount is minted to its recipient
+	ctx := suite.Ctx.WithEventManager(sdk.NewEventManager())
+	res, err := suite.msgServer.RedeemMintVoucher(ctx, types.NewMsgRedeemMintVoucher(redeemer.String(), voucher, signature))
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt64Coin(suite.defaultDenom, 100), res.Minted)
+	suite.Require().Equal(res.Minted, suite.App.BankKeeper.GetBalance(ctx, recipient, suite.defaultDenom))
+	suite.Require().True(suite.App.BankKeeper.GetBalance(ctx, redeemer, suite.defaultDenom).IsZero())
+	suite.AssertEventEmitted(ctx, proto.MessageName(&types.EventRedeemMintVoucher{}), 1)
+	suite.AssertEventEmitted(ctx, proto.MessageName(&types.EventMint{}), 1)
+
+	// every nonce is redeemed once
+	_, err = suite.msgServer.RedeemMintVoucher(suite.Ctx, types.NewMsgRedeemMintVoucher(recipient.String(), voucher, signature))
+	suite.Require().ErrorIs(err, types.ErrVoucherNonceUsed)
+
+	nonceRes, err := suite.queryClient.VoucherNonce(suite.Ctx.Context(), &types.QueryVoucherNonceRequest{Denom: suite.defaultDenom, Nonce: 1})
+	suite.Require().NoError(err)
+	suite.Require().True(nonceRes.Used)
+	nonceRes, err = suite.queryClient.VoucherNonce(suite.Ctx.Context(), &types.QueryVoucherNonceRequest{Denom: suite.defaultDenom, Nonce: 2})
+	suite.Require().NoError(err)
+	suite.Require().False(nonceRes.Used)
+
+	// vouchers are not redeemable once the admin has been renounced
+	voucher, signature = suite.signMintVoucher(signer, 100, 3)
+	_, err = suite.msgServer.ChangeAdmin(suite.Ctx, types.NewMsgChangeAdmin(admin.String(), suite.defaultDenom, ""))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.RedeemMintVoucher(suite.Ctx, types.NewMsgRedeemMintVoucher(redeemer.String(), voucher, signature))
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+}
@@ -12,9 +12,11 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"slices"
 
 	"github.com/cosmos/tokenfactory/x/tokenfactory/client/cli"
 	"github.com/cosmos/tokenfactory/x/tokenfactory/keeper"
+	modulev1 "github.com/cosmos/tokenfactory/x/tokenfactory/module/v1"
 	simulation "github.com/cosmos/tokenfactory/x/tokenfactory/simulation"
 	"github.com/cosmos/tokenfactory/x/tokenfactory/types"
 	"github.com/gorilla/mux"
@@ -23,21 +25,37 @@ import (
 
 	abci "github.com/cometbft/cometbft/abci/types"
 
+	authmodulev1 "cosmossdk.io/api/cosmos/auth/module/v1"
+	"cosmossdk.io/client/v2/autocli"
+	"cosmossdk.io/core/appmodule"
+	"cosmossdk.io/depinject"
+	storetypes "cosmossdk.io/store/types"
+
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
 	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 var (
 	_ module.AppModule      = AppModule{}
 	_ module.AppModuleBasic = AppModuleBasic{}
+
+	_ module.AppModuleSimulation = AppModule{}
+	_ module.HasProposalMsgs     = AppModule{}
+
+	_ appmodule.HasEndBlocker = AppModule{}
+
+	_ autocli.HasAutoCLIConfig = AppModule{}
 )
 
 // ConsensusVersion defines the current x/tokenfactory module consensus version.
-const ConsensusVersion = 2
+const ConsensusVersion = 3
 
 // ----------------------------------------------------------------------------
 // AppModuleBasic
@@ -88,16 +106,16 @@ func (AppModuleBasic) RegisterGRPCGatewayRoutes(clientCtx client.Context, mux *r
 	types.RegisterQueryHandlerClient(context.Background(), mux, types.NewQueryClient(clientCtx)) //nolint:errcheck
 }
 
+// GetQueryCmd returns the x/tokenfactory module's root query command, extended by autocli.
+func (AppModuleBasic) GetQueryCmd() *cobra.Command {
+	return cli.GetQueryCmd()
+}
+
 // GetTxCmd returns the x/tokenfactory module's root tx command.
 func (a AppModuleBasic) GetTxCmd() *cobra.Command {
 	return cli.GetTxCmd()
 }
 
-// GetQueryCmd returns the x/tokenfactory module's root query command.
-func (AppModuleBasic) GetQueryCmd() *cobra.Command {
-	return cli.GetQueryCmd()
-}
-
 // ----------------------------------------------------------------------------
 // AppModule
 // ----------------------------------------------------------------------------
@@ -148,10 +166,15 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 	if err := cfg.RegisterMigration(types.ModuleName, 1, m.Migrate1to2); err != nil {
 		panic(fmt.Sprintf("failed to migrate x/%s from version 1 to 2: %v", types.ModuleName, err))
 	}
+	if err := cfg.RegisterMigration(types.ModuleName, 2, m.Migrate2to3); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 2 to 3: %v", types.ModuleName, err))
+	}
 }
 
 // RegisterInvariants registers the x/tokenfactory module's invariants.
-func (am AppModule) RegisterInvariants(_ sdk.InvariantRegistry) {}
+func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
+	keeper.RegisterInvariants(ir, am.keeper)
+}
 
 // InitGenesis performs the x/tokenfactory module's genesis initialization. It
 // returns no validator updates.
@@ -176,6 +199,17 @@ func (AppModule) ConsensusVersion() uint64 {
 	return ConsensusVersion
 }
 
+// EndBlock prunes the reference ids of MsgMint and MsgBurn older than the retention param,
+// refunds the redemption requests that timed out, and executes the basket composition changes
+// whose timelock passed.
+func (am AppModule) EndBlock(ctx context.Context) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	am.keeper.PruneReferenceIDs(sdkCtx)
+	am.keeper.RefundTimedOutRedemptions(sdkCtx)
+	am.keeper.ExecuteBasketCompositions(sdkCtx)
+	return nil
+}
+
 // ____________________________________________________________________________
 
 // AppModuleSimulation functions
@@ -183,16 +217,103 @@ func (AppModule) GenerateGenesisState(simState *module.SimulationState) {
 	simulation.RandomizedGenState(simState)
 }
 
-// GenerateGenesisState creates a randomized GenState of the bank module.
-func (am AppModule) ProposalContents(_ module.SimulationState) []simtypes.WeightedProposalMsg {
-	return nil
+// ProposalMsgs returns msgs used for governance proposals for simulations.
+func (AppModule) ProposalMsgs(_ module.SimulationState) []simtypes.WeightedProposalMsg {
+	return simulation.ProposalMsgs()
 }
 
-// RegisterStoreDecoder registers a decoder for supply module's types
-func (am AppModule) RegisterStoreDecoder(_ simtypes.StoreDecoderRegistry) {
+// RegisterStoreDecoder registers a decoder for tokenfactory module's types
+func (am AppModule) RegisterStoreDecoder(sdr simtypes.StoreDecoderRegistry) {
+	sdr[types.StoreKey] = simulation.NewDecodeStore(types.ModuleCdc)
 }
 
 // WeightedOperations returns the all the gov module operations with their respective weights.
 func (am AppModule) WeightedOperations(simState module.SimulationState) []simtypes.WeightedOperation {
 	return simulation.WeightedOperations(&simState, am.keeper, am.accountKeeper, am.bankKeeper)
 }
+
+// ----------------------------------------------------------------------------
+// App Wiring Setup
+// ----------------------------------------------------------------------------
+
+func init() {
+	appmodule.Register(
+		&modulev1.Module{},
+		appmodule.Provide(ProvideModule),
+	)
+}
+
+// ModuleInputs are the dependencies of the module when the app is wired with depinject.
+type ModuleInputs struct {
+	depinject.In
+
+	Config            *modulev1.Module
+	AuthConfig        *authmodulev1.Module
+	Cdc               codec.Codec
+	StoreKey          *storetypes.KVStoreKey
+	TransientStoreKey *storetypes.TransientStoreKey
+
+	AccountKeeper       types.AccountKeeper
+	BankKeeper          types.BankKeeper
+	CommunityPoolKeeper types.CommunityPoolKeeper
+
+	// ContractKeeper calls the denom hooks and the before send hooks, which are skipped without it
+	ContractKeeper types.ContractKeeper    `optional:"true"`
+	Hooks          types.TokenFactoryHooks `optional:"true"`
+}
+
+// ModuleOutputs are the keeper, the module and the send restriction provided to the app.
+type ModuleOutputs struct {
+	depinject.Out
+
+	TokenFactoryKeeper keeper.Keeper
+	Module             appmodule.AppModule
+	SendRestriction    banktypes.SendRestrictionFn
+}
+
+// ProvideModule creates the keeper and the module from the module config. The permissions of
+// the module accounts are the ones of the auth module config.
+//
+// The keeper is provided by value, so the contract keeper and the TokenFactoryHooks must be
+// supplied to the container: setting them on the provided keeper does not reach the keeper of
+// the module. The send restriction runs the before send hooks, then the vesting restriction.
+func ProvideModule(in ModuleInputs) (ModuleOutputs, error) {
+	for _, capability := range in.Config.EnabledCapabilities {
+		if !slices.Contains(types.AllCapabilities(), capability) {
+			return ModuleOutputs{}, fmt.Errorf("unknown tokenfactory capability %s, must be one of %v", capability, types.AllCapabilities())
+		}
+	}
+
+	// default to governance authority if not provided
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName)
+	if in.Config.Authority != "" {
+		authority = authtypes.NewModuleAddressOrBech32Address(in.Config.Authority)
+	}
+
+	maccPerms := make(map[string][]string, len(in.AuthConfig.ModuleAccountPermissions))
+	for _, permission := range in.AuthConfig.ModuleAccountPermissions {
+		maccPerms[permission.Account] = permission.Permissions
+	}
+
+	k := keeper.NewKeeper(
+		in.Cdc,
+		in.StoreKey,
+		in.TransientStoreKey,
+		maccPerms,
+		in.AccountKeeper,
+		in.BankKeeper,
+		in.CommunityPoolKeeper,
+		in.Config.EnabledCapabilities,
+		authority.String(),
+	)
+	if in.ContractKeeper != nil {
+		k.SetContractKeeper(in.ContractKeeper)
+	}
+	if in.Hooks != nil {
+		k.SetHooks(in.Hooks)
+	}
+	m := NewAppModule(k, in.AccountKeeper, in.BankKeeper)
+	sendRestriction := banktypes.ComposeSendRestrictions(k.BeforeSendRestriction, k.VestingSendRestriction)
+
+	return ModuleOutputs{TokenFactoryKeeper: k, Module: m, SendRestriction: sendRestriction}, nil
+}
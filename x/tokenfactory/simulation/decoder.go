@@ -0,0 +1,143 @@
+package simulation
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/cosmos/tokenfactory/x/tokenfactory/types"
+
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+)
+
+// NewDecodeStore returns a decoder function closure that unmarshals the KVPair's
+// Value to the corresponding tokenfactory type.
+func NewDecodeStore(cdc codec.BinaryCodec) func(kvA, kvB kv.Pair) string {
+	denomsPrefix := []byte(types.DenomsPrefixKey + types.KeySeparator)
+	creatorPrefix := []byte(types.CreatorPrefixKey + types.KeySeparator)
+	adminPrefix := []byte(types.AdminPrefixKey + types.KeySeparator)
+	claimCampaignPrefix := []byte(types.ClaimCampaignPrefixKey + types.KeySeparator)
+	claimRecordPrefix := []byte(types.ClaimRecordPrefixKey + types.KeySeparator)
+	referenceIDHeightPrefix := []byte(types.ReferenceIDHeightPrefix + types.KeySeparator)
+	redemptionTimeoutPrefix := []byte(types.RedemptionTimeoutPrefix + types.KeySeparator)
+	vaultPrefix := types.GetVaultPrefix()
+	cw20BridgePrefix := types.GetCW20BridgePrefix()
+	cw20ContractPrefix := types.GetCW20ContractPrefix()
+	basketPrefix := types.GetBasketPrefix()
+	basketCompositionPrefix := []byte(types.BasketCompositionPrefix + types.KeySeparator)
+	escrowPrefix := types.GetEscrowPrefix()
+
+	return func(kvA, kvB kv.Pair) string {
+		switch {
+		// first, as a reference id can end like any other key of a denom
+		case bytes.HasPrefix(kvA.Key, denomsPrefix) && bytes.Contains(kvA.Key, []byte(types.KeySeparator+types.ReferenceIDPrefixKey+types.KeySeparator)):
+			return fmt.Sprintf("%d\n%d", sdk.BigEndianToUint64(kvA.Value), sdk.BigEndianToUint64(kvB.Value))
+
+		case bytes.Equal(kvA.Key, types.ParamsKey):
+			var paramsA, paramsB types.Params
+			cdc.MustUnmarshal(kvA.Value, &paramsA)
+			cdc.MustUnmarshal(kvB.Value, &paramsB)
+			return fmt.Sprintf("%v\n%v", paramsA, paramsB)
+
+		case bytes.HasPrefix(kvA.Key, denomsPrefix) && bytes.HasSuffix(kvA.Key, []byte(types.KeySeparator+types.DenomAuthorityMetadataKey)):
+			var metadataA, metadataB types.DenomAuthorityMetadata
+			cdc.MustUnmarshal(kvA.Value, &metadataA)
+			cdc.MustUnmarshal(kvB.Value, &metadataB)
+			return fmt.Sprintf("%v\n%v", metadataA, metadataB)
+
+		case bytes.HasPrefix(kvA.Key, denomsPrefix) && bytes.HasSuffix(kvA.Key, []byte(types.KeySeparator+types.DenomHookKey)):
+			var hookA, hookB types.DenomHook
+			cdc.MustUnmarshal(kvA.Value, &hookA)
+			cdc.MustUnmarshal(kvB.Value, &hookB)
+			return fmt.Sprintf("%v\n%v", hookA, hookB)
+
+		case bytes.HasPrefix(kvA.Key, denomsPrefix) && bytes.HasSuffix(kvA.Key, []byte(types.KeySeparator+types.BeforeSendHookAddressKey)),
+			bytes.HasPrefix(kvA.Key, creatorPrefix),
+			bytes.HasPrefix(kvA.Key, adminPrefix):
+			return fmt.Sprintf("%s\n%s", kvA.Value, kvB.Value)
+
+		case bytes.HasPrefix(kvA.Key, denomsPrefix) && bytes.HasSuffix(kvA.Key, []byte(types.KeySeparator+types.VoucherSignerKey)),
+			bytes.HasPrefix(kvA.Key, denomsPrefix) && bytes.Contains(kvA.Key, []byte(types.KeySeparator+types.VoucherNoncePrefixKey+types.KeySeparator)),
+			bytes.HasPrefix(kvA.Key, referenceIDHeightPrefix):
+			return fmt.Sprintf("%X\n%X", kvA.Value, kvB.Value)
+
+		case bytes.HasPrefix(kvA.Key, denomsPrefix) && bytes.HasSuffix(kvA.Key, []byte(types.KeySeparator+types.ReserveAttestorKey)):
+			var attestorA, attestorB types.ReserveAttestor
+			cdc.MustUnmarshal(kvA.Value, &attestorA)
+			cdc.MustUnmarshal(kvB.Value, &attestorB)
+			return fmt.Sprintf("%v\n%v", attestorA, attestorB)
+
+		case bytes.HasPrefix(kvA.Key, denomsPrefix) && bytes.Contains(kvA.Key, []byte(types.KeySeparator+types.ReserveAttestationPrefix+types.KeySeparator)):
+			var attestationA, attestationB types.ReserveAttestation
+			cdc.MustUnmarshal(kvA.Value, &attestationA)
+			cdc.MustUnmarshal(kvB.Value, &attestationB)
+			return fmt.Sprintf("%v\n%v", attestationA, attestationB)
+
+		case bytes.HasPrefix(kvA.Key, denomsPrefix) && bytes.Contains(kvA.Key, []byte(types.KeySeparator+types.RedemptionPrefixKey+types.KeySeparator)):
+			var redemptionA, redemptionB types.RedemptionRequest
+			cdc.MustUnmarshal(kvA.Value, &redemptionA)
+			cdc.MustUnmarshal(kvB.Value, &redemptionB)
+			return fmt.Sprintf("%v\n%v", redemptionA, redemptionB)
+
+		case bytes.HasPrefix(kvA.Key, denomsPrefix) && bytes.Contains(kvA.Key, []byte(types.KeySeparator+types.OpenRedemptionPrefixKey+types.KeySeparator)),
+			bytes.HasPrefix(kvA.Key, redemptionTimeoutPrefix):
+			return fmt.Sprintf("%X\n%X", kvA.Value, kvB.Value)
+
+		case bytes.HasPrefix(kvA.Key, denomsPrefix) && bytes.Contains(kvA.Key, []byte(types.KeySeparator+types.VestingPrefixKey+types.KeySeparator)):
+			var scheduleA, scheduleB types.VestingSchedule
+			cdc.MustUnmarshal(kvA.Value, &scheduleA)
+			cdc.MustUnmarshal(kvB.Value, &scheduleB)
+			return fmt.Sprintf("%v\n%v", scheduleA, scheduleB)
+
+		case bytes.HasPrefix(kvA.Key, vaultPrefix):
+			var vaultA, vaultB types.Vault
+			cdc.MustUnmarshal(kvA.Value, &vaultA)
+			cdc.MustUnmarshal(kvB.Value, &vaultB)
+			return fmt.Sprintf("%v\n%v", vaultA, vaultB)
+
+		case bytes.HasPrefix(kvA.Key, cw20BridgePrefix):
+			var bridgeA, bridgeB types.CW20Bridge
+			cdc.MustUnmarshal(kvA.Value, &bridgeA)
+			cdc.MustUnmarshal(kvB.Value, &bridgeB)
+			return fmt.Sprintf("%v\n%v", bridgeA, bridgeB)
+
+		case bytes.HasPrefix(kvA.Key, cw20ContractPrefix),
+			bytes.HasPrefix(kvA.Key, escrowPrefix):
+			return fmt.Sprintf("%s\n%s", kvA.Value, kvB.Value)
+
+		case bytes.HasPrefix(kvA.Key, basketPrefix):
+			var basketA, basketB types.Basket
+			cdc.MustUnmarshal(kvA.Value, &basketA)
+			cdc.MustUnmarshal(kvB.Value, &basketB)
+			return fmt.Sprintf("%v\n%v", basketA, basketB)
+
+		case bytes.HasPrefix(kvA.Key, basketCompositionPrefix):
+			return fmt.Sprintf("%X\n%X", kvA.Value, kvB.Value)
+
+		case bytes.HasPrefix(kvA.Key, claimCampaignPrefix):
+			var campaignA, campaignB types.ClaimCampaign
+			cdc.MustUnmarshal(kvA.Value, &campaignA)
+			cdc.MustUnmarshal(kvB.Value, &campaignB)
+			return fmt.Sprintf("%v\n%v", campaignA, campaignB)
+
+		case bytes.HasPrefix(kvA.Key, claimRecordPrefix):
+			var amountA, amountB sdkmath.Int
+			if err := amountA.Unmarshal(kvA.Value); err != nil {
+				panic(err)
+			}
+			if err := amountB.Unmarshal(kvB.Value); err != nil {
+				panic(err)
+			}
+			return fmt.Sprintf("%s\n%s", amountA, amountB)
+
+		case bytes.Equal(kvA.Key, []byte(types.NextClaimCampaignIDKey)):
+			return fmt.Sprintf("%d\n%d", sdk.BigEndianToUint64(kvA.Value), sdk.BigEndianToUint64(kvB.Value))
+
+		default:
+			panic(fmt.Sprintf("invalid tokenfactory key %s", kvA.Key))
+		}
+	}
+}
@@ -0,0 +1,160 @@
+package simulation_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/tokenfactory/x/tokenfactory/simulation"
+	"github.com/cosmos/tokenfactory/x/tokenfactory/types"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+)
+
+func TestDecodeStore(t *testing.T) {
+	cdc := types.ModuleCdc
+	dec := simulation.NewDecodeStore(cdc)
+
+	creator := "cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewc9m7z44"
+	denom := "factory/" + creator + "/bitcoin"
+	params := types.DefaultParams()
+	authorityMetadata := types.DenomAuthorityMetadata{Admin: creator}
+	denomHook := types.DenomHook{ContractAddress: creator, Strict: true}
+	claimCampaign := types.ClaimCampaign{
+		Id:         1,
+		Denom:      denom,
+		Creator:    creator,
+		MerkleRoot: types.ClaimLeafHash(creator, sdkmath.NewInt(10)),
+		TotalCap:   sdkmath.NewInt(10),
+		Claimed:    sdkmath.NewInt(10),
+	}
+	reserveAttestor := types.ReserveAttestor{Attestor: creator, MaxStaleness: time.Hour}
+	reserveAttestation := types.ReserveAttestation{
+		Id:         1,
+		Attestor:   creator,
+		Amount:     sdkmath.NewInt(100),
+		Timestamp:  time.Unix(100, 0).UTC(),
+		ReportHash: make([]byte, types.ReportHashLength),
+	}
+	redemption := types.RedemptionRequest{
+		Id:              1,
+		Holder:          creator,
+		Amount:          sdk.NewInt64Coin(denom, 100),
+		PayoutReference: "iban",
+		Created:         time.Unix(100, 0).UTC(),
+		Status:          types.REDEMPTION_STATUS_OPEN,
+	}
+	vault := types.Vault{
+		Denom:        denom,
+		BackingDenom: "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2",
+		Ratio:        sdkmath.NewInt(100),
+		Escrowed:     sdkmath.NewInt(5),
+	}
+	cw20Bridge := types.CW20Bridge{
+		Denom:           denom,
+		ContractAddress: "cosmos14hj2tavq8fpesdwxxcu44rty3hh90vhujrvcmstl4zr3txmfvw9s4hmalr",
+		Escrowed:        sdkmath.NewInt(5),
+	}
+	basket := types.Basket{
+		Denom:               denom,
+		Components:          sdk.NewCoins(sdk.NewInt64Coin("uatom", 10), sdk.NewInt64Coin("uosmo", 5)),
+		Escrowed:            sdk.NewCoins(sdk.NewInt64Coin("uatom", 20), sdk.NewInt64Coin("uosmo", 10)),
+		Supply:              sdkmath.NewInt(2),
+		CompositionTimelock: 24 * time.Hour,
+		PendingComposition: &types.PendingBasketComposition{
+			Components:   sdk.NewCoins(sdk.NewInt64Coin("uatom", 10)),
+			ExecuteAfter: time.Unix(300, 0).UTC(),
+		},
+	}
+	vestingSchedule := types.VestingSchedule{
+		Denom:     denom,
+		Recipient: creator,
+		Amount:    sdkmath.NewInt(100),
+		StartTime: time.Unix(100, 0).UTC(),
+		EndTime:   time.Unix(400, 0).UTC(),
+	}
+	claimedAmount, err := claimCampaign.Claimed.Marshal()
+	require.NoError(t, err)
+
+	denomKey := func(key string) []byte {
+		return append(types.GetDenomPrefixStore(denom), []byte(key)...)
+	}
+
+	kvPairs := kv.Pairs{
+		Pairs: []kv.Pair{
+			{Key: types.ParamsKey, Value: cdc.MustMarshal(&params)},
+			{Key: denomKey(types.DenomAuthorityMetadataKey), Value: cdc.MustMarshal(&authorityMetadata)},
+			{Key: denomKey(types.DenomHookKey), Value: cdc.MustMarshal(&denomHook)},
+			{Key: denomKey(types.BeforeSendHookAddressKey), Value: []byte(creator)},
+			{Key: append(types.GetCreatorPrefix(creator), []byte(denom)...), Value: []byte(denom)},
+			{Key: []byte(strings.Join([]string{types.AdminPrefixKey, creator, denom}, types.KeySeparator)), Value: []byte(denom)},
+			{Key: types.GetClaimCampaignKey(1), Value: cdc.MustMarshal(&claimCampaign)},
+			{Key: append(types.GetClaimRecordPrefix(1), []byte(creator)...), Value: claimedAmount},
+			{Key: []byte(types.NextClaimCampaignIDKey), Value: sdk.Uint64ToBigEndian(2)},
+			{Key: denomKey(types.VoucherSignerKey), Value: []byte{0x02, 0xab}},
+			{Key: append(denomKey(string(types.GetVoucherNoncePrefix())), sdk.Uint64ToBigEndian(7)...), Value: []byte{}},
+			{Key: denomKey(string(types.GetReferenceIDPrefix()) + types.DenomHookKey), Value: sdk.Uint64ToBigEndian(12)},
+			{Key: types.GetReferenceIDHeightKey(12, denom, types.DenomHookKey), Value: []byte{}},
+			{Key: denomKey(types.ReserveAttestorKey), Value: cdc.MustMarshal(&reserveAttestor)},
+			{Key: append(denomKey(string(types.GetReserveAttestationPrefix())), sdk.Uint64ToBigEndian(1)...), Value: cdc.MustMarshal(&reserveAttestation)},
+			{Key: append(denomKey(string(types.GetRedemptionPrefix())), sdk.Uint64ToBigEndian(1)...), Value: cdc.MustMarshal(&redemption)},
+			{Key: append(denomKey(string(types.GetOpenRedemptionPrefix())), sdk.Uint64ToBigEndian(1)...), Value: []byte{}},
+			{Key: types.GetRedemptionTimeoutKey(time.Unix(200, 0).UTC(), denom, 1), Value: []byte{}},
+			{Key: append(types.GetVaultPrefix(), []byte(denom)...), Value: cdc.MustMarshal(&vault)},
+			{Key: append(types.GetCW20BridgePrefix(), []byte(denom)...), Value: cdc.MustMarshal(&cw20Bridge)},
+			{Key: append(types.GetCW20ContractPrefix(), []byte(cw20Bridge.ContractAddress)...), Value: []byte(denom)},
+			{Key: append(types.GetBasketPrefix(), []byte(denom)...), Value: cdc.MustMarshal(&basket)},
+			{Key: types.GetBasketCompositionKey(time.Unix(300, 0).UTC(), denom), Value: []byte{}},
+			{Key: append(types.GetEscrowPrefix(), []byte(types.VaultAddress(denom).String())...), Value: []byte(denom)},
+			{Key: append(denomKey(string(types.GetVestingPrefix())), []byte(creator)...), Value: cdc.MustMarshal(&vestingSchedule)},
+			{Key: []byte("unknown"), Value: []byte("unknown")},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		expectedLog string
+	}{
+		{"Params", fmt.Sprintf("%v\n%v", params, params)},
+		{"DenomAuthorityMetadata", fmt.Sprintf("%v\n%v", authorityMetadata, authorityMetadata)},
+		{"DenomHook", fmt.Sprintf("%v\n%v", denomHook, denomHook)},
+		{"BeforeSendHookAddress", fmt.Sprintf("%s\n%s", creator, creator)},
+		{"CreatorDenom", fmt.Sprintf("%s\n%s", denom, denom)},
+		{"AdminDenom", fmt.Sprintf("%s\n%s", denom, denom)},
+		{"ClaimCampaign", fmt.Sprintf("%v\n%v", claimCampaign, claimCampaign)},
+		{"ClaimRecord", "10\n10"},
+		{"NextClaimCampaignID", "2\n2"},
+		{"VoucherSigner", "02AB\n02AB"},
+		{"VoucherNonce", "\n"},
+		{"ReferenceID", "12\n12"},
+		{"ReferenceIDHeight", "\n"},
+		{"ReserveAttestor", fmt.Sprintf("%v\n%v", reserveAttestor, reserveAttestor)},
+		{"ReserveAttestation", fmt.Sprintf("%v\n%v", reserveAttestation, reserveAttestation)},
+		{"Redemption", fmt.Sprintf("%v\n%v", redemption, redemption)},
+		{"OpenRedemption", "\n"},
+		{"RedemptionTimeout", "\n"},
+		{"Vault", fmt.Sprintf("%v\n%v", vault, vault)},
+		{"CW20Bridge", fmt.Sprintf("%v\n%v", cw20Bridge, cw20Bridge)},
+		{"CW20 contract", fmt.Sprintf("%s\n%s", denom, denom)},
+		{"Basket", fmt.Sprintf("%v\n%v", basket, basket)},
+		{"BasketComposition", "\n"},
+		{"Escrow", fmt.Sprintf("%s\n%s", denom, denom)},
+		{"VestingSchedule", fmt.Sprintf("%v\n%v", vestingSchedule, vestingSchedule)},
+		{"other", ""},
+	}
+	for i, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if i == len(tests)-1 {
+				require.Panics(t, func() { dec(kvPairs.Pairs[i], kvPairs.Pairs[i]) })
+				return
+			}
+			require.Equal(t, tt.expectedLog, dec(kvPairs.Pairs[i], kvPairs.Pairs[i]))
+		})
+	}
+}
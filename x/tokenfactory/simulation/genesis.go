@@ -2,6 +2,7 @@ package simulation
 
 import (
 	"math/rand"
+	"time"
 
 	appparams "github.com/cosmos/tokenfactory/app/params"
 	"github.com/cosmos/tokenfactory/x/tokenfactory/types"
@@ -10,6 +11,19 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+)
+
+// Simulation parameter constants
+const (
+	DenomCreationFee        = "denom_creation_fee"
+	DenomCreationGasConsume = "denom_creation_gas_consume"
+	WasmQueryGasPerItem     = "wasm_query_gas_per_item"
+	DenomHookGasLimit       = "denom_hook_gas_limit"
+	BeforeSendHookGasLimit  = "before_send_hook_gas_limit"
+	ReferenceIDRetention    = "reference_id_retention_blocks"
+	RedemptionTimeout       = "redemption_timeout"
+	FactoryDenoms           = "factory_denoms"
 )
 
 func RandDenomCreationFeeParam(r *rand.Rand) sdk.Coins {
@@ -17,11 +31,124 @@ func RandDenomCreationFeeParam(r *rand.Rand) sdk.Coins {
 	return sdk.NewCoins(sdk.NewCoin(appparams.BondDenom, sdkmath.NewInt(amount)))
 }
 
+// RandGasParam returns a random gas amount, up to maxGas.
+func RandGasParam(r *rand.Rand, maxGas uint64) uint64 {
+	return uint64(r.Int63n(int64(maxGas) + 1))
+}
+
+// RandReferenceIDRetentionParam returns a short random retention, so that reference ids are
+// pruned during the simulation, or zero so that they are never pruned.
+func RandReferenceIDRetentionParam(r *rand.Rand) uint64 {
+	return uint64(r.Intn(20))
+}
+
+// RandRedemptionTimeoutParam returns a short random redemption timeout, so that redemption requests
+// time out during the simulation, or zero so that they never time out.
+func RandRedemptionTimeoutParam(r *rand.Rand) time.Duration {
+	return time.Duration(r.Intn(120)) * time.Minute
+}
+
+// RandomizedParams returns random tokenfactory parameters.
+func RandomizedParams(r *rand.Rand) types.Params {
+	return types.Params{
+		DenomCreationFee:           RandDenomCreationFeeParam(r),
+		DenomCreationGasConsume:    RandGasParam(r, 4_000_000),
+		WasmQueryGasPerItem:        RandGasParam(r, 2_000),
+		DenomHookGasLimit:          RandGasParam(r, 1_000_000),
+		BeforeSendHookGasLimit:     RandGasParam(r, 1_000_000),
+		ReferenceIdRetentionBlocks: RandReferenceIDRetentionParam(r),
+		RedemptionTimeout:          RandRedemptionTimeoutParam(r),
+	}
+}
+
+// RandomizedFactoryDenoms returns up to one pre-existing denom per account. The admin of a denom
+// is its creator, another account, or no one when the admin has been renounced.
+func RandomizedFactoryDenoms(r *rand.Rand, accs []simtypes.Account) []types.GenesisDenom {
+	denoms := []types.GenesisDenom{}
+
+	for _, acc := range accs {
+		if r.Intn(3) != 0 {
+			continue
+		}
+
+		denom, err := types.GetTokenDenom(acc.Address.String(), simtypes.RandStringOfLength(r, 10))
+		if err != nil {
+			panic(err)
+		}
+
+		var admin string
+		switch r.Intn(4) {
+		case 0:
+			// renounced admin
+		case 1:
+			other, _ := simtypes.RandomAcc(r, accs)
+			admin = other.Address.String()
+		default:
+			admin = acc.Address.String()
+		}
+
+		denoms = append(denoms, types.GenesisDenom{
+			Denom:             denom,
+			AuthorityMetadata: types.DenomAuthorityMetadata{Admin: admin},
+		})
+	}
+
+	return denoms
+}
+
+// RandomizedGenState generates a random GenesisState for tokenfactory.
 func RandomizedGenState(simstate *module.SimulationState) {
-	tfGenesis := types.DefaultGenesis()
+	var (
+		denomCreationFee        sdk.Coins
+		denomCreationGasConsume uint64
+		wasmQueryGasPerItem     uint64
+		denomHookGasLimit       uint64
+		beforeSendHookGasLimit  uint64
+		referenceIDRetention    uint64
+		redemptionTimeout       time.Duration
+		factoryDenoms           []types.GenesisDenom
+	)
+
+	params := RandomizedParams(simstate.Rand)
+	simstate.AppParams.GetOrGenerate(DenomCreationFee, &denomCreationFee, simstate.Rand,
+		func(_ *rand.Rand) { denomCreationFee = params.DenomCreationFee },
+	)
+	simstate.AppParams.GetOrGenerate(DenomCreationGasConsume, &denomCreationGasConsume, simstate.Rand,
+		func(_ *rand.Rand) { denomCreationGasConsume = params.DenomCreationGasConsume },
+	)
+	simstate.AppParams.GetOrGenerate(WasmQueryGasPerItem, &wasmQueryGasPerItem, simstate.Rand,
+		func(_ *rand.Rand) { wasmQueryGasPerItem = params.WasmQueryGasPerItem },
+	)
+	simstate.AppParams.GetOrGenerate(DenomHookGasLimit, &denomHookGasLimit, simstate.Rand,
+		func(_ *rand.Rand) { denomHookGasLimit = params.DenomHookGasLimit },
+	)
+	simstate.AppParams.GetOrGenerate(BeforeSendHookGasLimit, &beforeSendHookGasLimit, simstate.Rand,
+		func(_ *rand.Rand) { beforeSendHookGasLimit = params.BeforeSendHookGasLimit },
+	)
+	simstate.AppParams.GetOrGenerate(ReferenceIDRetention, &referenceIDRetention, simstate.Rand,
+		func(_ *rand.Rand) { referenceIDRetention = params.ReferenceIdRetentionBlocks },
+	)
+	simstate.AppParams.GetOrGenerate(RedemptionTimeout, &redemptionTimeout, simstate.Rand,
+		func(_ *rand.Rand) { redemptionTimeout = params.RedemptionTimeout },
+	)
+	simstate.AppParams.GetOrGenerate(FactoryDenoms, &factoryDenoms, simstate.Rand,
+		func(r *rand.Rand) { factoryDenoms = RandomizedFactoryDenoms(r, simstate.Accounts) },
+	)
+
+	tfGenesis := &types.GenesisState{
+		Params: types.Params{
+			DenomCreationFee:           denomCreationFee,
+			DenomCreationGasConsume:    denomCreationGasConsume,
+			WasmQueryGasPerItem:        wasmQueryGasPerItem,
+			DenomHookGasLimit:          denomHookGasLimit,
+			BeforeSendHookGasLimit:     beforeSendHookGasLimit,
+			ReferenceIdRetentionBlocks: referenceIDRetention,
+			RedemptionTimeout:          redemptionTimeout,
+		},
+		FactoryDenoms: factoryDenoms,
+	}
 
-	_, err := simstate.Cdc.MarshalJSON(tfGenesis)
-	if err != nil {
+	if err := tfGenesis.Validate(); err != nil {
 		panic(err)
 	}
 
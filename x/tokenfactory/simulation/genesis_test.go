@@ -0,0 +1,57 @@
+package simulation_test
+
+import (
+	"encoding/json"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/tokenfactory/x/tokenfactory/simulation"
+	"github.com/cosmos/tokenfactory/x/tokenfactory/types"
+
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+)
+
+func TestRandomizedGenState(t *testing.T) {
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	r := rand.New(rand.NewSource(2))
+
+	simState := module.SimulationState{
+		AppParams:    make(simtypes.AppParams),
+		Cdc:          cdc,
+		Rand:         r,
+		NumBonded:    3,
+		BondDenom:    sdk.DefaultBondDenom,
+		Accounts:     simtypes.RandomAccounts(r, 30),
+		InitialStake: sdkmath.NewInt(1000),
+		GenState:     make(map[string]json.RawMessage),
+	}
+
+	simulation.RandomizedGenState(&simState)
+
+	var tfGenesis types.GenesisState
+	simState.Cdc.MustUnmarshalJSON(simState.GenState[types.ModuleName], &tfGenesis)
+	require.NoError(t, tfGenesis.Validate())
+	require.NotEqual(t, types.DefaultParams(), tfGenesis.Params)
+	require.NotEmpty(t, tfGenesis.FactoryDenoms)
+
+	renounced := 0
+	for _, denom := range tfGenesis.FactoryDenoms {
+		creator, _, err := types.DeconstructDenom(denom.Denom)
+		require.NoError(t, err)
+		_, found := simtypes.FindAccount(simState.Accounts, sdk.MustAccAddressFromBech32(creator))
+		require.True(t, found)
+
+		if denom.AuthorityMetadata.Admin == "" {
+			renounced++
+		}
+	}
+	require.NotZero(t, renounced)
+}
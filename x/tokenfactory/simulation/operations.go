@@ -1,8 +1,11 @@
 package simulation
 
 import (
+	"bytes"
 	"context"
+	"fmt"
 	"math/rand"
+	"time"
 
 	"github.com/cosmos/tokenfactory/x/tokenfactory/types"
 
@@ -11,6 +14,8 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
@@ -28,6 +33,22 @@ const (
 	OpWeightMsgChangeAdmin      = "op_weight_msg_tf_change_admin"
 	OpWeightMsgSetDenomMetadata = "op_weight_msg_tf_set_denom_metadata"
 	OpWeightMsgForceTransfer    = "op_weight_msg_tf_force_transfer"
+	OpWeightMsgRenounceAdmin    = "op_weight_msg_tf_renounce_admin"
+	OpWeightMsgMintNoAdmin      = "op_weight_msg_tf_mint_no_admin"
+	OpWeightMsgMultiMint        = "op_weight_msg_tf_multi_mint"
+	OpWeightMsgMultiBurn        = "op_weight_msg_tf_multi_burn"
+	OpWeightMsgClaimCampaign    = "op_weight_msg_tf_claim_campaign"
+	OpWeightMsgMintVoucher      = "op_weight_msg_tf_mint_voucher"
+	OpWeightMsgReserveAttestor  = "op_weight_msg_tf_reserve_attestor"
+	OpWeightMsgRedemption       = "op_weight_msg_tf_redemption"
+	OpWeightMsgCreateVault      = "op_weight_msg_tf_create_vault"
+	OpWeightMsgVaultDeposit     = "op_weight_msg_tf_vault_deposit"
+	OpWeightMsgVaultWithdraw    = "op_weight_msg_tf_vault_withdraw"
+	OpWeightMsgCreateBasket     = "op_weight_msg_tf_create_basket"
+	OpWeightMsgBasketMint       = "op_weight_msg_tf_basket_mint"
+	OpWeightMsgBasketRedeem     = "op_weight_msg_tf_basket_redeem"
+	OpWeightMsgMintVesting      = "op_weight_msg_tf_mint_vesting"
+	OpWeightMsgClawbackVesting  = "op_weight_msg_tf_clawback_vesting"
 
 	DefaultWeightMsgCreateDenom      int = 100
 	DefaultWeightMsgMint             int = 100
@@ -35,6 +56,22 @@ const (
 	DefaultWeightMsgChangeAdmin      int = 100
 	DefaultWeightMsgSetDenomMetadata int = 100
 	DefaultWeightMsgForceTransfer    int = 100
+	DefaultWeightMsgRenounceAdmin    int = 20
+	DefaultWeightMsgMintNoAdmin      int = 20
+	DefaultWeightMsgMultiMint        int = 50
+	DefaultWeightMsgMultiBurn        int = 50
+	DefaultWeightMsgClaimCampaign    int = 20
+	DefaultWeightMsgMintVoucher      int = 20
+	DefaultWeightMsgReserveAttestor  int = 10
+	DefaultWeightMsgRedemption       int = 20
+	DefaultWeightMsgCreateVault      int = 10
+	DefaultWeightMsgVaultDeposit     int = 50
+	DefaultWeightMsgVaultWithdraw    int = 50
+	DefaultWeightMsgCreateBasket     int = 10
+	DefaultWeightMsgBasketMint       int = 50
+	DefaultWeightMsgBasketRedeem     int = 50
+	DefaultWeightMsgMintVesting      int = 50
+	DefaultWeightMsgClawbackVesting  int = 20
 )
 
 type TokenfactoryKeeper interface {
@@ -42,12 +79,27 @@ type TokenfactoryKeeper interface {
 	GetAuthorityMetadata(ctx context.Context, denom string) (types.DenomAuthorityMetadata, error)
 	GetAllDenomsIterator(ctx context.Context) sdkstore.Iterator
 	GetDenomsFromCreator(ctx context.Context, creator string) []string
+	GetNextClaimCampaignID(ctx sdk.Context) uint64
+	GetClaimCampaign(ctx sdk.Context, campaignID uint64) (types.ClaimCampaign, bool)
+	GetClaimRecord(ctx sdk.Context, campaignID uint64, address string) (sdkmath.Int, bool)
+	GetVoucherSigner(ctx sdk.Context, denom string) []byte
+	IsVoucherNonceUsed(ctx sdk.Context, denom string, nonce uint64) bool
+	GetReserveAttestor(ctx sdk.Context, denom string) (types.ReserveAttestor, bool)
+	GetLatestReserveAttestation(ctx sdk.Context, denom string) (types.ReserveAttestation, bool)
+	CheckReserveCeiling(ctx sdk.Context, amount sdk.Coin) error
+	GetOpenRedemptions(ctx sdk.Context, denom string) []types.RedemptionRequest
+	GetVault(ctx sdk.Context, denom string) (types.Vault, bool)
+	GetAllVaults(ctx sdk.Context) []types.Vault
+	GetBasket(ctx sdk.Context, denom string) (types.Basket, bool)
+	GetAllBaskets(ctx sdk.Context) []types.Basket
+	GetAllVestingSchedules(ctx sdk.Context, denom string) []types.VestingSchedule
 }
 
 type BankKeeper interface {
 	simulation.BankKeeper
 	GetAllBalances(ctx context.Context, addr sdk.AccAddress) sdk.Coins
 	GetBalance(ctx context.Context, addr sdk.AccAddress, denom string) sdk.Coin
+	GetSupply(ctx context.Context, denom string) sdk.Coin
 }
 
 func WeightedOperations(
@@ -63,6 +115,22 @@ func WeightedOperations(
 		weightMsgChangeAdmin      int
 		weightMsgSetDenomMetadata int
 		weightMsgForceTransfer    int
+		weightMsgRenounceAdmin    int
+		weightMsgMintNoAdmin      int
+		weightMsgMultiMint        int
+		weightMsgMultiBurn        int
+		weightMsgClaimCampaign    int
+		weightMsgMintVoucher      int
+		weightMsgReserveAttestor  int
+		weightMsgRedemption       int
+		weightMsgCreateVault      int
+		weightMsgVaultDeposit     int
+		weightMsgVaultWithdraw    int
+		weightMsgCreateBasket     int
+		weightMsgBasketMint       int
+		weightMsgBasketRedeem     int
+		weightMsgMintVesting      int
+		weightMsgClawbackVesting  int
 	)
 
 	simstate.AppParams.GetOrGenerate(OpWeightMsgCreateDenom, &weightMsgCreateDenom, nil,
@@ -96,6 +164,86 @@ func WeightedOperations(
 			weightMsgForceTransfer = DefaultWeightMsgForceTransfer
 		},
 	)
+	simstate.AppParams.GetOrGenerate(OpWeightMsgRenounceAdmin, &weightMsgRenounceAdmin, nil,
+		func(_ *rand.Rand) {
+			weightMsgRenounceAdmin = DefaultWeightMsgRenounceAdmin
+		},
+	)
+	simstate.AppParams.GetOrGenerate(OpWeightMsgMintNoAdmin, &weightMsgMintNoAdmin, nil,
+		func(_ *rand.Rand) {
+			weightMsgMintNoAdmin = DefaultWeightMsgMintNoAdmin
+		},
+	)
+	simstate.AppParams.GetOrGenerate(OpWeightMsgMultiMint, &weightMsgMultiMint, nil,
+		func(_ *rand.Rand) {
+			weightMsgMultiMint = DefaultWeightMsgMultiMint
+		},
+	)
+	simstate.AppParams.GetOrGenerate(OpWeightMsgMultiBurn, &weightMsgMultiBurn, nil,
+		func(_ *rand.Rand) {
+			weightMsgMultiBurn = DefaultWeightMsgMultiBurn
+		},
+	)
+	simstate.AppParams.GetOrGenerate(OpWeightMsgClaimCampaign, &weightMsgClaimCampaign, nil,
+		func(_ *rand.Rand) {
+			weightMsgClaimCampaign = DefaultWeightMsgClaimCampaign
+		},
+	)
+	simstate.AppParams.GetOrGenerate(OpWeightMsgMintVoucher, &weightMsgMintVoucher, nil,
+		func(_ *rand.Rand) {
+			weightMsgMintVoucher = DefaultWeightMsgMintVoucher
+		},
+	)
+	simstate.AppParams.GetOrGenerate(OpWeightMsgReserveAttestor, &weightMsgReserveAttestor, nil,
+		func(_ *rand.Rand) {
+			weightMsgReserveAttestor = DefaultWeightMsgReserveAttestor
+		},
+	)
+	simstate.AppParams.GetOrGenerate(OpWeightMsgRedemption, &weightMsgRedemption, nil,
+		func(_ *rand.Rand) {
+			weightMsgRedemption = DefaultWeightMsgRedemption
+		},
+	)
+	simstate.AppParams.GetOrGenerate(OpWeightMsgCreateVault, &weightMsgCreateVault, nil,
+		func(_ *rand.Rand) {
+			weightMsgCreateVault = DefaultWeightMsgCreateVault
+		},
+	)
+	simstate.AppParams.GetOrGenerate(OpWeightMsgVaultDeposit, &weightMsgVaultDeposit, nil,
+		func(_ *rand.Rand) {
+			weightMsgVaultDeposit = DefaultWeightMsgVaultDeposit
+		},
+	)
+	simstate.AppParams.GetOrGenerate(OpWeightMsgVaultWithdraw, &weightMsgVaultWithdraw, nil,
+		func(_ *rand.Rand) {
+			weightMsgVaultWithdraw = DefaultWeightMsgVaultWithdraw
+		},
+	)
+	simstate.AppParams.GetOrGenerate(OpWeightMsgCreateBasket, &weightMsgCreateBasket, nil,
+		func(_ *rand.Rand) {
+			weightMsgCreateBasket = DefaultWeightMsgCreateBasket
+		},
+	)
+	simstate.AppParams.GetOrGenerate(OpWeightMsgBasketMint, &weightMsgBasketMint, nil,
+		func(_ *rand.Rand) {
+			weightMsgBasketMint = DefaultWeightMsgBasketMint
+		},
+	)
+	simstate.AppParams.GetOrGenerate(OpWeightMsgBasketRedeem, &weightMsgBasketRedeem, nil,
+		func(_ *rand.Rand) {
+			weightMsgBasketRedeem = DefaultWeightMsgBasketRedeem
+		},
+	)
+	simstate.AppParams.GetOrGenerate(OpWeightMsgMintVesting, &weightMsgMintVesting, nil,
+		func(_ *rand.Rand) {
+			weightMsgMintVesting = DefaultWeightMsgMintVesting
+		},
+	)
+	simstate.AppParams.GetOrGenerate(OpWeightMsgClawbackVesting, &weightMsgClawbackVesting, nil,
+		func(_ *rand.Rand) {
+			weightMsgClawbackVesting = DefaultWeightMsgClawbackVesting
+		},
+	)
 
 	return simulation.WeightedOperations{
 		simulation.NewWeightedOperation(
@@ -147,13 +295,176 @@ func WeightedOperations(
 				DefaultSimulationDenomSelector,
 			),
 		),
+		simulation.NewWeightedOperation(
+			weightMsgRenounceAdmin,
+			SimulateMsgRenounceAdmin(
+				simstate.TxConfig,
+				tfKeeper,
+				ak,
+				bk,
+				DefaultSimulationDenomSelector,
+			),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgMintNoAdmin,
+			SimulateMsgMintNoAdmin(
+				simstate.TxConfig,
+				tfKeeper,
+				ak,
+				bk,
+				DefaultSimulationDenomSelector,
+			),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgMultiMint,
+			SimulateMsgMultiMint(
+				simstate.TxConfig,
+				tfKeeper,
+				ak,
+				bk,
+				DefaultSimulationDenomSelector,
+			),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgMultiBurn,
+			SimulateMsgMultiBurn(
+				simstate.TxConfig,
+				tfKeeper,
+				ak,
+				bk,
+				DefaultSimulationDenomSelector,
+			),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgClaimCampaign,
+			SimulateMsgCreateClaimCampaign(
+				simstate.TxConfig,
+				tfKeeper,
+				ak,
+				bk,
+				DefaultSimulationDenomSelector,
+			),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgMintVoucher,
+			SimulateMsgSetVoucherSigner(
+				simstate.TxConfig,
+				tfKeeper,
+				ak,
+				bk,
+				DefaultSimulationDenomSelector,
+			),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgReserveAttestor,
+			SimulateMsgSetReserveAttestor(
+				simstate.TxConfig,
+				tfKeeper,
+				ak,
+				bk,
+				DefaultSimulationDenomSelector,
+			),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgRedemption,
+			SimulateMsgRequestRedemption(
+				simstate.TxConfig,
+				tfKeeper,
+				ak,
+				bk,
+				DefaultSimulationDenomSelector,
+			),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgCreateVault,
+			SimulateMsgCreateVault(
+				simstate.TxConfig,
+				tfKeeper,
+				ak,
+				bk,
+			),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgVaultDeposit,
+			SimulateMsgVaultDeposit(
+				simstate.TxConfig,
+				tfKeeper,
+				ak,
+				bk,
+			),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgVaultWithdraw,
+			SimulateMsgVaultWithdraw(
+				simstate.TxConfig,
+				tfKeeper,
+				ak,
+				bk,
+			),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgCreateBasket,
+			SimulateMsgCreateBasket(
+				simstate.TxConfig,
+				tfKeeper,
+				ak,
+				bk,
+			),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgBasketMint,
+			SimulateMsgBasketMint(
+				simstate.TxConfig,
+				tfKeeper,
+				ak,
+				bk,
+			),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgBasketRedeem,
+			SimulateMsgBasketRedeem(
+				simstate.TxConfig,
+				tfKeeper,
+				ak,
+				bk,
+			),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgMintVesting,
+			SimulateMsgMintVesting(
+				simstate.TxConfig,
+				tfKeeper,
+				ak,
+				bk,
+				DefaultSimulationDenomSelector,
+			),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgClawbackVesting,
+			SimulateMsgClawbackVesting(
+				simstate.TxConfig,
+				tfKeeper,
+				ak,
+				bk,
+				DefaultSimulationDenomSelector,
+			),
+		),
 	}
 }
 
 type DenomSelector = func(*rand.Rand, sdk.Context, TokenfactoryKeeper, string) (string, bool)
 
+// DefaultSimulationDenomSelector returns a random denom of a creator. Vault and basket denoms
+// are not selected, as they are only minted and burned through their vault or basket.
 func DefaultSimulationDenomSelector(r *rand.Rand, ctx sdk.Context, tfKeeper TokenfactoryKeeper, creator string) (string, bool) {
-	denoms := tfKeeper.GetDenomsFromCreator(ctx, creator)
+	var denoms []string
+	for _, denom := range tfKeeper.GetDenomsFromCreator(ctx, creator) {
+		_, isVault := tfKeeper.GetVault(ctx, denom)
+		_, isBasket := tfKeeper.GetBasket(ctx, denom)
+		if !isVault && !isBasket {
+			denoms = append(denoms, denom)
+		}
+	}
 	if len(denoms) == 0 {
 		return "", false
 	}
@@ -162,6 +473,16 @@ func DefaultSimulationDenomSelector(r *rand.Rand, ctx sdk.Context, tfKeeper Toke
 	return denoms[randPos], true
 }
 
+// FindAdminAccount returns the simulation account of the admin of a denom. It is not found when
+// the admin is not a simulation account, or has been renounced.
+func FindAdminAccount(accs []simtypes.Account, authData types.DenomAuthorityMetadata) (simtypes.Account, bool) {
+	if authData.Admin == "" {
+		return simtypes.Account{}, false
+	}
+
+	return simtypes.FindAccount(accs, sdk.MustAccAddressFromBech32(authData.Admin))
+}
+
 func SimulateMsgSetDenomMetadata(
 	txGen client.TxConfig,
 	tfKeeper TokenfactoryKeeper,
@@ -192,7 +513,7 @@ func SimulateMsgSetDenomMetadata(
 		if err != nil {
 			return simtypes.NoOpMsg(types.ModuleName, msgType, "err authority metadata"), nil, err
 		}
-		adminAccount, found := simtypes.FindAccount(accs, sdk.MustAccAddressFromBech32(authData.Admin))
+		adminAccount, found := FindAdminAccount(accs, authData)
 		if !found {
 			return simtypes.NoOpMsg(types.ModuleName, msgType, "admin account not found"), nil, nil
 		}
@@ -248,7 +569,7 @@ func SimulateMsgChangeAdmin(
 		if err != nil {
 			return simtypes.NoOpMsg(types.ModuleName, msgType, "err authority metadata"), nil, err
 		}
-		curAdminAccount, found := simtypes.FindAccount(accs, sdk.MustAccAddressFromBech32(authData.Admin))
+		curAdminAccount, found := FindAdminAccount(accs, authData)
 		if !found {
 			return simtypes.NoOpMsg(types.ModuleName, msgType, "admin account not found"), nil, nil
 		}
@@ -301,7 +622,7 @@ func SimulateMsgBurn(
 		if err != nil {
 			return simtypes.NoOpMsg(types.ModuleName, msgType, "err authority metadata"), nil, err
 		}
-		adminAccount, found := simtypes.FindAccount(accs, sdk.MustAccAddressFromBech32(authData.Admin))
+		adminAccount, found := FindAdminAccount(accs, authData)
 		if !found {
 			return simtypes.NoOpMsg(types.ModuleName, msgType, "admin account not found"), nil, nil
 		}
@@ -358,13 +679,16 @@ func SimulateMsgMint(
 		if err != nil {
 			return simtypes.NoOpMsg(types.ModuleName, msgType, "err authority metadata"), nil, err
 		}
-		adminAccount, found := simtypes.FindAccount(accs, sdk.MustAccAddressFromBech32(authData.Admin))
+		adminAccount, found := FindAdminAccount(accs, authData)
 		if !found {
 			return simtypes.NoOpMsg(types.ModuleName, msgType, "admin account not found"), nil, nil
 		}
 
 		// Rand mint amount
 		mintAmount, _ := simtypes.RandPositiveInt(r, sdkmath.NewIntFromUint64(100_000_000))
+		if err := tfKeeper.CheckReserveCeiling(ctx, sdk.NewCoin(denom, mintAmount)); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "mint above the attested reserve"), nil, nil
+		}
 
 		// Create msg mint
 		msg := types.MsgMint{
@@ -377,8 +701,14 @@ func SimulateMsgMint(
 	}
 }
 
-// Simulate msg create denom
-func SimulateMsgCreateDenom(txGen client.TxConfig, tfKeeper TokenfactoryKeeper, ak types.AccountKeeper, bk BankKeeper) simtypes.Operation {
+// Simulate msg multi mint denom to random accounts
+func SimulateMsgMultiMint(
+	txGen client.TxConfig,
+	tfKeeper TokenfactoryKeeper,
+	ak types.AccountKeeper,
+	bk BankKeeper,
+	denomSelector DenomSelector,
+) simtypes.Operation {
 	return func(
 		r *rand.Rand,
 		app *baseapp.BaseApp,
@@ -386,55 +716,1210 @@ func SimulateMsgCreateDenom(txGen client.TxConfig, tfKeeper TokenfactoryKeeper,
 		accs []simtypes.Account,
 		_ string,
 	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
-		msgType := sdk.MsgTypeURL(&types.MsgCreateDenom{})
-		// Get sims account
-		simAccount, _ := simtypes.RandomAcc(r, accs)
+		msgType := sdk.MsgTypeURL(&types.MsgMultiMint{})
 
-		// Check if sims account enough create fee
-		createFee := tfKeeper.GetParams(ctx).DenomCreationFee
-		balances := bk.GetAllBalances(ctx, simAccount.Address)
-		_, hasNeg := balances.SafeSub(createFee[0])
-		if hasNeg {
-			return simtypes.NoOpMsg(types.ModuleName, msgType, "Creator not enough creation fee"), nil, nil
+		// Get create denom account
+		createdDenomAccount, _ := simtypes.RandomAcc(r, accs)
+
+		// Get demon
+		denom, hasDenom := denomSelector(r, ctx, tfKeeper, createdDenomAccount.Address.String())
+		if !hasDenom {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "sim account have no denom created"), nil, nil
 		}
 
-		// Create msg create denom
-		msg := types.MsgCreateDenom{
-			Sender:   simAccount.Address.String(),
-			Subdenom: simtypes.RandStringOfLength(r, 10),
+		// Get admin of the denom
+		authData, err := tfKeeper.GetAuthorityMetadata(ctx, denom)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "err authority metadata"), nil, err
+		}
+		adminAccount, found := FindAdminAccount(accs, authData)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "admin account not found"), nil, nil
 		}
 
-		txCtx := BuildOperationInput(r, app, ctx, &msg, simAccount, ak, bk, createFee, txGen)
+		// Rand recipients, an address can only be listed once
+		var outputs []types.MultiMintOutput
+		total := sdkmath.ZeroInt()
+		for _, i := range r.Perm(len(accs))[:1+r.Intn(min(len(accs), 5))] {
+			amount, _ := simtypes.RandPositiveInt(r, sdkmath.NewIntFromUint64(100_000_000))
+			outputs = append(outputs, types.MultiMintOutput{Address: accs[i].Address.String(), Amount: amount})
+			total = total.Add(amount)
+		}
+		if err := tfKeeper.CheckReserveCeiling(ctx, sdk.NewCoin(denom, total)); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "mint above the attested reserve"), nil, nil
+		}
+
+		msg := types.MsgMultiMint{
+			Sender:  adminAccount.Address.String(),
+			Denom:   denom,
+			Outputs: outputs,
+		}
+
+		txCtx := BuildOperationInput(r, app, ctx, &msg, adminAccount, ak, bk, nil, txGen)
 		return simulation.GenAndDeliverTxWithRandFees(txCtx)
 	}
 }
 
-// BuildOperationInput helper to build object
-func BuildOperationInput(
-	r *rand.Rand,
-	app *baseapp.BaseApp,
-	ctx sdk.Context,
-	msg interface {
-		sdk.Msg
-		Type() string
-	},
-	simAccount simtypes.Account,
+// Simulate msg create claim campaign for random accounts, the accounts claim in the next blocks
+func SimulateMsgCreateClaimCampaign(
+	txGen client.TxConfig,
+	tfKeeper TokenfactoryKeeper,
 	ak types.AccountKeeper,
 	bk BankKeeper,
-	deposit sdk.Coins,
-	txGen client.TxConfig,
-) simulation.OperationInput {
-	return simulation.OperationInput{
-		R:               r,
-		App:             app,
-		TxGen:           txGen,
-		Cdc:             nil,
-		Msg:             msg,
-		Context:         ctx,
-		SimAccount:      simAccount,
-		AccountKeeper:   ak,
-		Bankkeeper:      bk,
-		ModuleName:      types.ModuleName,
-		CoinsSpentInMsg: deposit,
+	denomSelector DenomSelector,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand,
+		app *baseapp.BaseApp,
+		ctx sdk.Context,
+		accs []simtypes.Account,
+		_ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgCreateClaimCampaign{})
+
+		// Get create denom account
+		createdDenomAccount, _ := simtypes.RandomAcc(r, accs)
+
+		// Get demon
+		denom, hasDenom := denomSelector(r, ctx, tfKeeper, createdDenomAccount.Address.String())
+		if !hasDenom {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "sim account have no denom created"), nil, nil
+		}
+
+		// Get admin of the denom
+		authData, err := tfKeeper.GetAuthorityMetadata(ctx, denom)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "err authority metadata"), nil, err
+		}
+		adminAccount, found := FindAdminAccount(accs, authData)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "admin account not found"), nil, nil
+		}
+
+		// Rand claimers, an address can only be listed once
+		var claimers []simtypes.Account
+		var amounts []sdkmath.Int
+		var leaves [][]byte
+		totalCap := sdkmath.ZeroInt()
+		for _, i := range r.Perm(len(accs))[:1+r.Intn(min(len(accs), 5))] {
+			amount, _ := simtypes.RandPositiveInt(r, sdkmath.NewIntFromUint64(100_000_000))
+			claimers = append(claimers, accs[i])
+			amounts = append(amounts, amount)
+			leaves = append(leaves, types.ClaimLeafHash(accs[i].Address.String(), amount))
+			totalCap = totalCap.Add(amount)
+		}
+		root, proofs := types.BuildClaimMerkleTree(leaves)
+
+		msg := types.MsgCreateClaimCampaign{
+			Sender:     adminAccount.Address.String(),
+			Denom:      denom,
+			MerkleRoot: root,
+			TotalCap:   totalCap,
+		}
+
+		// the campaign gets the next id when the tx is delivered
+		campaignID := tfKeeper.GetNextClaimCampaignID(ctx)
+
+		txCtx := BuildOperationInput(r, app, ctx, &msg, adminAccount, ak, bk, nil, txGen)
+		opMsg, _, err := simulation.GenAndDeliverTxWithRandFees(txCtx)
+		if err != nil || !opMsg.OK {
+			return opMsg, nil, err
+		}
+
+		futureOps := make([]simtypes.FutureOperation, 0, len(claimers))
+		for i, claimer := range claimers {
+			futureOps = append(futureOps, simtypes.FutureOperation{
+				BlockHeight: int(ctx.BlockHeight()) + 1 + r.Intn(5),
+				Op:          SimulateMsgClaim(txGen, tfKeeper, ak, bk, campaignID, claimer, amounts[i], proofs[i]),
+			})
+		}
+
+		return opMsg, futureOps, nil
+	}
+}
+
+// Simulate msg claim of an account in a claim campaign
+func SimulateMsgClaim(
+	txGen client.TxConfig,
+	tfKeeper TokenfactoryKeeper,
+	ak types.AccountKeeper,
+	bk BankKeeper,
+	campaignID uint64,
+	claimer simtypes.Account,
+	amount sdkmath.Int,
+	proof [][]byte,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand,
+		app *baseapp.BaseApp,
+		ctx sdk.Context,
+		_ []simtypes.Account,
+		_ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgClaim{})
+
+		campaign, found := tfKeeper.GetClaimCampaign(ctx, campaignID)
+		if !found || campaign.Status(ctx.BlockTime()) != types.CLAIM_CAMPAIGN_STATUS_ACTIVE {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "claim campaign not active"), nil, nil
+		}
+		if _, claimed := tfKeeper.GetClaimRecord(ctx, campaignID, claimer.Address.String()); claimed {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "already claimed"), nil, nil
+		}
+		if err := tfKeeper.CheckReserveCeiling(ctx, sdk.NewCoin(campaign.Denom, amount)); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "mint above the attested reserve"), nil, nil
+		}
+
+		msg := types.MsgClaim{
+			Sender:     claimer.Address.String(),
+			CampaignId: campaignID,
+			Amount:     amount,
+			Proof:      proof,
+		}
+
+		txCtx := BuildOperationInput(r, app, ctx, &msg, claimer, ak, bk, nil, txGen)
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
+
+// Simulate msg multi burn denom from the random accounts holding it
+func SimulateMsgMultiBurn(
+	txGen client.TxConfig,
+	tfKeeper TokenfactoryKeeper,
+	ak types.AccountKeeper,
+	bk BankKeeper,
+	denomSelector DenomSelector,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand,
+		app *baseapp.BaseApp,
+		ctx sdk.Context,
+		accs []simtypes.Account,
+		_ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgMultiBurn{})
+
+		// Get create denom account
+		createdDenomAccount, _ := simtypes.RandomAcc(r, accs)
+
+		// Get demon
+		denom, hasDenom := denomSelector(r, ctx, tfKeeper, createdDenomAccount.Address.String())
+		if !hasDenom {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "sim account have no denom created"), nil, nil
+		}
+
+		// Get admin of the denom
+		authData, err := tfKeeper.GetAuthorityMetadata(ctx, denom)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "err authority metadata"), nil, err
+		}
+		adminAccount, found := FindAdminAccount(accs, authData)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "admin account not found"), nil, nil
+		}
+
+		// Rand burn amounts from the accounts with a balance, the admin burned amount cannot be
+		// used for the fees
+		var inputs []types.MultiBurnInput
+		var adminBurnAmount sdk.Coins
+		for _, i := range r.Perm(len(accs))[:min(len(accs), 5)] {
+			balance := bk.GetBalance(ctx, accs[i].Address, denom)
+			if !balance.Amount.IsPositive() {
+				continue
+			}
+
+			amount, _ := simtypes.RandPositiveInt(r, balance.Amount)
+			inputs = append(inputs, types.MultiBurnInput{Address: accs[i].Address.String(), Amount: amount})
+			if accs[i].Address.Equals(adminAccount.Address) {
+				adminBurnAmount = sdk.NewCoins(sdk.NewCoin(denom, amount))
+			}
+		}
+		if len(inputs) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "sim accounts have no balance"), nil, nil
+		}
+
+		msg := types.MsgMultiBurn{
+			Sender: adminAccount.Address.String(),
+			Denom:  denom,
+			Inputs: inputs,
+		}
+
+		txCtx := BuildOperationInput(r, app, ctx, &msg, adminAccount, ak, bk, adminBurnAmount, txGen)
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
+
+// Simulate msg change admin renouncing the admin of a denom, which must be rejected as only the
+// wasm bindings can renounce an admin
+func SimulateMsgRenounceAdmin(
+	txGen client.TxConfig,
+	tfKeeper TokenfactoryKeeper,
+	ak types.AccountKeeper,
+	bk BankKeeper,
+	denomSelector DenomSelector,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand,
+		app *baseapp.BaseApp,
+		ctx sdk.Context,
+		accs []simtypes.Account,
+		_ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgChangeAdmin{})
+
+		// Get create denom account
+		createdDenomAccount, _ := simtypes.RandomAcc(r, accs)
+
+		// Get demon
+		denom, hasDenom := denomSelector(r, ctx, tfKeeper, createdDenomAccount.Address.String())
+		if !hasDenom {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "sim account have no denom created"), nil, nil
+		}
+
+		// Get admin of the denom
+		authData, err := tfKeeper.GetAuthorityMetadata(ctx, denom)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "err authority metadata"), nil, err
+		}
+		adminAccount, found := FindAdminAccount(accs, authData)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "admin account not found"), nil, nil
+		}
+
+		// Create msg with an empty new admin
+		msg := types.MsgChangeAdmin{
+			Sender:   adminAccount.Address.String(),
+			Denom:    denom,
+			NewAdmin: "",
+		}
+
+		txCtx := BuildOperationInput(r, app, ctx, &msg, adminAccount, ak, bk, nil, txGen)
+		return GenAndDeliverTxWithRandFeesExpectingFailure(txCtx)
+	}
+}
+
+// Simulate msg mint by the creator of a denom without admin, which must be rejected
+func SimulateMsgMintNoAdmin(
+	txGen client.TxConfig,
+	tfKeeper TokenfactoryKeeper,
+	ak types.AccountKeeper,
+	bk BankKeeper,
+	denomSelector DenomSelector,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand,
+		app *baseapp.BaseApp,
+		ctx sdk.Context,
+		accs []simtypes.Account,
+		_ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgMint{})
+
+		// Get create denom account
+		createdDenomAccount, _ := simtypes.RandomAcc(r, accs)
+
+		// Get demon
+		denom, hasDenom := denomSelector(r, ctx, tfKeeper, createdDenomAccount.Address.String())
+		if !hasDenom {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "sim account have no denom created"), nil, nil
+		}
+
+		// Check the admin of the denom has been renounced
+		authData, err := tfKeeper.GetAuthorityMetadata(ctx, denom)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "err authority metadata"), nil, err
+		}
+		if authData.Admin != "" {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "denom has an admin"), nil, nil
+		}
+
+		// Rand mint amount
+		mintAmount, _ := simtypes.RandPositiveInt(r, sdkmath.NewIntFromUint64(100_000_000))
+
+		// Create msg mint from the creator of the denom
+		msg := types.MsgMint{
+			Sender: createdDenomAccount.Address.String(),
+			Amount: sdk.NewCoin(denom, mintAmount),
+		}
+
+		txCtx := BuildOperationInput(r, app, ctx, &msg, createdDenomAccount, ak, bk, nil, txGen)
+		return GenAndDeliverTxWithRandFeesExpectingFailure(txCtx)
+	}
+}
+
+// Simulate msg create denom
+func SimulateMsgCreateDenom(txGen client.TxConfig, tfKeeper TokenfactoryKeeper, ak types.AccountKeeper, bk BankKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand,
+		app *baseapp.BaseApp,
+		ctx sdk.Context,
+		accs []simtypes.Account,
+		_ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgCreateDenom{})
+		// Get sims account
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+
+		// Check if sims account enough create fee
+		createFee := tfKeeper.GetParams(ctx).DenomCreationFee
+		balances := bk.GetAllBalances(ctx, simAccount.Address)
+		if !balances.IsAllGTE(createFee) {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "Creator not enough creation fee"), nil, nil
+		}
+
+		// Create msg create denom
+		msg := types.MsgCreateDenom{
+			Sender:   simAccount.Address.String(),
+			Subdenom: simtypes.RandStringOfLength(r, 10),
+		}
+
+		txCtx := BuildOperationInput(r, app, ctx, &msg, simAccount, ak, bk, createFee, txGen)
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
+
+// BuildOperationInput helper to build object
+func BuildOperationInput(
+	r *rand.Rand,
+	app *baseapp.BaseApp,
+	ctx sdk.Context,
+	msg interface {
+		sdk.Msg
+		Type() string
+	},
+	simAccount simtypes.Account,
+	ak types.AccountKeeper,
+	bk BankKeeper,
+	deposit sdk.Coins,
+	txGen client.TxConfig,
+) simulation.OperationInput {
+	return simulation.OperationInput{
+		R:               r,
+		App:             app,
+		TxGen:           txGen,
+		Cdc:             nil,
+		Msg:             msg,
+		Context:         ctx,
+		SimAccount:      simAccount,
+		AccountKeeper:   ak,
+		Bankkeeper:      bk,
+		ModuleName:      types.ModuleName,
+		CoinsSpentInMsg: deposit,
+	}
+}
+
+// GenAndDeliverTxWithRandFeesExpectingFailure generates a transaction with a random fee and
+// delivers it, the operation fails when the transaction succeeds.
+func GenAndDeliverTxWithRandFeesExpectingFailure(txCtx simulation.OperationInput) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+	msgType := sdk.MsgTypeURL(txCtx.Msg)
+
+	account := txCtx.AccountKeeper.GetAccount(txCtx.Context, txCtx.SimAccount.Address)
+	spendable := txCtx.Bankkeeper.SpendableCoins(txCtx.Context, account.GetAddress())
+
+	fees, err := simtypes.RandomFees(txCtx.R, txCtx.Context, spendable)
+	if err != nil {
+		return simtypes.NoOpMsg(txCtx.ModuleName, msgType, "unable to generate fees"), nil, err
+	}
+
+	tx, err := simtestutil.GenSignedMockTx(
+		txCtx.R,
+		txCtx.TxGen,
+		[]sdk.Msg{txCtx.Msg},
+		fees,
+		simtestutil.DefaultGenTxGas,
+		txCtx.Context.ChainID(),
+		[]uint64{account.GetAccountNumber()},
+		[]uint64{account.GetSequence()},
+		txCtx.SimAccount.PrivKey,
+	)
+	if err != nil {
+		return simtypes.NoOpMsg(txCtx.ModuleName, msgType, "unable to generate mock tx"), nil, err
+	}
+
+	if _, _, err := txCtx.App.SimDeliver(txCtx.TxGen.TxEncoder(), tx); err == nil {
+		return simtypes.NoOpMsg(txCtx.ModuleName, msgType, "tx expected to fail"), nil,
+			fmt.Errorf("%s was expected to fail", msgType)
+	}
+
+	return simtypes.NewOperationMsg(txCtx.Msg, false, "tx rejected as expected"), nil, nil
+}
+
+// Simulate msg set voucher signer with the key of the denom admin, vouchers signed by the admin
+// are redeemed in the next blocks
+func SimulateMsgSetVoucherSigner(
+	txGen client.TxConfig,
+	tfKeeper TokenfactoryKeeper,
+	ak types.AccountKeeper,
+	bk BankKeeper,
+	denomSelector DenomSelector,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand,
+		app *baseapp.BaseApp,
+		ctx sdk.Context,
+		accs []simtypes.Account,
+		_ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgSetVoucherSigner{})
+
+		// Get create denom account
+		createdDenomAccount, _ := simtypes.RandomAcc(r, accs)
+
+		// Get demon
+		denom, hasDenom := denomSelector(r, ctx, tfKeeper, createdDenomAccount.Address.String())
+		if !hasDenom {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "sim account have no denom created"), nil, nil
+		}
+
+		// Get admin of the denom
+		authData, err := tfKeeper.GetAuthorityMetadata(ctx, denom)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "err authority metadata"), nil, err
+		}
+		adminAccount, found := FindAdminAccount(accs, authData)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "admin account not found"), nil, nil
+		}
+
+		signer, ok := adminAccount.PrivKey.(*secp256k1.PrivKey)
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "admin key is not secp256k1"), nil, nil
+		}
+
+		msg := types.MsgSetVoucherSigner{
+			Sender: adminAccount.Address.String(),
+			Denom:  denom,
+			PubKey: signer.PubKey().Bytes(),
+		}
+
+		txCtx := BuildOperationInput(r, app, ctx, &msg, adminAccount, ak, bk, nil, txGen)
+		opMsg, _, err := simulation.GenAndDeliverTxWithRandFees(txCtx)
+		if err != nil || !opMsg.OK {
+			return opMsg, nil, err
+		}
+
+		futureOps := []simtypes.FutureOperation{{
+			BlockHeight: int(ctx.BlockHeight()) + 1 + r.Intn(5),
+			Op:          SimulateMsgRedeemMintVoucher(txGen, tfKeeper, ak, bk, denom, signer),
+		}}
+		return opMsg, futureOps, nil
+	}
+}
+
+// Simulate msg redeem mint voucher of a voucher signed by the voucher signer of a denom, for a
+// random recipient and redeemer
+func SimulateMsgRedeemMintVoucher(
+	txGen client.TxConfig,
+	tfKeeper TokenfactoryKeeper,
+	ak types.AccountKeeper,
+	bk BankKeeper,
+	denom string,
+	signer *secp256k1.PrivKey,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand,
+		app *baseapp.BaseApp,
+		ctx sdk.Context,
+		accs []simtypes.Account,
+		_ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgRedeemMintVoucher{})
+
+		authData, err := tfKeeper.GetAuthorityMetadata(ctx, denom)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "err authority metadata"), nil, err
+		}
+		if authData.GetAdmin() == "" {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "denom has no admin"), nil, nil
+		}
+		if !bytes.Equal(tfKeeper.GetVoucherSigner(ctx, denom), signer.PubKey().Bytes()) {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "voucher signer has changed"), nil, nil
+		}
+
+		voucher := types.MintVoucher{
+			Denom:  denom,
+			Nonce:  r.Uint64(),
+			Expiry: ctx.BlockTime().Add(time.Hour),
+		}
+		if tfKeeper.IsVoucherNonceUsed(ctx, denom, voucher.Nonce) {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "voucher nonce already used"), nil, nil
+		}
+		voucher.Amount, _ = simtypes.RandPositiveInt(r, sdkmath.NewIntFromUint64(100_000_000))
+		if err := tfKeeper.CheckReserveCeiling(ctx, sdk.NewCoin(denom, voucher.Amount)); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "mint above the attested reserve"), nil, nil
+		}
+		recipient, _ := simtypes.RandomAcc(r, accs)
+		voucher.Recipient = recipient.Address.String()
+
+		signBytes, err := types.VoucherSignBytes(ctx.ChainID(), voucher)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "err voucher sign bytes"), nil, err
+		}
+		signature, err := signer.Sign(signBytes)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "err voucher signature"), nil, err
+		}
+
+		redeemer, _ := simtypes.RandomAcc(r, accs)
+		msg := types.MsgRedeemMintVoucher{
+			Sender:    redeemer.Address.String(),
+			Voucher:   voucher,
+			Signature: signature,
+		}
+
+		txCtx := BuildOperationInput(r, app, ctx, &msg, redeemer, ak, bk, nil, txGen)
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
+
+// Simulate msg set reserve attestor of a denom to a random account, which attests the reserve of
+// the denom in the next blocks
+func SimulateMsgSetReserveAttestor(
+	txGen client.TxConfig,
+	tfKeeper TokenfactoryKeeper,
+	ak types.AccountKeeper,
+	bk BankKeeper,
+	denomSelector DenomSelector,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand,
+		app *baseapp.BaseApp,
+		ctx sdk.Context,
+		accs []simtypes.Account,
+		_ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgSetReserveAttestor{})
+
+		// Get create denom account
+		createdDenomAccount, _ := simtypes.RandomAcc(r, accs)
+
+		// Get demon
+		denom, hasDenom := denomSelector(r, ctx, tfKeeper, createdDenomAccount.Address.String())
+		if !hasDenom {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "sim account have no denom created"), nil, nil
+		}
+
+		// Get admin of the denom
+		authData, err := tfKeeper.GetAuthorityMetadata(ctx, denom)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "err authority metadata"), nil, err
+		}
+		adminAccount, found := FindAdminAccount(accs, authData)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "admin account not found"), nil, nil
+		}
+
+		attestor, _ := simtypes.RandomAcc(r, accs)
+		msg := types.MsgSetReserveAttestor{
+			Sender:       adminAccount.Address.String(),
+			Denom:        denom,
+			Attestor:     attestor.Address.String(),
+			MaxStaleness: time.Duration(1+r.Intn(48)) * time.Hour,
+		}
+
+		txCtx := BuildOperationInput(r, app, ctx, &msg, adminAccount, ak, bk, nil, txGen)
+		opMsg, _, err := simulation.GenAndDeliverTxWithRandFees(txCtx)
+		if err != nil || !opMsg.OK {
+			return opMsg, nil, err
+		}
+
+		futureOps := []simtypes.FutureOperation{{
+			BlockHeight: int(ctx.BlockHeight()) + 1 + r.Intn(5),
+			Op:          SimulateMsgAttestReserve(txGen, tfKeeper, ak, bk, denom, attestor),
+		}}
+		return opMsg, futureOps, nil
+	}
+}
+
+// Simulate msg attest reserve of a denom by its reserve attestor, with a random headroom above
+// the supply. The attestor attests again in the next blocks, until it is replaced.
+func SimulateMsgAttestReserve(
+	txGen client.TxConfig,
+	tfKeeper TokenfactoryKeeper,
+	ak types.AccountKeeper,
+	bk BankKeeper,
+	denom string,
+	attestor simtypes.Account,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand,
+		app *baseapp.BaseApp,
+		ctx sdk.Context,
+		_ []simtypes.Account,
+		_ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgAttestReserve{})
+
+		reserveAttestor, found := tfKeeper.GetReserveAttestor(ctx, denom)
+		if !found || reserveAttestor.Attestor != attestor.Address.String() {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "reserve attestor has changed"), nil, nil
+		}
+		if latest, found := tfKeeper.GetLatestReserveAttestation(ctx, denom); found && ctx.BlockTime().Before(latest.Timestamp) {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "block time before the latest attestation"), nil, nil
+		}
+
+		headroom, _ := simtypes.RandPositiveInt(r, sdkmath.NewIntFromUint64(1_000_000_000))
+		reportHash := make([]byte, types.ReportHashLength)
+		r.Read(reportHash)
+
+		msg := types.MsgAttestReserve{
+			Sender:     attestor.Address.String(),
+			Denom:      denom,
+			Amount:     bk.GetSupply(ctx, denom).Amount.Add(headroom),
+			Timestamp:  ctx.BlockTime(),
+			ReportHash: reportHash,
+		}
+
+		txCtx := BuildOperationInput(r, app, ctx, &msg, attestor, ak, bk, nil, txGen)
+		opMsg, _, err := simulation.GenAndDeliverTxWithRandFees(txCtx)
+		if err != nil || !opMsg.OK {
+			return opMsg, nil, err
+		}
+
+		futureOps := []simtypes.FutureOperation{{
+			BlockHeight: int(ctx.BlockHeight()) + 1 + r.Intn(5),
+			Op:          SimulateMsgAttestReserve(txGen, tfKeeper, ak, bk, denom, attestor),
+		}}
+		return opMsg, futureOps, nil
+	}
+}
+
+// Simulate msg request redemption of a random amount held by a random holder of a denom. The
+// admin of the denom fulfills or rejects a request of the denom in the next blocks.
+func SimulateMsgRequestRedemption(
+	txGen client.TxConfig,
+	tfKeeper TokenfactoryKeeper,
+	ak types.AccountKeeper,
+	bk BankKeeper,
+	denomSelector DenomSelector,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand,
+		app *baseapp.BaseApp,
+		ctx sdk.Context,
+		accs []simtypes.Account,
+		_ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgRequestRedemption{})
+
+		// Get create denom account
+		createdDenomAccount, _ := simtypes.RandomAcc(r, accs)
+
+		// Get demon
+		denom, hasDenom := denomSelector(r, ctx, tfKeeper, createdDenomAccount.Address.String())
+		if !hasDenom {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "sim account have no denom created"), nil, nil
+		}
+
+		authData, err := tfKeeper.GetAuthorityMetadata(ctx, denom)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "err authority metadata"), nil, err
+		}
+		if authData.Admin == "" {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "denom has no admin"), nil, nil
+		}
+
+		// Get a holder of the denom, starting from a random account
+		var holder simtypes.Account
+		var balance sdk.Coin
+		start := r.Intn(len(accs))
+		for i := range accs {
+			acc := accs[(start+i)%len(accs)]
+			if balance = bk.GetBalance(ctx, acc.Address, denom); balance.IsPositive() {
+				holder = acc
+				break
+			}
+		}
+		if holder.Address == nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "denom has no holder"), nil, nil
+		}
+
+		amount, _ := simtypes.RandPositiveInt(r, balance.Amount)
+		msg := types.MsgRequestRedemption{
+			Sender:          holder.Address.String(),
+			Amount:          sdk.NewCoin(denom, amount),
+			PayoutReference: simtypes.RandStringOfLength(r, 1+r.Intn(34)),
+		}
+
+		txCtx := BuildOperationInput(r, app, ctx, &msg, holder, ak, bk, sdk.NewCoins(msg.Amount), txGen)
+		opMsg, _, err := simulation.GenAndDeliverTxWithRandFees(txCtx)
+		if err != nil || !opMsg.OK {
+			return opMsg, nil, err
+		}
+
+		futureOps := []simtypes.FutureOperation{{
+			BlockHeight: int(ctx.BlockHeight()) + 1 + r.Intn(10),
+			Op:          SimulateMsgResolveRedemption(txGen, tfKeeper, ak, bk, denom),
+		}}
+		return opMsg, futureOps, nil
+	}
+}
+
+// Simulate msg fulfill redemption or msg reject redemption of a random open redemption request of
+// a denom by its admin.
+func SimulateMsgResolveRedemption(
+	txGen client.TxConfig,
+	tfKeeper TokenfactoryKeeper,
+	ak types.AccountKeeper,
+	bk BankKeeper,
+	denom string,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand,
+		app *baseapp.BaseApp,
+		ctx sdk.Context,
+		accs []simtypes.Account,
+		_ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgFulfillRedemption{})
+
+		redemptions := tfKeeper.GetOpenRedemptions(ctx, denom)
+		if len(redemptions) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "denom has no open redemption"), nil, nil
+		}
+		redemption := redemptions[r.Intn(len(redemptions))]
+
+		authData, err := tfKeeper.GetAuthorityMetadata(ctx, denom)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "err authority metadata"), nil, err
+		}
+		adminAccount, found := FindAdminAccount(accs, authData)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "admin account not found"), nil, nil
+		}
+
+		if r.Intn(4) == 0 {
+			msg := types.MsgRejectRedemption{
+				Sender: adminAccount.Address.String(),
+				Denom:  denom,
+				Id:     redemption.Id,
+				Reason: simtypes.RandStringOfLength(r, r.Intn(34)),
+			}
+			txCtx := BuildOperationInput(r, app, ctx, &msg, adminAccount, ak, bk, nil, txGen)
+			return simulation.GenAndDeliverTxWithRandFees(txCtx)
+		}
+
+		msg := types.MsgFulfillRedemption{
+			Sender:              adminAccount.Address.String(),
+			Denom:               denom,
+			Id:                  redemption.Id,
+			SettlementReference: simtypes.RandStringOfLength(r, 1+r.Intn(34)),
+		}
+		txCtx := BuildOperationInput(r, app, ctx, &msg, adminAccount, ak, bk, nil, txGen)
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
+
+// Simulate msg create vault of a new denom backed by the bond denom, with a random ratio
+func SimulateMsgCreateVault(
+	txGen client.TxConfig,
+	tfKeeper TokenfactoryKeeper,
+	ak types.AccountKeeper,
+	bk BankKeeper,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand,
+		app *baseapp.BaseApp,
+		ctx sdk.Context,
+		accs []simtypes.Account,
+		_ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgCreateVault{})
+		// Get sims account
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+
+		// Check if sims account enough create fee
+		createFee := tfKeeper.GetParams(ctx).DenomCreationFee
+		balances := bk.GetAllBalances(ctx, simAccount.Address)
+		if !balances.IsAllGTE(createFee) {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "Creator not enough creation fee"), nil, nil
+		}
+
+		msg := types.MsgCreateVault{
+			Sender:       simAccount.Address.String(),
+			Subdenom:     simtypes.RandStringOfLength(r, 10),
+			BackingDenom: sdk.DefaultBondDenom,
+			Ratio:        sdkmath.NewInt(int64(1 + r.Intn(1000))),
+		}
+
+		txCtx := BuildOperationInput(r, app, ctx, &msg, simAccount, ak, bk, createFee, txGen)
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
+
+// Simulate msg vault deposit of a random amount of the spendable backing coins of a random
+// account into a random vault
+func SimulateMsgVaultDeposit(
+	txGen client.TxConfig,
+	tfKeeper TokenfactoryKeeper,
+	ak types.AccountKeeper,
+	bk BankKeeper,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand,
+		app *baseapp.BaseApp,
+		ctx sdk.Context,
+		accs []simtypes.Account,
+		_ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgVaultDeposit{})
+
+		vaults := tfKeeper.GetAllVaults(ctx)
+		if len(vaults) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no vault created"), nil, nil
+		}
+		vault := vaults[r.Intn(len(vaults))]
+
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		spendable := bk.SpendableCoins(ctx, simAccount.Address).AmountOf(vault.BackingDenom)
+		if !spendable.IsPositive() {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "account has no backing coins"), nil, nil
+		}
+
+		// keep half of the backing coins for the fees
+		amount, err := simtypes.RandPositiveInt(r, spendable.QuoRaw(2).AddRaw(1))
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to generate amount"), nil, err
+		}
+		msg := types.MsgVaultDeposit{
+			Sender: simAccount.Address.String(),
+			Denom:  vault.Denom,
+			Amount: amount,
+		}
+
+		deposit := sdk.NewCoins(sdk.NewCoin(vault.BackingDenom, amount))
+		txCtx := BuildOperationInput(r, app, ctx, &msg, simAccount, ak, bk, deposit, txGen)
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
+
+// Simulate msg vault withdraw of a random amount of backing coins by a random holder of a vault
+// denom, bounded by the escrow of the vault and the balance of the holder
+func SimulateMsgVaultWithdraw(
+	txGen client.TxConfig,
+	tfKeeper TokenfactoryKeeper,
+	ak types.AccountKeeper,
+	bk BankKeeper,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand,
+		app *baseapp.BaseApp,
+		ctx sdk.Context,
+		accs []simtypes.Account,
+		_ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgVaultWithdraw{})
+
+		vaults := tfKeeper.GetAllVaults(ctx)
+		if len(vaults) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no vault created"), nil, nil
+		}
+		vault := vaults[r.Intn(len(vaults))]
+		if !vault.Escrowed.IsPositive() {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "vault has no escrow"), nil, nil
+		}
+
+		// Get a holder of at least one backing unit of the denom, starting from a random account
+		var holder simtypes.Account
+		var withdrawable sdkmath.Int
+		start := r.Intn(len(accs))
+		for i := range accs {
+			acc := accs[(start+i)%len(accs)]
+			balance := bk.SpendableCoins(ctx, acc.Address).AmountOf(vault.Denom)
+			if withdrawable = balance.Quo(vault.Ratio); withdrawable.IsPositive() {
+				holder = acc
+				break
+			}
+		}
+		if holder.Address == nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "denom has no holder"), nil, nil
+		}
+
+		amount, err := simtypes.RandPositiveInt(r, sdkmath.MinInt(withdrawable, vault.Escrowed))
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to generate amount"), nil, err
+		}
+		msg := types.MsgVaultWithdraw{
+			Sender: holder.Address.String(),
+			Denom:  vault.Denom,
+			Amount: amount,
+		}
+
+		burned := sdk.NewCoins(sdk.NewCoin(vault.Denom, amount.Mul(vault.Ratio)))
+		txCtx := BuildOperationInput(r, app, ctx, &msg, holder, ak, bk, burned, txGen)
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
+
+// Simulate msg create basket of a new denom composed of a random amount of the bond denom per
+// unit, with a random composition timelock
+func SimulateMsgCreateBasket(
+	txGen client.TxConfig,
+	tfKeeper TokenfactoryKeeper,
+	ak types.AccountKeeper,
+	bk BankKeeper,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand,
+		app *baseapp.BaseApp,
+		ctx sdk.Context,
+		accs []simtypes.Account,
+		_ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgCreateBasket{})
+		// Get sims account
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+
+		// Check if sims account enough create fee
+		createFee := tfKeeper.GetParams(ctx).DenomCreationFee
+		balances := bk.GetAllBalances(ctx, simAccount.Address)
+		if !balances.IsAllGTE(createFee) {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "Creator not enough creation fee"), nil, nil
+		}
+
+		msg := types.MsgCreateBasket{
+			Sender:              simAccount.Address.String(),
+			Subdenom:            simtypes.RandStringOfLength(r, 10),
+			Components:          sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, int64(1+r.Intn(1000)))),
+			CompositionTimelock: types.MinBasketCompositionTimelock + time.Duration(r.Intn(7*24))*time.Hour,
+		}
+
+		txCtx := BuildOperationInput(r, app, ctx, &msg, simAccount, ak, bk, createFee, txGen)
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
+
+// Simulate msg basket mint of a random amount of a random basket denom, bounded by the spendable
+// components of a random account
+func SimulateMsgBasketMint(
+	txGen client.TxConfig,
+	tfKeeper TokenfactoryKeeper,
+	ak types.AccountKeeper,
+	bk BankKeeper,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand,
+		app *baseapp.BaseApp,
+		ctx sdk.Context,
+		accs []simtypes.Account,
+		_ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgBasketMint{})
+
+		baskets := tfKeeper.GetAllBaskets(ctx)
+		if len(baskets) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no basket created"), nil, nil
+		}
+		basket := baskets[r.Intn(len(baskets))]
+
+		// keep half of the components for the fees
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		spendable := bk.SpendableCoins(ctx, simAccount.Address)
+		var mintable sdkmath.Int
+		for i, component := range basket.Components {
+			units := spendable.AmountOf(component.Denom).QuoRaw(2).Quo(component.Amount)
+			if i == 0 || units.LT(mintable) {
+				mintable = units
+			}
+		}
+		if !mintable.IsPositive() {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "account has not enough components"), nil, nil
+		}
+
+		amount, err := simtypes.RandPositiveInt(r, mintable)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to generate amount"), nil, err
+		}
+		msg := types.MsgBasketMint{
+			Sender: simAccount.Address.String(),
+			Denom:  basket.Denom,
+			Amount: amount,
+		}
+
+		deposit, err := basket.MintDeposit(amount)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to compute the deposit"), nil, err
+		}
+		txCtx := BuildOperationInput(r, app, ctx, &msg, simAccount, ak, bk, deposit, txGen)
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
+
+// Simulate msg basket redeem of a random amount of a basket denom by a random holder, bounded by
+// the balance of the holder
+func SimulateMsgBasketRedeem(
+	txGen client.TxConfig,
+	tfKeeper TokenfactoryKeeper,
+	ak types.AccountKeeper,
+	bk BankKeeper,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand,
+		app *baseapp.BaseApp,
+		ctx sdk.Context,
+		accs []simtypes.Account,
+		_ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgBasketRedeem{})
+
+		baskets := tfKeeper.GetAllBaskets(ctx)
+		if len(baskets) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no basket created"), nil, nil
+		}
+		basket := baskets[r.Intn(len(baskets))]
+		if !basket.Supply.IsPositive() {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "basket has no supply"), nil, nil
+		}
+
+		// Get a holder of the denom, starting from a random account
+		var holder simtypes.Account
+		var balance sdkmath.Int
+		start := r.Intn(len(accs))
+		for i := range accs {
+			acc := accs[(start+i)%len(accs)]
+			if balance = bk.SpendableCoins(ctx, acc.Address).AmountOf(basket.Denom); balance.IsPositive() {
+				holder = acc
+				break
+			}
+		}
+		if holder.Address == nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "denom has no holder"), nil, nil
+		}
+
+		amount, err := simtypes.RandPositiveInt(r, balance)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to generate amount"), nil, err
+		}
+		msg := types.MsgBasketRedeem{
+			Sender: holder.Address.String(),
+			Denom:  basket.Denom,
+			Amount: amount,
+		}
+
+		burned := sdk.NewCoins(sdk.NewCoin(basket.Denom, amount))
+		txCtx := BuildOperationInput(r, app, ctx, &msg, holder, ak, bk, burned, txGen)
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
+
+// Simulate msg mint vesting of a random amount of a random denom, into a continuous or periodic
+// schedule of a new account. The recipients are not simulation accounts, so that the other
+// operations never try to send locked tokens.
+func SimulateMsgMintVesting(
+	txGen client.TxConfig,
+	tfKeeper TokenfactoryKeeper,
+	ak types.AccountKeeper,
+	bk BankKeeper,
+	denomSelector DenomSelector,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand,
+		app *baseapp.BaseApp,
+		ctx sdk.Context,
+		accs []simtypes.Account,
+		_ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgMintVesting{})
+
+		createdDenomAccount, _ := simtypes.RandomAcc(r, accs)
+		denom, hasDenom := denomSelector(r, ctx, tfKeeper, createdDenomAccount.Address.String())
+		if !hasDenom {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "sim account have no denom created"), nil, nil
+		}
+
+		authData, err := tfKeeper.GetAuthorityMetadata(ctx, denom)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "err authority metadata"), nil, err
+		}
+		adminAccount, found := FindAdminAccount(accs, authData)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "admin account not found"), nil, nil
+		}
+
+		periodCount := r.Intn(5)
+		mintAmount, _ := simtypes.RandPositiveInt(r, sdkmath.NewIntFromUint64(100_000_000))
+		if mintAmount.LT(sdkmath.NewInt(int64(periodCount))) {
+			mintAmount = sdkmath.NewInt(int64(periodCount))
+		}
+		if err := tfKeeper.CheckReserveCeiling(ctx, sdk.NewCoin(denom, mintAmount)); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "mint above the attested reserve"), nil, nil
+		}
+
+		msg := types.MsgMintVesting{
+			Sender:    adminAccount.Address.String(),
+			Amount:    sdk.NewCoin(denom, mintAmount),
+			Recipient: simtypes.RandomAccounts(r, 1)[0].Address.String(),
+		}
+
+		// no periods is a continuous schedule, the other periods vest an equal share of the amount
+		if periodCount == 0 {
+			msg.EndTime = ctx.BlockTime().Add(time.Duration(1+r.Intn(1000)) * time.Hour)
+		} else {
+			share := mintAmount.QuoRaw(int64(periodCount))
+			for i := 0; i < periodCount; i++ {
+				msg.Periods = append(msg.Periods, types.VestingPeriod{
+					Length: time.Duration(1+r.Intn(240)) * time.Hour,
+					Amount: share,
+				})
+			}
+			msg.Periods[0].Amount = mintAmount.Sub(share.MulRaw(int64(periodCount - 1)))
+		}
+
+		txCtx := BuildOperationInput(r, app, ctx, &msg, adminAccount, ak, bk, nil, txGen)
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
+
+// Simulate msg clawback vesting of a random vesting schedule of a random denom, with tokens
+// still locked
+func SimulateMsgClawbackVesting(
+	txGen client.TxConfig,
+	tfKeeper TokenfactoryKeeper,
+	ak types.AccountKeeper,
+	bk BankKeeper,
+	denomSelector DenomSelector,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand,
+		app *baseapp.BaseApp,
+		ctx sdk.Context,
+		accs []simtypes.Account,
+		_ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgClawbackVesting{})
+
+		createdDenomAccount, _ := simtypes.RandomAcc(r, accs)
+		denom, hasDenom := denomSelector(r, ctx, tfKeeper, createdDenomAccount.Address.String())
+		if !hasDenom {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "sim account have no denom created"), nil, nil
+		}
+
+		var locked []types.VestingSchedule
+		for _, schedule := range tfKeeper.GetAllVestingSchedules(ctx, denom) {
+			if schedule.LockedAmount(ctx.BlockTime()).IsPositive() {
+				locked = append(locked, schedule)
+			}
+		}
+		if len(locked) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "denom has no locked vesting schedule"), nil, nil
+		}
+		schedule := locked[r.Intn(len(locked))]
+
+		authData, err := tfKeeper.GetAuthorityMetadata(ctx, denom)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "err authority metadata"), nil, err
+		}
+		adminAccount, found := FindAdminAccount(accs, authData)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "admin account not found"), nil, nil
+		}
+
+		msg := types.MsgClawbackVesting{
+			Sender: adminAccount.Address.String(),
+			Denom:  denom,
+			Holder: schedule.Recipient,
+		}
+
+		txCtx := BuildOperationInput(r, app, ctx, &msg, adminAccount, ak, bk, nil, txGen)
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
 	}
 }
@@ -0,0 +1,115 @@
+package types
+
+import (
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+// MaxBasketComponents is the maximum number of components of a basket, bounding the coins moved
+// by its mints and redemptions
+const MaxBasketComponents = 16
+
+// MinBasketCompositionTimelock is the minimum composition timelock of a basket, so that the
+// holders of a basket denom can redeem it before a change of its components is executed
+const MinBasketCompositionTimelock = 24 * time.Hour
+
+// BasketAddress returns the account holding the components of the basket of a denom, derived
+// from the module account address and the denom
+func BasketAddress(denom string) sdk.AccAddress {
+	return address.Module(ModuleName, []byte(BasketPrefixKey), []byte(denom))
+}
+
+// ValidateBasketComponents returns an error if the components of a basket are not valid coins,
+// are empty or more than MaxBasketComponents, or include the basket denom itself.
+func ValidateBasketComponents(denom string, components sdk.Coins) error {
+	if err := components.Validate(); err != nil {
+		return errorsmod.Wrapf(ErrInvalidBasket, "invalid components: %s", err)
+	}
+
+	if components.Empty() || len(components) > MaxBasketComponents {
+		return errorsmod.Wrapf(ErrInvalidBasket, "a basket must have between 1 and %d components", MaxBasketComponents)
+	}
+
+	if components.AmountOf(denom).IsPositive() {
+		return errorsmod.Wrap(ErrInvalidBasket, "a basket can not be composed of its own denom")
+	}
+
+	return nil
+}
+
+// ValidateBasketCompositionTimelock returns an error if the composition timelock of a basket is
+// shorter than MinBasketCompositionTimelock.
+func ValidateBasketCompositionTimelock(timelock time.Duration) error {
+	if timelock < MinBasketCompositionTimelock {
+		return errorsmod.Wrapf(ErrInvalidBasket, "composition timelock must be at least %s", MinBasketCompositionTimelock)
+	}
+	return nil
+}
+
+// Validate does a stateless check of the basket fields.
+func (b Basket) Validate() error {
+	if _, _, err := DeconstructDenom(b.Denom); err != nil {
+		return err
+	}
+
+	if err := ValidateBasketComponents(b.Denom, b.Components); err != nil {
+		return err
+	}
+
+	if err := ValidateBasketCompositionTimelock(b.CompositionTimelock); err != nil {
+		return err
+	}
+
+	if err := b.Escrowed.Validate(); err != nil {
+		return errorsmod.Wrapf(ErrInvalidBasket, "invalid escrowed coins: %s", err)
+	}
+
+	if b.Supply.IsNil() || b.Supply.IsNegative() {
+		return errorsmod.Wrap(ErrInvalidBasket, "supply can not be negative")
+	}
+
+	// the redemptions round down, so a basket with supply always escrows some coins
+	if b.Supply.IsZero() != b.Escrowed.Empty() {
+		return errorsmod.Wrap(ErrInvalidBasket, "a basket escrows coins if and only if it has a supply")
+	}
+
+	if pending := b.PendingComposition; pending != nil {
+		if err := ValidateBasketComponents(b.Denom, pending.Components); err != nil {
+			return errorsmod.Wrapf(err, "pending composition")
+		}
+	}
+
+	return nil
+}
+
+// MintDeposit returns the components deposited to mint an amount of the basket denom.
+func (b Basket) MintDeposit(amount sdkmath.Int) (sdk.Coins, error) {
+	deposit := make(sdk.Coins, 0, len(b.Components))
+	for _, component := range b.Components {
+		componentAmount, err := component.Amount.SafeMul(amount)
+		if err != nil {
+			return nil, errorsmod.Wrapf(ErrInvalidBasket, "amount %s times component %s: %s", amount, component, err)
+		}
+		deposit = append(deposit, sdk.NewCoin(component.Denom, componentAmount))
+	}
+	return deposit, nil
+}
+
+// RedeemedCoins returns the share of the escrowed coins of the basket redeemed by burning an
+// amount of the basket denom, rounded down. The whole escrow is redeemed with the whole supply.
+func (b Basket) RedeemedCoins(amount sdkmath.Int) sdk.Coins {
+	if amount.Equal(b.Supply) {
+		return b.Escrowed
+	}
+
+	redeemed := make([]sdk.Coin, 0, len(b.Escrowed))
+	for _, coin := range b.Escrowed {
+		redeemed = append(redeemed, sdk.NewCoin(coin.Denom, coin.Amount.Mul(amount).Quo(b.Supply)))
+	}
+	return sdk.NewCoins(redeemed...)
+}
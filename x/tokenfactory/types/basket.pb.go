@@ -0,0 +1,964 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: osmosis/tokenfactory/v1beta1/basket.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Basket binds a factory denom to a composition of other denoms. A unit of the
+// factory denom is only minted against the components of a unit deposited in
+// the account of the basket, and burned when a proportional share of the
+// deposits is redeemed.
+type Basket struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	// components are the coins deposited for every unit of the basket denom.
+	Components github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=components,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"components" yaml:"components"`
+	// escrowed are the coins deposited in the basket and not redeemed. Coins
+	// sent to the account of the basket outside of a mint are not counted.
+	Escrowed github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=escrowed,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"escrowed" yaml:"escrowed"`
+	// supply is the amount of the basket denom minted by deposits and not
+	// redeemed, which the supply of the denom must equal.
+	Supply cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=supply,proto3,customtype=cosmossdk.io/math.Int" json:"supply" yaml:"supply"`
+	// composition_timelock is the delay between a change of the components by
+	// the admin of the denom and its execution. It is set at the creation of the
+	// basket and can not be changed.
+	CompositionTimelock time.Duration `protobuf:"bytes,5,opt,name=composition_timelock,json=compositionTimelock,proto3,stdduration" json:"composition_timelock" yaml:"composition_timelock"`
+	// pending_composition is the scheduled change of the components, if any.
+	PendingComposition *PendingBasketComposition `protobuf:"bytes,6,opt,name=pending_composition,json=pendingComposition,proto3" json:"pending_composition,omitempty" yaml:"pending_composition"`
+}
+
+func (m *Basket) Reset()         { *m = Basket{} }
+func (m *Basket) String() string { return proto.CompactTextString(m) }
+func (*Basket) ProtoMessage()    {}
+func (*Basket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ddd0ee93d9e9c275, []int{0}
+}
+func (m *Basket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Basket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Basket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Basket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Basket.Merge(m, src)
+}
+func (m *Basket) XXX_Size() int {
+	return m.Size()
+}
+func (m *Basket) XXX_DiscardUnknown() {
+	xxx_messageInfo_Basket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Basket proto.InternalMessageInfo
+
+func (m *Basket) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *Basket) GetComponents() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Components
+	}
+	return nil
+}
+
+func (m *Basket) GetEscrowed() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Escrowed
+	}
+	return nil
+}
+
+func (m *Basket) GetCompositionTimelock() time.Duration {
+	if m != nil {
+		return m.CompositionTimelock
+	}
+	return 0
+}
+
+func (m *Basket) GetPendingComposition() *PendingBasketComposition {
+	if m != nil {
+		return m.PendingComposition
+	}
+	return nil
+}
+
+// PendingBasketComposition is a change of the components of a basket, executed
+// at the end of the first block from its execution time.
+type PendingBasketComposition struct {
+	Components   github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=components,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"components" yaml:"components"`
+	ExecuteAfter time.Time                                `protobuf:"bytes,2,opt,name=execute_after,json=executeAfter,proto3,stdtime" json:"execute_after" yaml:"execute_after"`
+}
+
+func (m *PendingBasketComposition) Reset()         { *m = PendingBasketComposition{} }
+func (m *PendingBasketComposition) String() string { return proto.CompactTextString(m) }
+func (*PendingBasketComposition) ProtoMessage()    {}
+func (*PendingBasketComposition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ddd0ee93d9e9c275, []int{1}
+}
+func (m *PendingBasketComposition) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingBasketComposition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingBasketComposition.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingBasketComposition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingBasketComposition.Merge(m, src)
+}
+func (m *PendingBasketComposition) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingBasketComposition) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingBasketComposition.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingBasketComposition proto.InternalMessageInfo
+
+func (m *PendingBasketComposition) GetComponents() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Components
+	}
+	return nil
+}
+
+func (m *PendingBasketComposition) GetExecuteAfter() time.Time {
+	if m != nil {
+		return m.ExecuteAfter
+	}
+	return time.Time{}
+}
+
+func init() {
+	proto.RegisterType((*Basket)(nil), "osmosis.tokenfactory.v1beta1.Basket")
+	proto.RegisterType((*PendingBasketComposition)(nil), "osmosis.tokenfactory.v1beta1.PendingBasketComposition")
+}
+
+func init() {
+	proto.RegisterFile("osmosis/tokenfactory/v1beta1/basket.proto", fileDescriptor_ddd0ee93d9e9c275)
+}
+
+var fileDescriptor_ddd0ee93d9e9c275 = []byte{
+	// 589 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x54, 0xbf, 0x6f, 0xd3, 0x40,
+	0x14, 0xce, 0xb5, 0x4d, 0x04, 0x47, 0x2b, 0xa8, 0x1b, 0x24, 0x37, 0x20, 0x3b, 0xb2, 0x04, 0x0a,
+	0x88, 0x9e, 0x55, 0x2a, 0x31, 0x74, 0xab, 0xcb, 0x40, 0x91, 0x90, 0x50, 0xd4, 0x05, 0x96, 0xc8,
+	0xb1, 0x2f, 0xae, 0x95, 0xd8, 0xcf, 0xca, 0x5d, 0xa0, 0x61, 0x61, 0x42, 0xac, 0x19, 0x19, 0x3b,
+	0x22, 0x26, 0x06, 0xfe, 0x88, 0x8e, 0x15, 0x13, 0x62, 0x70, 0x51, 0x32, 0xc0, 0x9c, 0xbf, 0x00,
+	0xf9, 0xee, 0x9c, 0xba, 0x29, 0x3f, 0x24, 0x16, 0x96, 0xc4, 0x77, 0xdf, 0xfb, 0xbe, 0xf7, 0xbd,
+	0x1f, 0x36, 0xbe, 0x03, 0x2c, 0x02, 0x16, 0x32, 0x9b, 0x43, 0x97, 0xc6, 0x1d, 0xd7, 0xe3, 0xd0,
+	0x1f, 0xda, 0x2f, 0x36, 0xdb, 0x94, 0xbb, 0x9b, 0x76, 0xdb, 0x65, 0x5d, 0xca, 0x49, 0xd2, 0x07,
+	0x0e, 0xda, 0x4d, 0x15, 0x4a, 0x8a, 0xa1, 0x44, 0x85, 0xd6, 0xaa, 0x01, 0x04, 0x20, 0x02, 0xed,
+	0xec, 0x49, 0x72, 0x6a, 0xab, 0x6e, 0x14, 0xc6, 0x60, 0x8b, 0x5f, 0x75, 0xb5, 0xee, 0x09, 0x9d,
+	0x96, 0x8c, 0x95, 0x07, 0x05, 0x19, 0xf2, 0x94, 0xa5, 0xa5, 0x33, 0x0f, 0x1e, 0x84, 0x71, 0x8e,
+	0x07, 0x00, 0x41, 0x8f, 0xda, 0xe2, 0xd4, 0x1e, 0x74, 0x6c, 0x7f, 0xd0, 0x77, 0x79, 0x08, 0x39,
+	0x6e, 0xce, 0xe3, 0x3c, 0x8c, 0x28, 0xe3, 0x6e, 0x94, 0xc8, 0x00, 0xeb, 0xa8, 0x8c, 0x2b, 0x8e,
+	0xa8, 0x49, 0xbb, 0x8d, 0xcb, 0x3e, 0x8d, 0x21, 0xd2, 0x51, 0x1d, 0x35, 0x2e, 0x3b, 0xd7, 0xa6,
+	0xa9, 0xb9, 0x3c, 0x74, 0xa3, 0xde, 0xb6, 0x25, 0xae, 0xad, 0xa6, 0x84, 0xb5, 0x37, 0x08, 0x63,
+	0x0f, 0xa2, 0x04, 0x62, 0x1a, 0x73, 0xa6, 0x2f, 0xd4, 0x17, 0x1b, 0x57, 0xee, 0xaf, 0x13, 0xe5,
+	0x3b, 0x73, 0x9a, 0xb7, 0x80, 0xec, 0x42, 0x18, 0x3b, 0x8f, 0x8f, 0x53, 0xb3, 0x34, 0x4d, 0xcd,
+	0x55, 0x29, 0x76, 0x46, 0xb5, 0x3e, 0x9c, 0x9a, 0x8d, 0x20, 0xe4, 0x07, 0x83, 0x36, 0xf1, 0x20,
+	0x52, 0x95, 0xab, 0xbf, 0x0d, 0xe6, 0x77, 0x6d, 0x3e, 0x4c, 0x28, 0x13, 0x2a, 0xec, 0xfd, 0xf7,
+	0x8f, 0x77, 0x51, 0xb3, 0x90, 0x58, 0x7b, 0x8d, 0x2f, 0x51, 0xe6, 0xf5, 0xe1, 0x25, 0xf5, 0xf5,
+	0xc5, 0xbf, 0x99, 0x78, 0xa4, 0x4c, 0x5c, 0x95, 0x26, 0x72, 0xe2, 0x3f, 0x58, 0x98, 0x25, 0xd5,
+	0x9e, 0xe1, 0x0a, 0x1b, 0x24, 0x49, 0x6f, 0xa8, 0x2f, 0x89, 0x8e, 0xed, 0x64, 0x39, 0xbe, 0xa6,
+	0xe6, 0x75, 0x49, 0x67, 0x7e, 0x97, 0x84, 0x60, 0x47, 0x2e, 0x3f, 0x20, 0x7b, 0x31, 0x9f, 0xa6,
+	0xe6, 0x8a, 0x4c, 0x2e, 0x49, 0xd6, 0xe7, 0x4f, 0x1b, 0x58, 0xf9, 0xdd, 0x8b, 0xb9, 0x14, 0x57,
+	0x82, 0xda, 0x2b, 0x5c, 0x15, 0x95, 0xb2, 0x30, 0x1b, 0x66, 0x2b, 0x9b, 0x5a, 0x0f, 0xbc, 0xae,
+	0x5e, 0xae, 0x23, 0x51, 0xa7, 0x1c, 0x2b, 0xc9, 0xc7, 0x4a, 0x1e, 0xaa, 0xb1, 0x3b, 0xf7, 0x54,
+	0x9d, 0x37, 0x0a, 0xcd, 0x9e, 0x13, 0xb1, 0xde, 0x9d, 0x9a, 0x48, 0xa6, 0x5b, 0x2b, 0xe0, 0xfb,
+	0x0a, 0xd6, 0xde, 0x22, 0xbc, 0x96, 0xd0, 0xd8, 0x0f, 0xe3, 0xa0, 0x55, 0xc0, 0xf5, 0x8a, 0xc8,
+	0xfd, 0x80, 0xfc, 0x69, 0xe9, 0xc9, 0x53, 0x49, 0x94, 0x2b, 0xb5, 0x7b, 0xc6, 0x76, 0x8c, 0x69,
+	0x6a, 0xd6, 0xa4, 0xa9, 0x5f, 0x88, 0x5b, 0x4d, 0x4d, 0xdd, 0x16, 0x38, 0xdb, 0x4b, 0x3f, 0x8e,
+	0x4c, 0x64, 0x8d, 0x16, 0xb0, 0xfe, 0x3b, 0xd9, 0xf9, 0x65, 0x44, 0xff, 0x6b, 0x19, 0x3b, 0x78,
+	0x85, 0x1e, 0x52, 0x6f, 0xc0, 0x69, 0xcb, 0xed, 0x70, 0xda, 0xd7, 0x17, 0x44, 0xb7, 0x6a, 0x17,
+	0x26, 0xb5, 0x9f, 0xbf, 0x80, 0xce, 0x2d, 0x65, 0xa5, 0xaa, 0x56, 0xb2, 0x48, 0xb7, 0x46, 0xb3,
+	0x19, 0x2d, 0x2b, 0x60, 0x27, 0xbb, 0x97, 0x2d, 0x71, 0x9e, 0x1c, 0x8f, 0x0d, 0x74, 0x32, 0x36,
+	0xd0, 0xb7, 0xb1, 0x81, 0x46, 0x13, 0xa3, 0x74, 0x32, 0x31, 0x4a, 0x5f, 0x26, 0x46, 0xe9, 0xf9,
+	0xd6, 0xc5, 0x12, 0xce, 0x7d, 0xc7, 0x0e, 0xcf, 0x1f, 0x45, 0x4d, 0xed, 0x8a, 0x70, 0xb7, 0xf5,
+	0x33, 0x00, 0x00, 0xff, 0xff, 0xa5, 0xa0, 0x82, 0x7e, 0xfb, 0x04, 0x00, 0x00,
+}
+
+func (this *Basket) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Basket)
+	if !ok {
+		that2, ok := that.(Basket)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Denom != that1.Denom {
+		return false
+	}
+	if len(this.Components) != len(that1.Components) {
+		return false
+	}
+	for i := range this.Components {
+		if !this.Components[i].Equal(&that1.Components[i]) {
+			return false
+		}
+	}
+	if len(this.Escrowed) != len(that1.Escrowed) {
+		return false
+	}
+	for i := range this.Escrowed {
+		if !this.Escrowed[i].Equal(&that1.Escrowed[i]) {
+			return false
+		}
+	}
+	if !this.Supply.Equal(that1.Supply) {
+		return false
+	}
+	if this.CompositionTimelock != that1.CompositionTimelock {
+		return false
+	}
+	if !this.PendingComposition.Equal(that1.PendingComposition) {
+		return false
+	}
+	return true
+}
+func (this *PendingBasketComposition) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PendingBasketComposition)
+	if !ok {
+		that2, ok := that.(PendingBasketComposition)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Components) != len(that1.Components) {
+		return false
+	}
+	for i := range this.Components {
+		if !this.Components[i].Equal(&that1.Components[i]) {
+			return false
+		}
+	}
+	if !this.ExecuteAfter.Equal(that1.ExecuteAfter) {
+		return false
+	}
+	return true
+}
+func (m *Basket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Basket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Basket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PendingComposition != nil {
+		{
+			size, err := m.PendingComposition.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBasket(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.CompositionTimelock, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.CompositionTimelock):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintBasket(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Supply.Size()
+		i -= size
+		if _, err := m.Supply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintBasket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Escrowed) > 0 {
+		for iNdEx := len(m.Escrowed) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Escrowed[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBasket(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Components) > 0 {
+		for iNdEx := len(m.Components) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Components[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBasket(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintBasket(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingBasketComposition) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingBasketComposition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingBasketComposition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ExecuteAfter, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExecuteAfter):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintBasket(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Components) > 0 {
+		for iNdEx := len(m.Components) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Components[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBasket(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintBasket(dAtA []byte, offset int, v uint64) int {
+	offset -= sovBasket(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Basket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovBasket(uint64(l))
+	}
+	if len(m.Components) > 0 {
+		for _, e := range m.Components {
+			l = e.Size()
+			n += 1 + l + sovBasket(uint64(l))
+		}
+	}
+	if len(m.Escrowed) > 0 {
+		for _, e := range m.Escrowed {
+			l = e.Size()
+			n += 1 + l + sovBasket(uint64(l))
+		}
+	}
+	l = m.Supply.Size()
+	n += 1 + l + sovBasket(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.CompositionTimelock)
+	n += 1 + l + sovBasket(uint64(l))
+	if m.PendingComposition != nil {
+		l = m.PendingComposition.Size()
+		n += 1 + l + sovBasket(uint64(l))
+	}
+	return n
+}
+
+func (m *PendingBasketComposition) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Components) > 0 {
+		for _, e := range m.Components {
+			l = e.Size()
+			n += 1 + l + sovBasket(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExecuteAfter)
+	n += 1 + l + sovBasket(uint64(l))
+	return n
+}
+
+func sovBasket(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozBasket(x uint64) (n int) {
+	return sovBasket(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Basket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBasket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Basket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Basket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBasket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBasket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBasket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Components", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBasket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBasket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBasket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Components = append(m.Components, types.Coin{})
+			if err := m.Components[len(m.Components)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Escrowed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBasket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBasket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBasket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Escrowed = append(m.Escrowed, types.Coin{})
+			if err := m.Escrowed[len(m.Escrowed)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Supply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBasket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBasket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBasket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Supply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompositionTimelock", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBasket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBasket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBasket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.CompositionTimelock, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingComposition", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBasket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBasket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBasket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PendingComposition == nil {
+				m.PendingComposition = &PendingBasketComposition{}
+			}
+			if err := m.PendingComposition.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBasket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBasket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PendingBasketComposition) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBasket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingBasketComposition: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingBasketComposition: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Components", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBasket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBasket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBasket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Components = append(m.Components, types.Coin{})
+			if err := m.Components[len(m.Components)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecuteAfter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBasket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBasket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBasket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ExecuteAfter, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBasket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBasket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipBasket(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowBasket
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowBasket
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowBasket
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthBasket
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupBasket
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthBasket
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthBasket        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowBasket          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupBasket = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,17 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// BlockBeforeSendSudoMsg is the message sent with sudo to the before send hook contract of a
+// denom before every transfer of the denom. The transfer fails when the contract errors.
+type BlockBeforeSendSudoMsg struct {
+	BlockBeforeSend BlockBeforeSendMsg `json:"block_before_send"`
+}
+
+type BlockBeforeSendMsg struct {
+	From   string   `json:"from"`
+	To     string   `json:"to"`
+	Amount sdk.Coin `json:"amount"`
+}
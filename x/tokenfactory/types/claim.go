@@ -0,0 +1,102 @@
+package types
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+)
+
+// ClaimMerkleRootLength is the length of the merkle root of a claim campaign, a sha256 hash.
+const ClaimMerkleRootLength = sha256.Size
+
+var (
+	claimLeafPrefix = []byte{0x00}
+	claimNodePrefix = []byte{0x01}
+)
+
+// ClaimLeafHash returns the merkle tree leaf of the claim of amount by a bech32 address:
+// sha256(0x00 || address || ":" || amount), with amount in base 10.
+func ClaimLeafHash(address string, amount sdkmath.Int) []byte {
+	h := sha256.New()
+	h.Write(claimLeafPrefix)
+	h.Write([]byte(address + ":" + amount.String()))
+	return h.Sum(nil)
+}
+
+// hashClaimNodes returns the parent of two nodes: sha256(0x01 || min(a, b) || max(a, b)). The
+// nodes are sorted so that proofs do not need the position of the siblings.
+func hashClaimNodes(a, b []byte) []byte {
+	if bytes.Compare(a, b) > 0 {
+		a, b = b, a
+	}
+
+	h := sha256.New()
+	h.Write(claimNodePrefix)
+	h.Write(a)
+	h.Write(b)
+	return h.Sum(nil)
+}
+
+// VerifyClaimProof returns whether the proof, the sibling hashes from the leaf up, links the
+// leaf to the merkle root.
+func VerifyClaimProof(root, leaf []byte, proof [][]byte) bool {
+	node := leaf
+	for _, sibling := range proof {
+		node = hashClaimNodes(node, sibling)
+	}
+
+	return bytes.Equal(node, root)
+}
+
+// BuildClaimMerkleTree returns the merkle root of the leaves, and the proof of every leaf. A
+// node without sibling is moved up to the next level unchanged.
+func BuildClaimMerkleTree(leaves [][]byte) (root []byte, proofs [][][]byte) {
+	if len(leaves) == 0 {
+		return nil, nil
+	}
+
+	proofs = make([][][]byte, len(leaves))
+	// positions[i] is the index of the ancestor of leaf i in the current level
+	positions := make([]int, len(leaves))
+	for i := range positions {
+		positions[i] = i
+	}
+
+	level := leaves
+	for len(level) > 1 {
+		next := make([][]byte, 0, (len(level)+1)/2)
+		for i := 0; i < len(level); i += 2 {
+			if i+1 == len(level) {
+				next = append(next, level[i])
+				continue
+			}
+			next = append(next, hashClaimNodes(level[i], level[i+1]))
+		}
+
+		for i, pos := range positions {
+			if sibling := pos ^ 1; sibling < len(level) {
+				proofs[i] = append(proofs[i], level[sibling])
+			}
+			positions[i] = pos / 2
+		}
+		level = next
+	}
+
+	return level[0], proofs
+}
+
+// Status returns the status of the campaign at the given block time.
+func (c ClaimCampaign) Status(blockTime time.Time) ClaimCampaignStatus {
+	switch {
+	case c.Closed:
+		return CLAIM_CAMPAIGN_STATUS_CLOSED
+	case c.Expiry != nil && !blockTime.Before(*c.Expiry):
+		return CLAIM_CAMPAIGN_STATUS_EXPIRED
+	case c.Claimed.GTE(c.TotalCap):
+		return CLAIM_CAMPAIGN_STATUS_EXHAUSTED
+	default:
+		return CLAIM_CAMPAIGN_STATUS_ACTIVE
+	}
+}
@@ -0,0 +1,1074 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: osmosis/tokenfactory/v1beta1/claim.proto
+
+package types
+
+import (
+	bytes "bytes"
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ClaimCampaignStatus is the status of a claim campaign.
+type ClaimCampaignStatus int32
+
+const (
+	// CLAIM_CAMPAIGN_STATUS_UNSPECIFIED is never returned.
+	CLAIM_CAMPAIGN_STATUS_UNSPECIFIED ClaimCampaignStatus = 0
+	// CLAIM_CAMPAIGN_STATUS_ACTIVE accepts claims.
+	CLAIM_CAMPAIGN_STATUS_ACTIVE ClaimCampaignStatus = 1
+	// CLAIM_CAMPAIGN_STATUS_EXPIRED is past its expiry.
+	CLAIM_CAMPAIGN_STATUS_EXPIRED ClaimCampaignStatus = 2
+	// CLAIM_CAMPAIGN_STATUS_EXHAUSTED has minted its total cap.
+	CLAIM_CAMPAIGN_STATUS_EXHAUSTED ClaimCampaignStatus = 3
+	// CLAIM_CAMPAIGN_STATUS_CLOSED was closed by the admin of the denom.
+	CLAIM_CAMPAIGN_STATUS_CLOSED ClaimCampaignStatus = 4
+)
+
+var ClaimCampaignStatus_name = map[int32]string{
+	0: "CLAIM_CAMPAIGN_STATUS_UNSPECIFIED",
+	1: "CLAIM_CAMPAIGN_STATUS_ACTIVE",
+	2: "CLAIM_CAMPAIGN_STATUS_EXPIRED",
+	3: "CLAIM_CAMPAIGN_STATUS_EXHAUSTED",
+	4: "CLAIM_CAMPAIGN_STATUS_CLOSED",
+}
+
+var ClaimCampaignStatus_value = map[string]int32{
+	"CLAIM_CAMPAIGN_STATUS_UNSPECIFIED": 0,
+	"CLAIM_CAMPAIGN_STATUS_ACTIVE":      1,
+	"CLAIM_CAMPAIGN_STATUS_EXPIRED":     2,
+	"CLAIM_CAMPAIGN_STATUS_EXHAUSTED":   3,
+	"CLAIM_CAMPAIGN_STATUS_CLOSED":      4,
+}
+
+func (x ClaimCampaignStatus) String() string {
+	return proto.EnumName(ClaimCampaignStatus_name, int32(x))
+}
+
+func (ClaimCampaignStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_2da21f8acf2a10bd, []int{0}
+}
+
+// ClaimCampaign lets the addresses of the leaves of a merkle tree of
+// (address, amount) claim their amount of a factory denom, which is minted on
+// claim.
+type ClaimCampaign struct {
+	Id    uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty" yaml:"id"`
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	// creator is the denom admin that registered the campaign.
+	Creator string `protobuf:"bytes,3,opt,name=creator,proto3" json:"creator,omitempty" yaml:"creator"`
+	// merkle_root is the root of the merkle tree of the claims, see
+	// ClaimLeafHash for the leaves.
+	MerkleRoot []byte `protobuf:"bytes,4,opt,name=merkle_root,json=merkleRoot,proto3" json:"merkle_root,omitempty" yaml:"merkle_root"`
+	// total_cap is the maximum amount all the claims can mint.
+	TotalCap cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=total_cap,json=totalCap,proto3,customtype=cosmossdk.io/math.Int" json:"total_cap" yaml:"total_cap"`
+	// claimed is the amount minted by the claims so far.
+	Claimed cosmossdk_io_math.Int `protobuf:"bytes,6,opt,name=claimed,proto3,customtype=cosmossdk.io/math.Int" json:"claimed" yaml:"claimed"`
+	// expiry is the optional time after which claims are rejected.
+	Expiry *time.Time `protobuf:"bytes,7,opt,name=expiry,proto3,stdtime" json:"expiry,omitempty" yaml:"expiry"`
+	// closed is set when the admin of the denom closes the campaign.
+	Closed bool `protobuf:"varint,8,opt,name=closed,proto3" json:"closed,omitempty" yaml:"closed"`
+}
+
+func (m *ClaimCampaign) Reset()         { *m = ClaimCampaign{} }
+func (m *ClaimCampaign) String() string { return proto.CompactTextString(m) }
+func (*ClaimCampaign) ProtoMessage()    {}
+func (*ClaimCampaign) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2da21f8acf2a10bd, []int{0}
+}
+func (m *ClaimCampaign) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClaimCampaign) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClaimCampaign.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ClaimCampaign) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClaimCampaign.Merge(m, src)
+}
+func (m *ClaimCampaign) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClaimCampaign) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClaimCampaign.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClaimCampaign proto.InternalMessageInfo
+
+func (m *ClaimCampaign) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *ClaimCampaign) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *ClaimCampaign) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *ClaimCampaign) GetMerkleRoot() []byte {
+	if m != nil {
+		return m.MerkleRoot
+	}
+	return nil
+}
+
+func (m *ClaimCampaign) GetExpiry() *time.Time {
+	if m != nil {
+		return m.Expiry
+	}
+	return nil
+}
+
+func (m *ClaimCampaign) GetClosed() bool {
+	if m != nil {
+		return m.Closed
+	}
+	return false
+}
+
+// ClaimRecord is the claim of an address in a campaign.
+type ClaimRecord struct {
+	CampaignId uint64                `protobuf:"varint,1,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty" yaml:"campaign_id"`
+	Address    string                `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty" yaml:"address"`
+	Amount     cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount" yaml:"amount"`
+}
+
+func (m *ClaimRecord) Reset()         { *m = ClaimRecord{} }
+func (m *ClaimRecord) String() string { return proto.CompactTextString(m) }
+func (*ClaimRecord) ProtoMessage()    {}
+func (*ClaimRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2da21f8acf2a10bd, []int{1}
+}
+func (m *ClaimRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClaimRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClaimRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ClaimRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClaimRecord.Merge(m, src)
+}
+func (m *ClaimRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClaimRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClaimRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClaimRecord proto.InternalMessageInfo
+
+func (m *ClaimRecord) GetCampaignId() uint64 {
+	if m != nil {
+		return m.CampaignId
+	}
+	return 0
+}
+
+func (m *ClaimRecord) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterEnum("osmosis.tokenfactory.v1beta1.ClaimCampaignStatus", ClaimCampaignStatus_name, ClaimCampaignStatus_value)
+	proto.RegisterType((*ClaimCampaign)(nil), "osmosis.tokenfactory.v1beta1.ClaimCampaign")
+	proto.RegisterType((*ClaimRecord)(nil), "osmosis.tokenfactory.v1beta1.ClaimRecord")
+}
+
+func init() {
+	proto.RegisterFile("osmosis/tokenfactory/v1beta1/claim.proto", fileDescriptor_2da21f8acf2a10bd)
+}
+
+var fileDescriptor_2da21f8acf2a10bd = []byte{
+	// 692 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x94, 0x41, 0x4f, 0x13, 0x41,
+	0x14, 0xc7, 0x3b, 0xa5, 0x14, 0x18, 0xc0, 0x94, 0x11, 0xcc, 0xda, 0x40, 0xb7, 0xac, 0xd1, 0x54,
+	0x12, 0x77, 0x83, 0x1c, 0x4c, 0xb8, 0x6d, 0xb7, 0x55, 0x37, 0x01, 0x24, 0xdb, 0x62, 0xd4, 0x98,
+	0x34, 0xd3, 0xdd, 0x61, 0xd9, 0xd0, 0xdd, 0xd9, 0xec, 0x4e, 0x0d, 0xfd, 0x06, 0x1e, 0x89, 0x9f,
+	0xc0, 0xc4, 0x8b, 0x47, 0x0f, 0x7e, 0x08, 0x4e, 0x86, 0x78, 0x32, 0x1e, 0x56, 0x03, 0x07, 0xbd,
+	0x78, 0xe9, 0x27, 0x30, 0xdd, 0x99, 0x62, 0x49, 0x8a, 0x5c, 0x9a, 0xbe, 0x79, 0xbf, 0xf7, 0x7f,
+	0x6f, 0xf6, 0xfd, 0x77, 0x61, 0x85, 0xc6, 0x3e, 0x8d, 0xbd, 0x58, 0x63, 0xf4, 0x90, 0x04, 0xfb,
+	0xd8, 0x66, 0x34, 0xea, 0x69, 0x6f, 0xd6, 0xdb, 0x84, 0xe1, 0x75, 0xcd, 0xee, 0x60, 0xcf, 0x57,
+	0xc3, 0x88, 0x32, 0x8a, 0x96, 0x05, 0xa9, 0x8e, 0x92, 0xaa, 0x20, 0x8b, 0x8b, 0x2e, 0x75, 0x69,
+	0x0a, 0x6a, 0x83, 0x7f, 0xbc, 0xa6, 0xb8, 0x80, 0x7d, 0x2f, 0xa0, 0x5a, 0xfa, 0x2b, 0x8e, 0x6e,
+	0xdb, 0xa9, 0x4e, 0x8b, 0xb3, 0x3c, 0x10, 0x29, 0xd9, 0xa5, 0xd4, 0xed, 0x10, 0x2d, 0x8d, 0xda,
+	0xdd, 0x7d, 0x8d, 0x79, 0x3e, 0x89, 0x19, 0xf6, 0x43, 0x0e, 0x28, 0xef, 0x72, 0x70, 0xde, 0x18,
+	0x8c, 0x64, 0x60, 0x3f, 0xc4, 0x9e, 0x1b, 0xa0, 0x15, 0x98, 0xf5, 0x1c, 0x09, 0x94, 0x41, 0x25,
+	0x57, 0x9d, 0xef, 0x27, 0xf2, 0x4c, 0x0f, 0xfb, 0x9d, 0x4d, 0xc5, 0x73, 0x14, 0x2b, 0xeb, 0x39,
+	0xe8, 0x1e, 0x9c, 0x74, 0x48, 0x40, 0x7d, 0x29, 0x5b, 0x06, 0x95, 0x99, 0x6a, 0xa1, 0x9f, 0xc8,
+	0x73, 0x9c, 0x48, 0x8f, 0x15, 0x8b, 0xa7, 0x51, 0x0d, 0x4e, 0xd9, 0x11, 0xc1, 0x8c, 0x46, 0xd2,
+	0x44, 0x4a, 0xae, 0xf5, 0x13, 0xf9, 0x06, 0x27, 0x45, 0x42, 0xf9, 0xfa, 0xf9, 0xc1, 0xa2, 0x18,
+	0x57, 0x77, 0x9c, 0x88, 0xc4, 0x71, 0x83, 0x45, 0x5e, 0xe0, 0x5a, 0xc3, 0x52, 0xf4, 0x08, 0xce,
+	0xfa, 0x24, 0x3a, 0xec, 0x90, 0x56, 0x44, 0x29, 0x93, 0x72, 0x65, 0x50, 0x99, 0xab, 0xde, 0xea,
+	0x27, 0x32, 0xe2, 0x4a, 0x23, 0x49, 0xc5, 0x82, 0x3c, 0xb2, 0x28, 0x65, 0x08, 0xc3, 0x19, 0x46,
+	0x19, 0xee, 0xb4, 0x6c, 0x1c, 0x4a, 0x93, 0xe9, 0x00, 0xb5, 0x93, 0x44, 0xce, 0x7c, 0x4f, 0xe4,
+	0x25, 0xde, 0x32, 0x76, 0x0e, 0x55, 0x8f, 0x6a, 0x3e, 0x66, 0x07, 0xaa, 0x19, 0xb0, 0x7e, 0x22,
+	0x17, 0xb8, 0xe6, 0x45, 0xdd, 0x60, 0x3e, 0x28, 0xe6, 0x33, 0x03, 0xf6, 0xf1, 0xd7, 0xa7, 0x35,
+	0x60, 0x4d, 0xa7, 0x69, 0x03, 0x87, 0xe8, 0x35, 0x9c, 0x4a, 0x97, 0x49, 0x1c, 0x29, 0x9f, 0x36,
+	0xa8, 0x5e, 0xd7, 0x60, 0x78, 0x7d, 0x5e, 0x35, 0x56, 0x7e, 0x28, 0x89, 0x4c, 0x98, 0x27, 0x47,
+	0xa1, 0x17, 0xf5, 0xa4, 0xa9, 0x32, 0xa8, 0xcc, 0x3e, 0x2c, 0xaa, 0x7c, 0x95, 0xea, 0x70, 0x95,
+	0x6a, 0x73, 0xb8, 0xca, 0xea, 0x52, 0x3f, 0x91, 0xe7, 0xb9, 0x36, 0xaf, 0x51, 0x8e, 0x7f, 0xc8,
+	0xc0, 0x12, 0x02, 0xe8, 0x3e, 0xcc, 0xdb, 0x1d, 0x1a, 0x13, 0x47, 0x9a, 0x2e, 0x83, 0xca, 0x74,
+	0x75, 0xe1, 0x1f, 0xce, 0xcf, 0x15, 0x4b, 0x00, 0x9b, 0xb9, 0xdf, 0xef, 0x65, 0xa0, 0xfc, 0x01,
+	0x70, 0x36, 0x35, 0x85, 0x45, 0x6c, 0x1a, 0x39, 0x83, 0x2d, 0xd8, 0xc2, 0x1e, 0xad, 0x0b, 0x6f,
+	0x8c, 0x6c, 0x61, 0x24, 0xa9, 0x58, 0x70, 0x18, 0x99, 0xce, 0xc0, 0x04, 0x98, 0x2f, 0x56, 0xd8,
+	0x65, 0xc4, 0x04, 0x22, 0xf1, 0x1f, 0x13, 0x08, 0x02, 0xbd, 0x84, 0x79, 0xec, 0xd3, 0x6e, 0xc0,
+	0x84, 0x93, 0xf4, 0xeb, 0x9e, 0xb3, 0xb8, 0x1c, 0x2f, 0x1a, 0xfb, 0x98, 0x85, 0x20, 0xbf, 0xef,
+	0xda, 0x17, 0x00, 0x6f, 0x5e, 0x7a, 0x09, 0x1a, 0x0c, 0xb3, 0x6e, 0x8c, 0xee, 0xc2, 0x55, 0x63,
+	0x4b, 0x37, 0xb7, 0x5b, 0x86, 0xbe, 0xbd, 0xab, 0x9b, 0x4f, 0x76, 0x5a, 0x8d, 0xa6, 0xde, 0xdc,
+	0x6b, 0xb4, 0xf6, 0x76, 0x1a, 0xbb, 0x75, 0xc3, 0x7c, 0x6c, 0xd6, 0x6b, 0x85, 0x0c, 0x2a, 0xc3,
+	0xe5, 0xf1, 0x98, 0x6e, 0x34, 0xcd, 0xe7, 0xf5, 0x02, 0x40, 0xab, 0x70, 0x65, 0x3c, 0x51, 0x7f,
+	0xb1, 0x6b, 0x5a, 0xf5, 0x5a, 0x21, 0x8b, 0xee, 0x40, 0xf9, 0x2a, 0xe4, 0xa9, 0xbe, 0xd7, 0x68,
+	0xd6, 0x6b, 0x85, 0x89, 0xab, 0x3b, 0x19, 0x5b, 0xcf, 0x1a, 0xf5, 0x5a, 0x21, 0x57, 0xcc, 0xbd,
+	0xfd, 0x50, 0xca, 0x54, 0xb7, 0x4f, 0xce, 0x4a, 0xe0, 0xf4, 0xac, 0x04, 0x7e, 0x9e, 0x95, 0xc0,
+	0xf1, 0x79, 0x29, 0x73, 0x7a, 0x5e, 0xca, 0x7c, 0x3b, 0x2f, 0x65, 0x5e, 0x6d, 0xb8, 0x1e, 0x3b,
+	0xe8, 0xb6, 0x55, 0x9b, 0xfa, 0xe2, 0x4b, 0x71, 0xf9, 0x33, 0x75, 0x74, 0x39, 0x64, 0xbd, 0x90,
+	0xc4, 0xed, 0x7c, 0xea, 0xb9, 0x8d, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x34, 0xcd, 0xce, 0xc1,
+	0xda, 0x04, 0x00, 0x00,
+}
+
+func (this *ClaimCampaign) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ClaimCampaign)
+	if !ok {
+		that2, ok := that.(ClaimCampaign)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Id != that1.Id {
+		return false
+	}
+	if this.Denom != that1.Denom {
+		return false
+	}
+	if this.Creator != that1.Creator {
+		return false
+	}
+	if !bytes.Equal(this.MerkleRoot, that1.MerkleRoot) {
+		return false
+	}
+	if !this.TotalCap.Equal(that1.TotalCap) {
+		return false
+	}
+	if !this.Claimed.Equal(that1.Claimed) {
+		return false
+	}
+	if that1.Expiry == nil {
+		if this.Expiry != nil {
+			return false
+		}
+	} else if !this.Expiry.Equal(*that1.Expiry) {
+		return false
+	}
+	if this.Closed != that1.Closed {
+		return false
+	}
+	return true
+}
+func (this *ClaimRecord) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ClaimRecord)
+	if !ok {
+		that2, ok := that.(ClaimRecord)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.CampaignId != that1.CampaignId {
+		return false
+	}
+	if this.Address != that1.Address {
+		return false
+	}
+	if !this.Amount.Equal(that1.Amount) {
+		return false
+	}
+	return true
+}
+func (m *ClaimCampaign) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClaimCampaign) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClaimCampaign) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Closed {
+		i--
+		if m.Closed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Expiry != nil {
+		n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Expiry, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiry):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintClaim(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x3a
+	}
+	{
+		size := m.Claimed.Size()
+		i -= size
+		if _, err := m.Claimed.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintClaim(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.TotalCap.Size()
+		i -= size
+		if _, err := m.TotalCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintClaim(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.MerkleRoot) > 0 {
+		i -= len(m.MerkleRoot)
+		copy(dAtA[i:], m.MerkleRoot)
+		i = encodeVarintClaim(dAtA, i, uint64(len(m.MerkleRoot)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintClaim(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintClaim(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintClaim(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ClaimRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClaimRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClaimRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintClaim(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintClaim(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.CampaignId != 0 {
+		i = encodeVarintClaim(dAtA, i, uint64(m.CampaignId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintClaim(dAtA []byte, offset int, v uint64) int {
+	offset -= sovClaim(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ClaimCampaign) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovClaim(uint64(m.Id))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovClaim(uint64(l))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovClaim(uint64(l))
+	}
+	l = len(m.MerkleRoot)
+	if l > 0 {
+		n += 1 + l + sovClaim(uint64(l))
+	}
+	l = m.TotalCap.Size()
+	n += 1 + l + sovClaim(uint64(l))
+	l = m.Claimed.Size()
+	n += 1 + l + sovClaim(uint64(l))
+	if m.Expiry != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiry)
+		n += 1 + l + sovClaim(uint64(l))
+	}
+	if m.Closed {
+		n += 2
+	}
+	return n
+}
+
+func (m *ClaimRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CampaignId != 0 {
+		n += 1 + sovClaim(uint64(m.CampaignId))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovClaim(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovClaim(uint64(l))
+	return n
+}
+
+func sovClaim(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozClaim(x uint64) (n int) {
+	return sovClaim(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ClaimCampaign) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowClaim
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClaimCampaign: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClaimCampaign: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaim
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaim
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClaim
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClaim
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaim
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClaim
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClaim
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MerkleRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaim
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthClaim
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClaim
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MerkleRoot = append(m.MerkleRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.MerkleRoot == nil {
+				m.MerkleRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaim
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClaim
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClaim
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Claimed", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaim
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClaim
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClaim
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Claimed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaim
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClaim
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthClaim
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Expiry == nil {
+				m.Expiry = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Expiry, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Closed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaim
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Closed = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipClaim(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthClaim
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClaimRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowClaim
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClaimRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClaimRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CampaignId", wireType)
+			}
+			m.CampaignId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaim
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CampaignId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaim
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClaim
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClaim
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaim
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClaim
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClaim
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipClaim(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthClaim
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipClaim(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowClaim
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowClaim
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowClaim
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthClaim
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupClaim
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthClaim
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthClaim        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowClaim          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupClaim = fmt.Errorf("proto: unexpected end of group")
+)
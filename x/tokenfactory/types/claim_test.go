@@ -0,0 +1,80 @@
+package types_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/cosmos/tokenfactory/x/tokenfactory/types"
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+)
+
+func TestClaimMerkleTree(t *testing.T) {
+	for _, n := range []int{1, 2, 3, 4, 5, 8, 13} {
+		t.Run(fmt.Sprintf("%d leaves", n), func(t *testing.T) {
+			leaves := make([][]byte, n)
+			for i := range leaves {
+				leaves[i] = types.ClaimLeafHash(fmt.Sprintf("address%d", i), sdkmath.NewInt(int64(i+1)))
+			}
+
+			root, proofs := types.BuildClaimMerkleTree(leaves)
+			require.Len(t, root, types.ClaimMerkleRootLength)
+			require.Len(t, proofs, n)
+
+			for i, leaf := range leaves {
+				require.True(t, types.VerifyClaimProof(root, leaf, proofs[i]), "leaf %d", i)
+
+				// the proof does not verify another amount
+				other := types.ClaimLeafHash(fmt.Sprintf("address%d", i), sdkmath.NewInt(int64(i+2)))
+				require.False(t, types.VerifyClaimProof(root, other, proofs[i]), "leaf %d", i)
+			}
+		})
+	}
+
+	root, proofs := types.BuildClaimMerkleTree(nil)
+	require.Nil(t, root)
+	require.Nil(t, proofs)
+}
+
+func TestClaimCampaignStatus(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	expiry := now.Add(time.Hour)
+
+	for _, tc := range []struct {
+		desc      string
+		campaign  types.ClaimCampaign
+		expStatus types.ClaimCampaignStatus
+	}{
+		{
+			desc:      "active",
+			campaign:  types.ClaimCampaign{TotalCap: sdkmath.NewInt(10), Claimed: sdkmath.NewInt(9), Expiry: &expiry},
+			expStatus: types.CLAIM_CAMPAIGN_STATUS_ACTIVE,
+		},
+		{
+			desc:      "active without expiry",
+			campaign:  types.ClaimCampaign{TotalCap: sdkmath.NewInt(10), Claimed: sdkmath.ZeroInt()},
+			expStatus: types.CLAIM_CAMPAIGN_STATUS_ACTIVE,
+		},
+		{
+			desc:      "exhausted",
+			campaign:  types.ClaimCampaign{TotalCap: sdkmath.NewInt(10), Claimed: sdkmath.NewInt(10), Expiry: &expiry},
+			expStatus: types.CLAIM_CAMPAIGN_STATUS_EXHAUSTED,
+		},
+		{
+			desc:      "expired",
+			campaign:  types.ClaimCampaign{TotalCap: sdkmath.NewInt(10), Claimed: sdkmath.NewInt(10), Expiry: &now},
+			expStatus: types.CLAIM_CAMPAIGN_STATUS_EXPIRED,
+		},
+		{
+			desc:      "closed",
+			campaign:  types.ClaimCampaign{TotalCap: sdkmath.NewInt(10), Claimed: sdkmath.ZeroInt(), Expiry: &now, Closed: true},
+			expStatus: types.CLAIM_CAMPAIGN_STATUS_CLOSED,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			require.Equal(t, tc.expStatus, tc.campaign.Status(now))
+		})
+	}
+}
@@ -29,6 +29,31 @@ const (
 	burnTFDenom          = "osmosis/tokenfactory/burn"
 	forceTransferTFDenom = "osmosis/tokenfactory/force-transfer"
 	changeAdminTFDenom   = "osmosis/tokenfactory/change-admin"
+	setDenomHookTFDenom  = "osmosis/tokenfactory/set-denom-hook"
+	setBeforeSendHookTF  = "osmosis/tokenfactory/set-bef-send-hook"
+	multiMintTFDenom     = "osmosis/tokenfactory/multi-mint"
+	multiBurnTFDenom     = "osmosis/tokenfactory/multi-burn"
+	createClaimCampTF    = "osmosis/tokenfactory/create-claim-camp"
+	claimTF              = "osmosis/tokenfactory/claim"
+	closeClaimCampTF     = "osmosis/tokenfactory/close-claim-camp"
+	setVoucherSignerTF   = "osmosis/tokenfactory/set-voucher-signer"
+	redeemVoucherTF      = "osmosis/tokenfactory/redeem-voucher"
+	setReserveAttestorTF = "osmosis/tokenfactory/set-attestor"
+	attestReserveTF      = "osmosis/tokenfactory/attest-reserve"
+	requestRedemptionTF  = "osmosis/tokenfactory/request-redemption"
+	fulfillRedemptionTF  = "osmosis/tokenfactory/fulfill-redemption"
+	rejectRedemptionTF   = "osmosis/tokenfactory/reject-redemption"
+	createVaultTF        = "osmosis/tokenfactory/create-vault"
+	vaultDepositTF       = "osmosis/tokenfactory/vault-deposit"
+	vaultWithdrawTF      = "osmosis/tokenfactory/vault-withdraw"
+	registerCW20TF       = "osmosis/tokenfactory/register-cw20"
+	withdrawCW20TF       = "osmosis/tokenfactory/withdraw-cw20"
+	createBasketTF       = "osmosis/tokenfactory/create-basket"
+	basketMintTF         = "osmosis/tokenfactory/basket-mint"
+	basketRedeemTF       = "osmosis/tokenfactory/basket-redeem"
+	setBasketCompTF      = "osmosis/tokenfactory/set-basket-comp"
+	mintVestingTF        = "osmosis/tokenfactory/mint-vesting"
+	clawbackVestingTF    = "osmosis/tokenfactory/clawback-vesting"
 	updateTFparams       = "osmosis/tokenfactory/msg-update-params"
 )
 
@@ -56,6 +81,31 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&MsgBurn{},
 		&MsgForceTransfer{},
 		&MsgChangeAdmin{},
+		&MsgSetDenomHook{},
+		&MsgSetBeforeSendHook{},
+		&MsgMultiMint{},
+		&MsgMultiBurn{},
+		&MsgCreateClaimCampaign{},
+		&MsgClaim{},
+		&MsgCloseClaimCampaign{},
+		&MsgSetVoucherSigner{},
+		&MsgRedeemMintVoucher{},
+		&MsgSetReserveAttestor{},
+		&MsgAttestReserve{},
+		&MsgRequestRedemption{},
+		&MsgFulfillRedemption{},
+		&MsgRejectRedemption{},
+		&MsgCreateVault{},
+		&MsgVaultDeposit{},
+		&MsgVaultWithdraw{},
+		&MsgRegisterCW20{},
+		&MsgWithdrawCW20{},
+		&MsgCreateBasket{},
+		&MsgBasketMint{},
+		&MsgBasketRedeem{},
+		&MsgSetBasketComposition{},
+		&MsgMintVesting{},
+		&MsgClawbackVesting{},
 		&MsgUpdateParams{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -67,5 +117,30 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgBurn{}, burnTFDenom, nil)
 	cdc.RegisterConcrete(&MsgForceTransfer{}, forceTransferTFDenom, nil)
 	cdc.RegisterConcrete(&MsgChangeAdmin{}, changeAdminTFDenom, nil)
+	cdc.RegisterConcrete(&MsgSetDenomHook{}, setDenomHookTFDenom, nil)
+	cdc.RegisterConcrete(&MsgSetBeforeSendHook{}, setBeforeSendHookTF, nil)
+	cdc.RegisterConcrete(&MsgMultiMint{}, multiMintTFDenom, nil)
+	cdc.RegisterConcrete(&MsgMultiBurn{}, multiBurnTFDenom, nil)
+	cdc.RegisterConcrete(&MsgCreateClaimCampaign{}, createClaimCampTF, nil)
+	cdc.RegisterConcrete(&MsgClaim{}, claimTF, nil)
+	cdc.RegisterConcrete(&MsgCloseClaimCampaign{}, closeClaimCampTF, nil)
+	cdc.RegisterConcrete(&MsgSetVoucherSigner{}, setVoucherSignerTF, nil)
+	cdc.RegisterConcrete(&MsgRedeemMintVoucher{}, redeemVoucherTF, nil)
+	cdc.RegisterConcrete(&MsgSetReserveAttestor{}, setReserveAttestorTF, nil)
+	cdc.RegisterConcrete(&MsgAttestReserve{}, attestReserveTF, nil)
+	cdc.RegisterConcrete(&MsgRequestRedemption{}, requestRedemptionTF, nil)
+	cdc.RegisterConcrete(&MsgFulfillRedemption{}, fulfillRedemptionTF, nil)
+	cdc.RegisterConcrete(&MsgRejectRedemption{}, rejectRedemptionTF, nil)
+	cdc.RegisterConcrete(&MsgCreateVault{}, createVaultTF, nil)
+	cdc.RegisterConcrete(&MsgVaultDeposit{}, vaultDepositTF, nil)
+	cdc.RegisterConcrete(&MsgVaultWithdraw{}, vaultWithdrawTF, nil)
+	cdc.RegisterConcrete(&MsgRegisterCW20{}, registerCW20TF, nil)
+	cdc.RegisterConcrete(&MsgWithdrawCW20{}, withdrawCW20TF, nil)
+	cdc.RegisterConcrete(&MsgCreateBasket{}, createBasketTF, nil)
+	cdc.RegisterConcrete(&MsgBasketMint{}, basketMintTF, nil)
+	cdc.RegisterConcrete(&MsgBasketRedeem{}, basketRedeemTF, nil)
+	cdc.RegisterConcrete(&MsgSetBasketComposition{}, setBasketCompTF, nil)
+	cdc.RegisterConcrete(&MsgMintVesting{}, mintVestingTF, nil)
+	cdc.RegisterConcrete(&MsgClawbackVesting{}, clawbackVestingTF, nil)
 	cdc.RegisterConcrete(&MsgUpdateParams{}, updateTFparams, nil)
 }
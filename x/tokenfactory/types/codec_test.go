@@ -23,7 +23,7 @@ func (suite *CodecTestSuite) TestRegisterInterfaces() {
 	RegisterInterfaces(registry)
 
 	impls := registry.ListImplementations(sdk.MsgInterfaceProtoName)
-	suite.Require().Equal(7, len(impls))
+	suite.Require().Equal(32, len(impls))
 	suite.Require().ElementsMatch([]string{
 		"/osmosis.tokenfactory.v1beta1.MsgCreateDenom",
 		"/osmosis.tokenfactory.v1beta1.MsgMint",
@@ -32,5 +32,30 @@ func (suite *CodecTestSuite) TestRegisterInterfaces() {
 		"/osmosis.tokenfactory.v1beta1.MsgSetDenomMetadata",
 		"/osmosis.tokenfactory.v1beta1.MsgForceTransfer",
 		"/osmosis.tokenfactory.v1beta1.MsgUpdateParams",
+		"/osmosis.tokenfactory.v1beta1.MsgSetDenomHook",
+		"/osmosis.tokenfactory.v1beta1.MsgSetBeforeSendHook",
+		"/osmosis.tokenfactory.v1beta1.MsgMultiMint",
+		"/osmosis.tokenfactory.v1beta1.MsgMultiBurn",
+		"/osmosis.tokenfactory.v1beta1.MsgCreateClaimCampaign",
+		"/osmosis.tokenfactory.v1beta1.MsgClaim",
+		"/osmosis.tokenfactory.v1beta1.MsgCloseClaimCampaign",
+		"/osmosis.tokenfactory.v1beta1.MsgSetVoucherSigner",
+		"/osmosis.tokenfactory.v1beta1.MsgRedeemMintVoucher",
+		"/osmosis.tokenfactory.v1beta1.MsgSetReserveAttestor",
+		"/osmosis.tokenfactory.v1beta1.MsgAttestReserve",
+		"/osmosis.tokenfactory.v1beta1.MsgRequestRedemption",
+		"/osmosis.tokenfactory.v1beta1.MsgFulfillRedemption",
+		"/osmosis.tokenfactory.v1beta1.MsgRejectRedemption",
+		"/osmosis.tokenfactory.v1beta1.MsgCreateVault",
+		"/osmosis.tokenfactory.v1beta1.MsgVaultDeposit",
+		"/osmosis.tokenfactory.v1beta1.MsgVaultWithdraw",
+		"/osmosis.tokenfactory.v1beta1.MsgRegisterCW20",
+		"/osmosis.tokenfactory.v1beta1.MsgWithdrawCW20",
+		"/osmosis.tokenfactory.v1beta1.MsgCreateBasket",
+		"/osmosis.tokenfactory.v1beta1.MsgBasketMint",
+		"/osmosis.tokenfactory.v1beta1.MsgBasketRedeem",
+		"/osmosis.tokenfactory.v1beta1.MsgSetBasketComposition",
+		"/osmosis.tokenfactory.v1beta1.MsgMintVesting",
+		"/osmosis.tokenfactory.v1beta1.MsgClawbackVesting",
 	}, impls)
 }
@@ -0,0 +1,55 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+// CW20BridgeAddress is the address holding the CW20 tokens of every bridged denom, derived from
+// the module account address. No contract is instantiated at this address: the CW20 send hooks
+// targeting it are handled by the tokenfactory wasm bindings.
+var CW20BridgeAddress = sdk.AccAddress(address.Module(ModuleName, []byte(CW20BridgePrefixKey)))
+
+// CW20ReceiveHook is the message a CW20 contract sends to the recipient contract of a `Send`,
+// handled by the bindings when the recipient is CW20BridgeAddress.
+type CW20ReceiveHook struct {
+	Receive *CW20ReceiveMsg `json:"receive,omitempty"`
+}
+
+// CW20ReceiveMsg is the `Cw20ReceiveMsg` of the CW20 specification. Sender is the account the
+// tokens were sent by, and the msg is ignored by the bridge.
+type CW20ReceiveMsg struct {
+	Sender string      `json:"sender"`
+	Amount sdkmath.Int `json:"amount"`
+	Msg    []byte      `json:"msg"`
+}
+
+// CW20ExecuteMsg is the subset of the CW20 execute messages used by the bridge on withdrawals.
+type CW20ExecuteMsg struct {
+	Transfer *CW20Transfer `json:"transfer,omitempty"`
+}
+
+type CW20Transfer struct {
+	Recipient string      `json:"recipient"`
+	Amount    sdkmath.Int `json:"amount"`
+}
+
+// Validate does a stateless check of the CW20 bridge fields.
+func (b CW20Bridge) Validate() error {
+	if _, _, err := DeconstructDenom(b.Denom); err != nil {
+		return err
+	}
+
+	if _, err := sdk.AccAddressFromBech32(b.ContractAddress); err != nil {
+		return errorsmod.Wrapf(ErrInvalidCW20Bridge, "invalid contract address: %s", err)
+	}
+
+	if b.Escrowed.IsNil() || b.Escrowed.IsNegative() {
+		return errorsmod.Wrap(ErrInvalidCW20Bridge, "escrowed amount can not be negative")
+	}
+
+	return nil
+}
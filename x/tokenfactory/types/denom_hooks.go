@@ -0,0 +1,65 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// Validate performs a stateless validation of the denom hook
+func (h DenomHook) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(h.ContractAddress); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid denom hook contract address (%s)", err)
+	}
+	return nil
+}
+
+// DenomHookSudoMsg is the message sent with sudo to the hook contract of a denom.
+// Exactly one of the fields is set.
+type DenomHookSudoMsg struct {
+	DenomCreated          *DenomCreatedHook          `json:"denom_created,omitempty"`
+	DenomMinted           *DenomMintedHook           `json:"denom_minted,omitempty"`
+	DenomBurned           *DenomBurnedHook           `json:"denom_burned,omitempty"`
+	DenomForceTransferred *DenomForceTransferredHook `json:"denom_force_transferred,omitempty"`
+	DenomAdminChanged     *DenomAdminChangedHook     `json:"denom_admin_changed,omitempty"`
+	DenomMetadataChanged  *DenomMetadataChangedHook  `json:"denom_metadata_changed,omitempty"`
+}
+
+type DenomCreatedHook struct {
+	Denom   string `json:"denom"`
+	Creator string `json:"creator"`
+}
+
+type DenomMintedHook struct {
+	Denom         string      `json:"denom"`
+	Amount        sdkmath.Int `json:"amount"`
+	MintToAddress string      `json:"mint_to_address"`
+}
+
+type DenomBurnedHook struct {
+	Denom           string      `json:"denom"`
+	Amount          sdkmath.Int `json:"amount"`
+	BurnFromAddress string      `json:"burn_from_address"`
+}
+
+type DenomForceTransferredHook struct {
+	Denom       string      `json:"denom"`
+	Amount      sdkmath.Int `json:"amount"`
+	FromAddress string      `json:"from_address"`
+	ToAddress   string      `json:"to_address"`
+}
+
+type DenomAdminChangedHook struct {
+	Denom         string `json:"denom"`
+	PreviousAdmin string `json:"previous_admin"`
+	// NewAdmin is empty when the admin has been renounced.
+	NewAdmin string `json:"new_admin"`
+}
+
+type DenomMetadataChangedHook struct {
+	Denom    string             `json:"denom"`
+	Metadata banktypes.Metadata `json:"metadata"`
+}
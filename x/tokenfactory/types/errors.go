@@ -20,4 +20,35 @@ var (
 	ErrCreatorTooLong           = errorsmod.Register(ModuleName, 9, fmt.Sprintf("creator too long, max length is %d bytes", MaxCreatorLength))
 	ErrDenomDoesNotExist        = errorsmod.Register(ModuleName, 10, "denom does not exist")
 	ErrCapabilityNotEnabled     = errorsmod.Register(ModuleName, 11, "this capability is not enabled on chain")
+	ErrDenomHookFailed          = errorsmod.Register(ModuleName, 12, "denom hook call failed")
+	ErrBeforeSendHookFailed     = errorsmod.Register(ModuleName, 13, "before send hook call failed")
+	ErrClaimCampaignNotFound    = errorsmod.Register(ModuleName, 14, "claim campaign not found")
+	ErrClaimCampaignInactive    = errorsmod.Register(ModuleName, 15, "claim campaign is not active")
+	ErrInvalidClaimProof        = errorsmod.Register(ModuleName, 16, "invalid claim proof")
+	ErrAlreadyClaimed           = errorsmod.Register(ModuleName, 17, "address has already claimed")
+	ErrClaimCapExceeded         = errorsmod.Register(ModuleName, 18, "claim exceeds the campaign total cap")
+	ErrVoucherSignerNotSet      = errorsmod.Register(ModuleName, 19, "denom has no voucher signer")
+	ErrInvalidVoucherSignature  = errorsmod.Register(ModuleName, 20, "invalid mint voucher signature")
+	ErrVoucherExpired           = errorsmod.Register(ModuleName, 21, "mint voucher has expired")
+	ErrVoucherNonceUsed         = errorsmod.Register(ModuleName, 22, "mint voucher nonce has already been redeemed")
+	ErrDuplicateReferenceID     = errorsmod.Register(ModuleName, 23, "reference id has already been used for this denom")
+	ErrReferenceIDNotFound      = errorsmod.Register(ModuleName, 24, "reference id not found")
+	ErrReserveCeilingExceeded   = errorsmod.Register(ModuleName, 25, "mint would push the supply above the attested reserve")
+	ErrStaleReserveAttestation  = errorsmod.Register(ModuleName, 26, "reserve attestation is missing or stale")
+	ErrInvalidAttestation       = errorsmod.Register(ModuleName, 27, "invalid reserve attestation")
+	ErrRedemptionNotFound       = errorsmod.Register(ModuleName, 28, "redemption request not found")
+	ErrRedemptionNotOpen        = errorsmod.Register(ModuleName, 29, "redemption request is not open")
+	ErrVaultNotFound            = errorsmod.Register(ModuleName, 30, "vault not found")
+	ErrVaultBacking             = errorsmod.Register(ModuleName, 31, "supply of the vault denom does not match its escrowed backing")
+	ErrInvalidVault             = errorsmod.Register(ModuleName, 32, "invalid vault")
+	ErrCW20BridgeNotFound       = errorsmod.Register(ModuleName, 33, "cw20 bridge not found")
+	ErrCW20Backing              = errorsmod.Register(ModuleName, 34, "supply of the bridged denom does not match its escrowed cw20 tokens")
+	ErrInvalidCW20Bridge        = errorsmod.Register(ModuleName, 35, "invalid cw20 bridge")
+	ErrBasketNotFound           = errorsmod.Register(ModuleName, 36, "basket not found")
+	ErrBasketBacking            = errorsmod.Register(ModuleName, 37, "supply of the basket denom does not match its deposits")
+	ErrInvalidBasket            = errorsmod.Register(ModuleName, 38, "invalid basket")
+	ErrInvalidVesting           = errorsmod.Register(ModuleName, 39, "invalid vesting schedule")
+	ErrVestingNotFound          = errorsmod.Register(ModuleName, 40, "vesting schedule not found")
+	ErrVestingLocked            = errorsmod.Register(ModuleName, 41, "tokens are locked by a vesting schedule")
+	ErrContractNotFound         = errorsmod.Register(ModuleName, 42, "contract not found")
 )
@@ -2,6 +2,10 @@ package types
 
 // event types
 //
+// Deprecated: the untyped events built from these attributes are emitted
+// alongside the typed events defined in events.proto and will be removed in
+// the next release. Indexers should consume the typed events instead.
+//
 //nolint:gosec
 const (
 	AttributeAmount              = "amount"
@@ -2,6 +2,7 @@ package types
 
 import (
 	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -28,6 +29,7 @@ func (gs GenesisState) Validate() error {
 	}
 
 	seenDenoms := map[string]bool{}
+	seenCW20Contracts := map[string]bool{}
 
 	for _, denom := range gs.GetFactoryDenoms() {
 		if seenDenoms[denom.GetDenom()] {
@@ -46,6 +48,197 @@ func (gs GenesisState) Validate() error {
 				return errorsmod.Wrapf(ErrInvalidAuthorityMetadata, "Invalid admin address (%s)", err)
 			}
 		}
+
+		if denom.DenomHook != nil {
+			if err := denom.DenomHook.Validate(); err != nil {
+				return errorsmod.Wrapf(ErrInvalidGenesis, "denom %s: %s", denom.GetDenom(), err)
+			}
+		}
+
+		if denom.BeforeSendHookAddress != "" {
+			if _, err := sdk.AccAddressFromBech32(denom.BeforeSendHookAddress); err != nil {
+				return errorsmod.Wrapf(ErrInvalidGenesis, "denom %s: invalid before send hook address (%s)", denom.GetDenom(), err)
+			}
+		}
+
+		if err := ValidateVoucherSigner(denom.VoucherSigner); err != nil {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "denom %s: %s", denom.GetDenom(), err)
+		}
+
+		seenNonces := map[uint64]bool{}
+		for _, nonce := range denom.UsedVoucherNonces {
+			if seenNonces[nonce] {
+				return errorsmod.Wrapf(ErrInvalidGenesis, "denom %s: duplicate voucher nonce %d", denom.GetDenom(), nonce)
+			}
+			seenNonces[nonce] = true
+		}
+
+		seenReferenceIDs := map[string]bool{}
+		for _, record := range denom.ReferenceIds {
+			if record.ReferenceId == "" {
+				return errorsmod.Wrapf(ErrInvalidGenesis, "denom %s: empty reference id", denom.GetDenom())
+			}
+			if err := ValidateReferenceID(record.ReferenceId); err != nil {
+				return errorsmod.Wrapf(ErrInvalidGenesis, "denom %s: %s", denom.GetDenom(), err)
+			}
+			if seenReferenceIDs[record.ReferenceId] {
+				return errorsmod.Wrapf(ErrInvalidGenesis, "denom %s: duplicate reference id %s", denom.GetDenom(), record.ReferenceId)
+			}
+			if record.Height < 0 {
+				return errorsmod.Wrapf(ErrInvalidGenesis, "denom %s: negative height of reference id %s", denom.GetDenom(), record.ReferenceId)
+			}
+			seenReferenceIDs[record.ReferenceId] = true
+		}
+
+		if attestor := denom.ReserveAttestor; attestor != nil {
+			if attestor.Attestor == "" {
+				return errorsmod.Wrapf(ErrInvalidGenesis, "denom %s: empty reserve attestor", denom.GetDenom())
+			}
+			if err := ValidateReserveAttestor(attestor.Attestor, attestor.MaxStaleness); err != nil {
+				return errorsmod.Wrapf(ErrInvalidGenesis, "denom %s: %s", denom.GetDenom(), err)
+			}
+		}
+
+		for i, attestation := range denom.ReserveAttestations {
+			if err := attestation.Validate(); err != nil {
+				return errorsmod.Wrapf(ErrInvalidGenesis, "denom %s: reserve attestation %d: %s", denom.GetDenom(), attestation.Id, err)
+			}
+			if i > 0 {
+				previous := denom.ReserveAttestations[i-1]
+				if attestation.Id <= previous.Id {
+					return errorsmod.Wrapf(ErrInvalidGenesis, "denom %s: reserve attestations must be ordered by increasing id", denom.GetDenom())
+				}
+				if attestation.Timestamp.Before(previous.Timestamp) {
+					return errorsmod.Wrapf(ErrInvalidGenesis, "denom %s: reserve attestation %d is older than attestation %d", denom.GetDenom(), attestation.Id, previous.Id)
+				}
+			}
+		}
+
+		for i, redemption := range denom.Redemptions {
+			if err := redemption.Validate(); err != nil {
+				return errorsmod.Wrapf(ErrInvalidGenesis, "denom %s: redemption %d: %s", denom.GetDenom(), redemption.Id, err)
+			}
+			if redemption.Amount.Denom != denom.GetDenom() {
+				return errorsmod.Wrapf(ErrInvalidGenesis, "denom %s: redemption %d is of denom %s", denom.GetDenom(), redemption.Id, redemption.Amount.Denom)
+			}
+			if i > 0 && redemption.Id <= denom.Redemptions[i-1].Id {
+				return errorsmod.Wrapf(ErrInvalidGenesis, "denom %s: redemptions must be ordered by increasing id", denom.GetDenom())
+			}
+		}
+
+		if vault := denom.Vault; vault != nil {
+			if err := vault.Validate(); err != nil {
+				return errorsmod.Wrapf(ErrInvalidGenesis, "denom %s: vault: %s", denom.GetDenom(), err)
+			}
+			if vault.Denom != denom.GetDenom() {
+				return errorsmod.Wrapf(ErrInvalidGenesis, "denom %s: vault is of denom %s", denom.GetDenom(), vault.Denom)
+			}
+		}
+
+		if bridge := denom.Cw20Bridge; bridge != nil {
+			if err := bridge.Validate(); err != nil {
+				return errorsmod.Wrapf(ErrInvalidGenesis, "denom %s: cw20 bridge: %s", denom.GetDenom(), err)
+			}
+			if bridge.Denom != denom.GetDenom() {
+				return errorsmod.Wrapf(ErrInvalidGenesis, "denom %s: cw20 bridge is of denom %s", denom.GetDenom(), bridge.Denom)
+			}
+			if denom.Vault != nil {
+				return errorsmod.Wrapf(ErrInvalidGenesis, "denom %s: a vault denom can not be bound to a cw20 contract", denom.GetDenom())
+			}
+			if seenCW20Contracts[bridge.ContractAddress] {
+				return errorsmod.Wrapf(ErrInvalidGenesis, "cw20 contract %s is bound to several denoms", bridge.ContractAddress)
+			}
+			seenCW20Contracts[bridge.ContractAddress] = true
+		}
+
+		if basket := denom.Basket; basket != nil {
+			if err := basket.Validate(); err != nil {
+				return errorsmod.Wrapf(ErrInvalidGenesis, "denom %s: basket: %s", denom.GetDenom(), err)
+			}
+			if basket.Denom != denom.GetDenom() {
+				return errorsmod.Wrapf(ErrInvalidGenesis, "denom %s: basket is of denom %s", denom.GetDenom(), basket.Denom)
+			}
+			if denom.Vault != nil || denom.Cw20Bridge != nil {
+				return errorsmod.Wrapf(ErrInvalidGenesis, "denom %s: a basket denom can not be a vault or bound to a cw20 contract", denom.GetDenom())
+			}
+		}
+
+		for i, schedule := range denom.VestingSchedules {
+			if err := schedule.Validate(); err != nil {
+				return errorsmod.Wrapf(ErrInvalidGenesis, "denom %s: vesting schedule of %s: %s", denom.GetDenom(), schedule.Recipient, err)
+			}
+			if schedule.Denom != denom.GetDenom() {
+				return errorsmod.Wrapf(ErrInvalidGenesis, "denom %s: vesting schedule is of denom %s", denom.GetDenom(), schedule.Denom)
+			}
+			if i > 0 && schedule.Recipient <= denom.VestingSchedules[i-1].Recipient {
+				return errorsmod.Wrapf(ErrInvalidGenesis, "denom %s: vesting schedules must be ordered by increasing recipient", denom.GetDenom())
+			}
+		}
+	}
+
+	return gs.validateClaims(seenDenoms)
+}
+
+// validateClaims validates the claim campaigns of the factory denoms, and that the claimed
+// amount of every campaign is the sum of its claim records.
+func (gs GenesisState) validateClaims(denoms map[string]bool) error {
+	campaigns := map[uint64]ClaimCampaign{}
+	for _, campaign := range gs.GetClaimCampaigns() {
+		if campaign.Id == 0 {
+			return errorsmod.Wrap(ErrInvalidGenesis, "claim campaign id must be positive")
+		}
+		if _, found := campaigns[campaign.Id]; found {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "duplicate claim campaign: %d", campaign.Id)
+		}
+		if !denoms[campaign.Denom] {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "claim campaign %d: unknown denom %s", campaign.Id, campaign.Denom)
+		}
+		if _, err := sdk.AccAddressFromBech32(campaign.Creator); err != nil {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "claim campaign %d: invalid creator address (%s)", campaign.Id, err)
+		}
+		if len(campaign.MerkleRoot) != ClaimMerkleRootLength {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "claim campaign %d: merkle root must be %d bytes", campaign.Id, ClaimMerkleRootLength)
+		}
+		if campaign.TotalCap.IsNil() || !campaign.TotalCap.IsPositive() {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "claim campaign %d: total cap must be positive", campaign.Id)
+		}
+		if campaign.Claimed.IsNil() || campaign.Claimed.IsNegative() || campaign.Claimed.GT(campaign.TotalCap) {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "claim campaign %d: claimed must be between 0 and the total cap", campaign.Id)
+		}
+		campaigns[campaign.Id] = campaign
+	}
+
+	claimed := map[uint64]sdkmath.Int{}
+	seenRecords := map[uint64]map[string]bool{}
+	for _, record := range gs.GetClaimRecords() {
+		if _, found := campaigns[record.CampaignId]; !found {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "claim record of unknown campaign %d", record.CampaignId)
+		}
+		if _, err := sdk.AccAddressFromBech32(record.Address); err != nil {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "claim record of campaign %d: invalid address (%s)", record.CampaignId, err)
+		}
+		if seenRecords[record.CampaignId] == nil {
+			seenRecords[record.CampaignId] = map[string]bool{}
+			claimed[record.CampaignId] = sdkmath.ZeroInt()
+		}
+		if seenRecords[record.CampaignId][record.Address] {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "duplicate claim record of %s in campaign %d", record.Address, record.CampaignId)
+		}
+		seenRecords[record.CampaignId][record.Address] = true
+		if record.Amount.IsNil() || !record.Amount.IsPositive() {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "claim record of %s in campaign %d: amount must be positive", record.Address, record.CampaignId)
+		}
+		claimed[record.CampaignId] = claimed[record.CampaignId].Add(record.Amount)
+	}
+
+	for id, campaign := range campaigns {
+		total, found := claimed[id]
+		if !found {
+			total = sdkmath.ZeroInt()
+		}
+		if !campaign.Claimed.Equal(total) {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "claim campaign %d: claimed %s is not the sum of its claim records %s", id, campaign.Claimed, total)
+		}
 	}
 
 	return nil
@@ -4,6 +4,7 @@
 package types
 
 import (
+	bytes "bytes"
 	fmt "fmt"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -28,6 +29,10 @@ type GenesisState struct {
 	// params defines the parameters of the module.
 	Params        Params         `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	FactoryDenoms []GenesisDenom `protobuf:"bytes,2,rep,name=factory_denoms,json=factoryDenoms,proto3" json:"factory_denoms" yaml:"factory_denoms"`
+	// claim_campaigns are the claim campaigns, with their claimed amount.
+	ClaimCampaigns []ClaimCampaign `protobuf:"bytes,3,rep,name=claim_campaigns,json=claimCampaigns,proto3" json:"claim_campaigns" yaml:"claim_campaigns"`
+	// claim_records are the claims of all the campaigns.
+	ClaimRecords []ClaimRecord `protobuf:"bytes,4,rep,name=claim_records,json=claimRecords,proto3" json:"claim_records" yaml:"claim_records"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -77,12 +82,55 @@ func (m *GenesisState) GetFactoryDenoms() []GenesisDenom {
 	return nil
 }
 
+func (m *GenesisState) GetClaimCampaigns() []ClaimCampaign {
+	if m != nil {
+		return m.ClaimCampaigns
+	}
+	return nil
+}
+
+func (m *GenesisState) GetClaimRecords() []ClaimRecord {
+	if m != nil {
+		return m.ClaimRecords
+	}
+	return nil
+}
+
 // GenesisDenom defines a tokenfactory denom that is defined within genesis
 // state. The structure contains DenomAuthorityMetadata which defines the
 // denom's admin.
 type GenesisDenom struct {
 	Denom             string                 `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
 	AuthorityMetadata DenomAuthorityMetadata `protobuf:"bytes,2,opt,name=authority_metadata,json=authorityMetadata,proto3" json:"authority_metadata" yaml:"authority_metadata"`
+	// denom_hook is the optional hook contract of the denom.
+	DenomHook *DenomHook `protobuf:"bytes,3,opt,name=denom_hook,json=denomHook,proto3" json:"denom_hook,omitempty" yaml:"denom_hook"`
+	// before_send_hook_address is the optional before send hook contract of the
+	// denom.
+	BeforeSendHookAddress string `protobuf:"bytes,4,opt,name=before_send_hook_address,json=beforeSendHookAddress,proto3" json:"before_send_hook_address,omitempty" yaml:"before_send_hook_address"`
+	// voucher_signer is the optional public key that signs the mint vouchers of
+	// the denom.
+	VoucherSigner []byte `protobuf:"bytes,5,opt,name=voucher_signer,json=voucherSigner,proto3" json:"voucher_signer,omitempty" yaml:"voucher_signer"`
+	// used_voucher_nonces are the redeemed mint voucher nonces of the denom.
+	UsedVoucherNonces []uint64 `protobuf:"varint,6,rep,packed,name=used_voucher_nonces,json=usedVoucherNonces,proto3" json:"used_voucher_nonces,omitempty" yaml:"used_voucher_nonces"`
+	// reference_ids are the reference ids of MsgMint and MsgBurn used for the
+	// denom, and not pruned yet.
+	ReferenceIds []ReferenceIDRecord `protobuf:"bytes,7,rep,name=reference_ids,json=referenceIds,proto3" json:"reference_ids" yaml:"reference_ids"`
+	// reserve_attestor is the optional reserve attestor of the denom.
+	ReserveAttestor *ReserveAttestor `protobuf:"bytes,8,opt,name=reserve_attestor,json=reserveAttestor,proto3" json:"reserve_attestor,omitempty" yaml:"reserve_attestor"`
+	// reserve_attestations are the reserve attestations of the denom, ordered by
+	// id.
+	ReserveAttestations []ReserveAttestation `protobuf:"bytes,9,rep,name=reserve_attestations,json=reserveAttestations,proto3" json:"reserve_attestations" yaml:"reserve_attestations"`
+	// redemptions are the redemption requests of the denom, ordered by id.
+	Redemptions []RedemptionRequest `protobuf:"bytes,10,rep,name=redemptions,proto3" json:"redemptions" yaml:"redemptions"`
+	// vault is the vault of the denom, if the denom was created as a vault.
+	Vault *Vault `protobuf:"bytes,11,opt,name=vault,proto3" json:"vault,omitempty" yaml:"vault"`
+	// cw20_bridge is the CW20 contract the denom is bound to, if any.
+	Cw20Bridge *CW20Bridge `protobuf:"bytes,12,opt,name=cw20_bridge,json=cw20Bridge,proto3" json:"cw20_bridge,omitempty" yaml:"cw20_bridge"`
+	// basket is the basket of the denom, if the denom was created as a basket.
+	Basket *Basket `protobuf:"bytes,13,opt,name=basket,proto3" json:"basket,omitempty" yaml:"basket"`
+	// vesting_schedules are the vesting schedules of the denom, ordered by
+	// recipient.
+	VestingSchedules []VestingSchedule `protobuf:"bytes,14,rep,name=vesting_schedules,json=vestingSchedules,proto3" json:"vesting_schedules" yaml:"vesting_schedules"`
 }
 
 func (m *GenesisDenom) Reset()         { *m = GenesisDenom{} }
@@ -132,9 +180,148 @@ func (m *GenesisDenom) GetAuthorityMetadata() DenomAuthorityMetadata {
 	return DenomAuthorityMetadata{}
 }
 
+func (m *GenesisDenom) GetDenomHook() *DenomHook {
+	if m != nil {
+		return m.DenomHook
+	}
+	return nil
+}
+
+func (m *GenesisDenom) GetBeforeSendHookAddress() string {
+	if m != nil {
+		return m.BeforeSendHookAddress
+	}
+	return ""
+}
+
+func (m *GenesisDenom) GetVoucherSigner() []byte {
+	if m != nil {
+		return m.VoucherSigner
+	}
+	return nil
+}
+
+func (m *GenesisDenom) GetUsedVoucherNonces() []uint64 {
+	if m != nil {
+		return m.UsedVoucherNonces
+	}
+	return nil
+}
+
+func (m *GenesisDenom) GetReferenceIds() []ReferenceIDRecord {
+	if m != nil {
+		return m.ReferenceIds
+	}
+	return nil
+}
+
+func (m *GenesisDenom) GetReserveAttestor() *ReserveAttestor {
+	if m != nil {
+		return m.ReserveAttestor
+	}
+	return nil
+}
+
+func (m *GenesisDenom) GetReserveAttestations() []ReserveAttestation {
+	if m != nil {
+		return m.ReserveAttestations
+	}
+	return nil
+}
+
+func (m *GenesisDenom) GetRedemptions() []RedemptionRequest {
+	if m != nil {
+		return m.Redemptions
+	}
+	return nil
+}
+
+func (m *GenesisDenom) GetVault() *Vault {
+	if m != nil {
+		return m.Vault
+	}
+	return nil
+}
+
+func (m *GenesisDenom) GetCw20Bridge() *CW20Bridge {
+	if m != nil {
+		return m.Cw20Bridge
+	}
+	return nil
+}
+
+func (m *GenesisDenom) GetBasket() *Basket {
+	if m != nil {
+		return m.Basket
+	}
+	return nil
+}
+
+func (m *GenesisDenom) GetVestingSchedules() []VestingSchedule {
+	if m != nil {
+		return m.VestingSchedules
+	}
+	return nil
+}
+
+// ReferenceIDRecord is a reference id used by a MsgMint or a MsgBurn of a
+// denom, with the height of the block it was used in.
+type ReferenceIDRecord struct {
+	ReferenceId string `protobuf:"bytes,1,opt,name=reference_id,json=referenceId,proto3" json:"reference_id,omitempty" yaml:"reference_id"`
+	Height      int64  `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty" yaml:"height"`
+}
+
+func (m *ReferenceIDRecord) Reset()         { *m = ReferenceIDRecord{} }
+func (m *ReferenceIDRecord) String() string { return proto.CompactTextString(m) }
+func (*ReferenceIDRecord) ProtoMessage()    {}
+func (*ReferenceIDRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5749c3f71850298b, []int{2}
+}
+func (m *ReferenceIDRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReferenceIDRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReferenceIDRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReferenceIDRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReferenceIDRecord.Merge(m, src)
+}
+func (m *ReferenceIDRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReferenceIDRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReferenceIDRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReferenceIDRecord proto.InternalMessageInfo
+
+func (m *ReferenceIDRecord) GetReferenceId() string {
+	if m != nil {
+		return m.ReferenceId
+	}
+	return ""
+}
+
+func (m *ReferenceIDRecord) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "osmosis.tokenfactory.v1beta1.GenesisState")
 	proto.RegisterType((*GenesisDenom)(nil), "osmosis.tokenfactory.v1beta1.GenesisDenom")
+	proto.RegisterType((*ReferenceIDRecord)(nil), "osmosis.tokenfactory.v1beta1.ReferenceIDRecord")
 }
 
 func init() {
@@ -142,30 +329,68 @@ func init() {
 }
 
 var fileDescriptor_5749c3f71850298b = []byte{
-	// 356 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0xca, 0x2f, 0xce, 0xcd,
-	0x2f, 0xce, 0x2c, 0xd6, 0x2f, 0xc9, 0xcf, 0x4e, 0xcd, 0x4b, 0x4b, 0x4c, 0x2e, 0xc9, 0x2f, 0xaa,
-	0xd4, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c,
-	0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x92, 0x81, 0xaa, 0xd5, 0x43, 0x56, 0xab, 0x07, 0x55,
-	0x2b, 0x25, 0x92, 0x9e, 0x9f, 0x9e, 0x0f, 0x56, 0xa8, 0x0f, 0x62, 0x41, 0xf4, 0x48, 0x99, 0xe0,
-	0x35, 0x3f, 0xb1, 0xb4, 0x24, 0x23, 0xbf, 0x28, 0xb3, 0xa4, 0xd2, 0x37, 0xb5, 0x24, 0x31, 0x25,
-	0xb1, 0x24, 0x11, 0xaa, 0x4b, 0x13, 0xaf, 0xae, 0x82, 0xc4, 0xa2, 0xc4, 0x5c, 0xa8, 0xa3, 0x94,
-	0x8e, 0x30, 0x72, 0xf1, 0xb8, 0x43, 0x9c, 0x19, 0x5c, 0x92, 0x58, 0x92, 0x2a, 0xe4, 0xc4, 0xc5,
-	0x06, 0x51, 0x20, 0xc1, 0xa8, 0xc0, 0xa8, 0xc1, 0x6d, 0xa4, 0xa2, 0x87, 0xcf, 0xd9, 0x7a, 0x01,
-	0x60, 0xb5, 0x4e, 0x2c, 0x27, 0xee, 0xc9, 0x33, 0x04, 0x41, 0x75, 0x0a, 0x15, 0x70, 0xf1, 0x41,
-	0xd5, 0xc5, 0xa7, 0xa4, 0xe6, 0xe5, 0xe7, 0x16, 0x4b, 0x30, 0x29, 0x30, 0x6b, 0x70, 0x1b, 0x69,
-	0xe1, 0x37, 0x0b, 0xea, 0x0e, 0x17, 0x90, 0x16, 0x27, 0x59, 0x90, 0x89, 0x9f, 0xee, 0xc9, 0x8b,
-	0x56, 0x26, 0xe6, 0xe6, 0x58, 0x29, 0xa1, 0x9a, 0xa7, 0x14, 0xc4, 0x0b, 0x15, 0x70, 0x81, 0xf0,
-	0x8f, 0x22, 0xbc, 0x01, 0x16, 0x11, 0x52, 0xe3, 0x62, 0x05, 0x2b, 0x05, 0xfb, 0x82, 0xd3, 0x49,
-	0xe0, 0xd3, 0x3d, 0x79, 0x1e, 0x88, 0x49, 0x60, 0x61, 0xa5, 0x20, 0x88, 0xb4, 0x50, 0x1b, 0x23,
-	0x97, 0x10, 0x3c, 0x18, 0xe3, 0x73, 0xa1, 0xe1, 0x28, 0xc1, 0x04, 0xf6, 0xbb, 0x09, 0x7e, 0xf7,
-	0x82, 0x6d, 0x72, 0x44, 0x8f, 0x03, 0x27, 0x45, 0xa8, 0xcb, 0x25, 0x21, 0xf6, 0x61, 0x9a, 0xae,
-	0x14, 0x24, 0x88, 0x11, 0x73, 0x56, 0x2c, 0x2f, 0x16, 0xc8, 0x33, 0x3a, 0xf9, 0x9e, 0x78, 0x24,
-	0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78,
-	0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x71, 0x7a, 0x66, 0x49, 0x46, 0x69, 0x92, 0x5e,
-	0x72, 0x7e, 0xae, 0x7e, 0x32, 0xd8, 0x59, 0xa8, 0xb1, 0x5b, 0x81, 0xca, 0x2d, 0xa9, 0x2c, 0x48,
-	0x2d, 0x4e, 0x62, 0x03, 0x47, 0xb2, 0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0xfb, 0x7d, 0x5a, 0x33,
-	0xa7, 0x02, 0x00, 0x00,
+	// 961 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0x4f, 0x6f, 0x1b, 0x45,
+	0x14, 0xcf, 0xd6, 0x49, 0x20, 0x63, 0x3b, 0x8d, 0x27, 0x49, 0xbb, 0x4d, 0x8b, 0xd7, 0x4c, 0x10,
+	0x75, 0x82, 0x6a, 0xa7, 0x69, 0x4f, 0x39, 0x91, 0x69, 0x25, 0xa8, 0x50, 0x0b, 0x9a, 0x48, 0x41,
+	0x42, 0xa0, 0xd5, 0x78, 0x77, 0x62, 0xaf, 0xe2, 0xdd, 0x31, 0x33, 0x63, 0x43, 0x24, 0x0e, 0x9c,
+	0x10, 0x47, 0x3e, 0x02, 0x47, 0x3e, 0x4a, 0x8f, 0x3d, 0x72, 0xb2, 0x50, 0x72, 0xe1, 0xec, 0x13,
+	0x47, 0xb4, 0x33, 0xe3, 0x3f, 0x1b, 0x87, 0x8d, 0xb9, 0xed, 0xbe, 0xf9, 0xfd, 0x99, 0x79, 0xf3,
+	0xde, 0x1b, 0xb0, 0xcf, 0x65, 0xcc, 0x65, 0x24, 0x9b, 0x8a, 0x9f, 0xb3, 0xe4, 0x8c, 0x06, 0x8a,
+	0x8b, 0x8b, 0xe6, 0xe0, 0x69, 0x8b, 0x29, 0xfa, 0xb4, 0xd9, 0x66, 0x09, 0x93, 0x91, 0x6c, 0xf4,
+	0x04, 0x57, 0x1c, 0x3e, 0xb2, 0xd8, 0xc6, 0x2c, 0xb6, 0x61, 0xb1, 0x3b, 0x5b, 0x6d, 0xde, 0xe6,
+	0x1a, 0xd8, 0x4c, 0xbf, 0x0c, 0x67, 0xe7, 0x79, 0xae, 0x3e, 0xed, 0xab, 0x0e, 0x17, 0x91, 0xba,
+	0x78, 0xcd, 0x14, 0x0d, 0xa9, 0xa2, 0x96, 0xb5, 0x97, 0xcb, 0x6a, 0x51, 0x79, 0xce, 0x94, 0x85,
+	0xd6, 0x73, 0xa1, 0x41, 0x97, 0x46, 0xb1, 0x45, 0x3e, 0xce, 0x47, 0xfe, 0x70, 0x78, 0x60, 0x81,
+	0x4f, 0x72, 0x81, 0x21, 0x4b, 0x78, 0xec, 0x77, 0x38, 0x3f, 0x5f, 0x68, 0xb3, 0x3d, 0x2a, 0x68,
+	0x2c, 0x17, 0x52, 0x16, 0x2c, 0x64, 0x71, 0x4f, 0x45, 0x3c, 0xb1, 0xf0, 0xfd, 0x5b, 0xe0, 0x92,
+	0x89, 0x01, 0x5b, 0x28, 0x0f, 0x03, 0xda, 0xef, 0xaa, 0x85, 0x54, 0x07, 0x4c, 0xaa, 0x28, 0x69,
+	0x1b, 0x2c, 0xfa, 0xa3, 0x00, 0x4a, 0x9f, 0x99, 0x22, 0x38, 0x51, 0x54, 0x31, 0x88, 0xc1, 0xaa,
+	0x39, 0x91, 0xeb, 0xd4, 0x9c, 0x7a, 0xf1, 0xf0, 0xa3, 0x46, 0x5e, 0x51, 0x34, 0xbe, 0xd2, 0x58,
+	0xbc, 0xfc, 0x76, 0xe8, 0x2d, 0x11, 0xcb, 0x84, 0x3d, 0xb0, 0x6e, 0x71, 0xbe, 0x4e, 0xa6, 0x74,
+	0xef, 0xd4, 0x0a, 0xf5, 0xe2, 0xe1, 0x7e, 0xbe, 0x96, 0xdd, 0xc7, 0xcb, 0x94, 0x82, 0x3f, 0x48,
+	0x15, 0x47, 0x43, 0x6f, 0xfb, 0x82, 0xc6, 0xdd, 0x23, 0x94, 0xd5, 0x43, 0xa4, 0x6c, 0x03, 0x1a,
+	0x2c, 0xa1, 0x02, 0x77, 0x75, 0x25, 0xf8, 0x01, 0x8d, 0x7b, 0x34, 0x6a, 0x27, 0xd2, 0x2d, 0x68,
+	0xcb, 0x4f, 0xf2, 0x2d, 0x5f, 0xa4, 0xa4, 0x17, 0x96, 0x83, 0xab, 0xd6, 0xf3, 0x9e, 0xf1, 0xbc,
+	0xa6, 0x88, 0xc8, 0x7a, 0x30, 0x0b, 0x97, 0xb0, 0x0b, 0xca, 0x06, 0x23, 0x58, 0xc0, 0x45, 0x28,
+	0xdd, 0x65, 0xed, 0xb9, 0xb7, 0x80, 0x27, 0xd1, 0x0c, 0xfc, 0xc8, 0x3a, 0x6e, 0xcd, 0x3a, 0x5a,
+	0x35, 0x44, 0x4a, 0xc1, 0x14, 0x2a, 0xd1, 0x3f, 0x60, 0x72, 0x55, 0xfa, 0xd4, 0xf0, 0x63, 0xb0,
+	0xa2, 0xd3, 0xa1, 0x6f, 0x6a, 0x0d, 0x6f, 0x8c, 0x86, 0x5e, 0xc9, 0xe8, 0xe8, 0x30, 0x22, 0x66,
+	0x19, 0xfe, 0xe2, 0x00, 0x38, 0x69, 0x44, 0x3f, 0xb6, 0x9d, 0xe8, 0xde, 0xd1, 0xf7, 0xfb, 0x3c,
+	0x7f, 0xb3, 0xda, 0xe9, 0xf8, 0x7a, 0x17, 0xe3, 0x0f, 0xed, 0xbe, 0x1f, 0x18, 0xbf, 0x79, 0x75,
+	0x44, 0x2a, 0x73, 0xbd, 0x0f, 0xbf, 0x03, 0x60, 0xda, 0x5c, 0x6e, 0x41, 0xfb, 0x3f, 0x5e, 0xc0,
+	0xff, 0x73, 0xce, 0xcf, 0xf1, 0xf6, 0x68, 0xe8, 0x55, 0x66, 0x8e, 0xa7, 0x45, 0x10, 0x59, 0x0b,
+	0xc7, 0x08, 0xf8, 0x2d, 0x70, 0x5b, 0xec, 0x8c, 0x0b, 0xe6, 0x4b, 0x96, 0x84, 0x7a, 0xdd, 0xa7,
+	0x61, 0x28, 0x98, 0x4c, 0x6f, 0x26, 0x4d, 0xd1, 0xee, 0x68, 0xe8, 0x79, 0x46, 0xe3, 0xbf, 0x90,
+	0x88, 0x6c, 0x9b, 0xa5, 0x13, 0x96, 0x84, 0xa9, 0xec, 0xb1, 0x89, 0xc3, 0x4f, 0xc1, 0xfa, 0x80,
+	0xf7, 0x83, 0x0e, 0x13, 0xbe, 0x8c, 0xda, 0x09, 0x13, 0xee, 0x4a, 0xcd, 0xa9, 0x97, 0xf0, 0x83,
+	0x69, 0x91, 0x66, 0xd7, 0x11, 0x29, 0xdb, 0xc0, 0x89, 0xfe, 0x87, 0x6f, 0xc0, 0x66, 0x5f, 0xb2,
+	0xd0, 0x1f, 0xc3, 0x12, 0x9e, 0x04, 0x4c, 0xba, 0xab, 0xb5, 0x42, 0x7d, 0x19, 0x57, 0x47, 0x43,
+	0x6f, 0xc7, 0xc8, 0xdc, 0x00, 0x42, 0xa4, 0x92, 0x46, 0x4f, 0x4d, 0xf0, 0x8d, 0x8e, 0x41, 0x01,
+	0xca, 0x82, 0x9d, 0x31, 0xc1, 0x92, 0x80, 0xf9, 0x51, 0x28, 0xdd, 0xf7, 0x74, 0xf9, 0x35, 0xf3,
+	0x33, 0x4a, 0xc6, 0x94, 0x57, 0x2f, 0x6f, 0x2e, 0xc2, 0x8c, 0x26, 0x22, 0xa5, 0xc9, 0xff, 0xab,
+	0x50, 0xc2, 0x3e, 0xd8, 0xb0, 0x63, 0xc9, 0xa7, 0x4a, 0x31, 0xa9, 0xb8, 0x70, 0xdf, 0xd7, 0x17,
+	0xf9, 0xe4, 0x36, 0x5b, 0xcd, 0x3a, 0xb6, 0x24, 0xfc, 0x70, 0x34, 0xf4, 0xee, 0x8f, 0x0d, 0xb3,
+	0x82, 0x88, 0xdc, 0x15, 0x59, 0x34, 0xfc, 0xd5, 0x01, 0x5b, 0x59, 0x18, 0x4d, 0xc7, 0xa8, 0x74,
+	0xd7, 0xf4, 0x91, 0x0f, 0xfe, 0x87, 0xb7, 0x26, 0xe2, 0x5d, 0x7b, 0xe6, 0x87, 0x37, 0x6d, 0xc1,
+	0x68, 0x23, 0xb2, 0x29, 0xe6, 0x88, 0x12, 0xc6, 0xa0, 0x38, 0x9d, 0xe3, 0xd2, 0x05, 0x8b, 0xe5,
+	0x7c, 0x4c, 0x20, 0xec, 0xfb, 0x3e, 0x93, 0x0a, 0xef, 0x58, 0x7f, 0x38, 0xf6, 0x9f, 0x28, 0x22,
+	0x32, 0xab, 0x0f, 0xbf, 0x00, 0x2b, 0x7a, 0xb6, 0xbb, 0x45, 0x9d, 0xe5, 0xdd, 0x7c, 0xa3, 0xd3,
+	0x14, 0x3a, 0x3b, 0x09, 0x34, 0x17, 0x11, 0xa3, 0x01, 0x29, 0x28, 0xa6, 0xcf, 0xa0, 0xdf, 0x12,
+	0x51, 0xd8, 0x66, 0x6e, 0x49, 0x4b, 0xd6, 0x6f, 0x19, 0x57, 0x5f, 0x1f, 0x1e, 0x60, 0x8d, 0xc7,
+	0xf7, 0xa6, 0x1b, 0x9e, 0x91, 0x41, 0x04, 0xa4, 0x7f, 0x06, 0x03, 0xbf, 0x04, 0xab, 0xe6, 0xf9,
+	0x76, 0xcb, 0x8b, 0xbc, 0x1f, 0x58, 0x63, 0x71, 0x65, 0x34, 0xf4, 0xca, 0xb6, 0x31, 0x75, 0x04,
+	0x11, 0x2b, 0x03, 0x7f, 0x02, 0x15, 0xfb, 0x64, 0xf9, 0x32, 0xe8, 0xb0, 0xb0, 0xdf, 0x65, 0xd2,
+	0x5d, 0xd7, 0x59, 0xbf, 0xa5, 0xe4, 0x4e, 0x0d, 0xed, 0xc4, 0xb2, 0x70, 0xcd, 0xe6, 0xdc, 0xb5,
+	0xa9, 0xb9, 0xae, 0x8a, 0xc8, 0xc6, 0x20, 0x4b, 0x91, 0x47, 0xcb, 0x7f, 0xff, 0xee, 0x39, 0xe8,
+	0x67, 0x07, 0x54, 0xe6, 0xfa, 0x06, 0x1e, 0x81, 0xd2, 0x6c, 0xaf, 0xd8, 0x31, 0x7c, 0x7f, 0x34,
+	0xf4, 0x36, 0xe7, 0x3b, 0x49, 0x5f, 0xeb, 0xa4, 0x91, 0xe0, 0x1e, 0x58, 0xed, 0xb0, 0xa8, 0xdd,
+	0x51, 0x7a, 0x0c, 0x17, 0x66, 0x13, 0x60, 0xe2, 0x88, 0x58, 0x80, 0xd9, 0x02, 0x7e, 0xfd, 0xf6,
+	0xb2, 0xea, 0xbc, 0xbb, 0xac, 0x3a, 0x7f, 0x5d, 0x56, 0x9d, 0xdf, 0xae, 0xaa, 0x4b, 0xef, 0xae,
+	0xaa, 0x4b, 0x7f, 0x5e, 0x55, 0x97, 0xbe, 0x79, 0xd6, 0x8e, 0x54, 0xa7, 0xdf, 0x6a, 0x04, 0x3c,
+	0x6e, 0x06, 0x3a, 0x21, 0xd9, 0x87, 0xff, 0xc7, 0xec, 0xaf, 0xba, 0xe8, 0x31, 0xd9, 0x5a, 0xd5,
+	0xcf, 0xff, 0xb3, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x0a, 0x70, 0x50, 0x86, 0x1f, 0x0a, 0x00,
+	0x00,
 }
 
 func (this *GenesisDenom) Equal(that interface{}) bool {
@@ -193,6 +418,94 @@ func (this *GenesisDenom) Equal(that interface{}) bool {
 	if !this.AuthorityMetadata.Equal(&that1.AuthorityMetadata) {
 		return false
 	}
+	if !this.DenomHook.Equal(that1.DenomHook) {
+		return false
+	}
+	if this.BeforeSendHookAddress != that1.BeforeSendHookAddress {
+		return false
+	}
+	if !bytes.Equal(this.VoucherSigner, that1.VoucherSigner) {
+		return false
+	}
+	if len(this.UsedVoucherNonces) != len(that1.UsedVoucherNonces) {
+		return false
+	}
+	for i := range this.UsedVoucherNonces {
+		if this.UsedVoucherNonces[i] != that1.UsedVoucherNonces[i] {
+			return false
+		}
+	}
+	if len(this.ReferenceIds) != len(that1.ReferenceIds) {
+		return false
+	}
+	for i := range this.ReferenceIds {
+		if !this.ReferenceIds[i].Equal(&that1.ReferenceIds[i]) {
+			return false
+		}
+	}
+	if !this.ReserveAttestor.Equal(that1.ReserveAttestor) {
+		return false
+	}
+	if len(this.ReserveAttestations) != len(that1.ReserveAttestations) {
+		return false
+	}
+	for i := range this.ReserveAttestations {
+		if !this.ReserveAttestations[i].Equal(&that1.ReserveAttestations[i]) {
+			return false
+		}
+	}
+	if len(this.Redemptions) != len(that1.Redemptions) {
+		return false
+	}
+	for i := range this.Redemptions {
+		if !this.Redemptions[i].Equal(&that1.Redemptions[i]) {
+			return false
+		}
+	}
+	if !this.Vault.Equal(that1.Vault) {
+		return false
+	}
+	if !this.Cw20Bridge.Equal(that1.Cw20Bridge) {
+		return false
+	}
+	if !this.Basket.Equal(that1.Basket) {
+		return false
+	}
+	if len(this.VestingSchedules) != len(that1.VestingSchedules) {
+		return false
+	}
+	for i := range this.VestingSchedules {
+		if !this.VestingSchedules[i].Equal(&that1.VestingSchedules[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *ReferenceIDRecord) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ReferenceIDRecord)
+	if !ok {
+		that2, ok := that.(ReferenceIDRecord)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ReferenceId != that1.ReferenceId {
+		return false
+	}
+	if this.Height != that1.Height {
+		return false
+	}
 	return true
 }
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -215,6 +528,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ClaimRecords) > 0 {
+		for iNdEx := len(m.ClaimRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ClaimRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.ClaimCampaigns) > 0 {
+		for iNdEx := len(m.ClaimCampaigns) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ClaimCampaigns[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.FactoryDenoms) > 0 {
 		for iNdEx := len(m.FactoryDenoms) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -262,6 +603,154 @@ func (m *GenesisDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.VestingSchedules) > 0 {
+		for iNdEx := len(m.VestingSchedules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VestingSchedules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if m.Basket != nil {
+		{
+			size, err := m.Basket.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.Cw20Bridge != nil {
+		{
+			size, err := m.Cw20Bridge.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.Vault != nil {
+		{
+			size, err := m.Vault.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.Redemptions) > 0 {
+		for iNdEx := len(m.Redemptions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Redemptions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.ReserveAttestations) > 0 {
+		for iNdEx := len(m.ReserveAttestations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ReserveAttestations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.ReserveAttestor != nil {
+		{
+			size, err := m.ReserveAttestor.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.ReferenceIds) > 0 {
+		for iNdEx := len(m.ReferenceIds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ReferenceIds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.UsedVoucherNonces) > 0 {
+		dAtA7 := make([]byte, len(m.UsedVoucherNonces)*10)
+		var j6 int
+		for _, num := range m.UsedVoucherNonces {
+			for num >= 1<<7 {
+				dAtA7[j6] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j6++
+			}
+			dAtA7[j6] = uint8(num)
+			j6++
+		}
+		i -= j6
+		copy(dAtA[i:], dAtA7[:j6])
+		i = encodeVarintGenesis(dAtA, i, uint64(j6))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.VoucherSigner) > 0 {
+		i -= len(m.VoucherSigner)
+		copy(dAtA[i:], m.VoucherSigner)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.VoucherSigner)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.BeforeSendHookAddress) > 0 {
+		i -= len(m.BeforeSendHookAddress)
+		copy(dAtA[i:], m.BeforeSendHookAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.BeforeSendHookAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.DenomHook != nil {
+		{
+			size, err := m.DenomHook.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	{
 		size, err := m.AuthorityMetadata.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -282,8 +771,43 @@ func (m *GenesisDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGenesis(v)
+func (m *ReferenceIDRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReferenceIDRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReferenceIDRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ReferenceId) > 0 {
+		i -= len(m.ReferenceId)
+		copy(dAtA[i:], m.ReferenceId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ReferenceId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
 	base := offset
 	for v >= 1<<7 {
 		dAtA[offset] = uint8(v&0x7f | 0x80)
@@ -307,6 +831,18 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.ClaimCampaigns) > 0 {
+		for _, e := range m.ClaimCampaigns {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ClaimRecords) > 0 {
+		for _, e := range m.ClaimRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -322,6 +858,81 @@ func (m *GenesisDenom) Size() (n int) {
 	}
 	l = m.AuthorityMetadata.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if m.DenomHook != nil {
+		l = m.DenomHook.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.BeforeSendHookAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.VoucherSigner)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.UsedVoucherNonces) > 0 {
+		l = 0
+		for _, e := range m.UsedVoucherNonces {
+			l += sovGenesis(uint64(e))
+		}
+		n += 1 + sovGenesis(uint64(l)) + l
+	}
+	if len(m.ReferenceIds) > 0 {
+		for _, e := range m.ReferenceIds {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.ReserveAttestor != nil {
+		l = m.ReserveAttestor.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.ReserveAttestations) > 0 {
+		for _, e := range m.ReserveAttestations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Redemptions) > 0 {
+		for _, e := range m.Redemptions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.Vault != nil {
+		l = m.Vault.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Cw20Bridge != nil {
+		l = m.Cw20Bridge.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Basket != nil {
+		l = m.Basket.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.VestingSchedules) > 0 {
+		for _, e := range m.VestingSchedules {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ReferenceIDRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ReferenceId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovGenesis(uint64(m.Height))
+	}
 	return n
 }
 
@@ -427,6 +1038,74 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimCampaigns", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClaimCampaigns = append(m.ClaimCampaigns, ClaimCampaign{})
+			if err := m.ClaimCampaigns[len(m.ClaimCampaigns)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClaimRecords = append(m.ClaimRecords, ClaimRecord{})
+			if err := m.ClaimRecords[len(m.ClaimRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -542,6 +1221,565 @@ func (m *GenesisDenom) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomHook", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DenomHook == nil {
+				m.DenomHook = &DenomHook{}
+			}
+			if err := m.DenomHook.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BeforeSendHookAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BeforeSendHookAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoucherSigner", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VoucherSigner = append(m.VoucherSigner[:0], dAtA[iNdEx:postIndex]...)
+			if m.VoucherSigner == nil {
+				m.VoucherSigner = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.UsedVoucherNonces = append(m.UsedVoucherNonces, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.UsedVoucherNonces) == 0 {
+					m.UsedVoucherNonces = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenesis
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.UsedVoucherNonces = append(m.UsedVoucherNonces, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field UsedVoucherNonces", wireType)
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReferenceIds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReferenceIds = append(m.ReferenceIds, ReferenceIDRecord{})
+			if err := m.ReferenceIds[len(m.ReferenceIds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReserveAttestor", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ReserveAttestor == nil {
+				m.ReserveAttestor = &ReserveAttestor{}
+			}
+			if err := m.ReserveAttestor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReserveAttestations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReserveAttestations = append(m.ReserveAttestations, ReserveAttestation{})
+			if err := m.ReserveAttestations[len(m.ReserveAttestations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Redemptions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Redemptions = append(m.Redemptions, RedemptionRequest{})
+			if err := m.Redemptions[len(m.Redemptions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vault", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Vault == nil {
+				m.Vault = &Vault{}
+			}
+			if err := m.Vault.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cw20Bridge", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Cw20Bridge == nil {
+				m.Cw20Bridge = &CW20Bridge{}
+			}
+			if err := m.Cw20Bridge.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Basket", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Basket == nil {
+				m.Basket = &Basket{}
+			}
+			if err := m.Basket.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingSchedules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VestingSchedules = append(m.VestingSchedules, VestingSchedule{})
+			if err := m.VestingSchedules[len(m.VestingSchedules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReferenceIDRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReferenceIDRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReferenceIDRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReferenceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReferenceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
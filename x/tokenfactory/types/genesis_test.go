@@ -2,11 +2,94 @@ package types_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/cosmos/tokenfactory/x/tokenfactory/types"
 	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+func TestGenesisState_ValidateClaims(t *testing.T) {
+	denom := "factory/cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8/bitcoin"
+	claimer := "cosmos15czt5nhlnvayqq37xun9s9yus0d6y26dx74r5p"
+	campaign := types.ClaimCampaign{
+		Id:         1,
+		Denom:      denom,
+		Creator:    "cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8",
+		MerkleRoot: types.ClaimLeafHash(claimer, sdkmath.NewInt(10)),
+		TotalCap:   sdkmath.NewInt(100),
+		Claimed:    sdkmath.NewInt(10),
+	}
+	record := types.ClaimRecord{CampaignId: 1, Address: claimer, Amount: sdkmath.NewInt(10)}
+
+	for _, tc := range []struct {
+		desc      string
+		malleate  func(campaign *types.ClaimCampaign, records *[]types.ClaimRecord)
+		expErrStr string
+	}{
+		{
+			desc:     "valid",
+			malleate: func(*types.ClaimCampaign, *[]types.ClaimRecord) {},
+		},
+		{
+			desc:      "zero id",
+			malleate:  func(c *types.ClaimCampaign, _ *[]types.ClaimRecord) { c.Id = 0 },
+			expErrStr: "id must be positive",
+		},
+		{
+			desc:      "unknown denom",
+			malleate:  func(c *types.ClaimCampaign, _ *[]types.ClaimRecord) { c.Denom = denom + "2" },
+			expErrStr: "unknown denom",
+		},
+		{
+			desc:      "short merkle root",
+			malleate:  func(c *types.ClaimCampaign, _ *[]types.ClaimRecord) { c.MerkleRoot = c.MerkleRoot[:31] },
+			expErrStr: "merkle root must be 32 bytes",
+		},
+		{
+			desc:      "claimed over the cap",
+			malleate:  func(c *types.ClaimCampaign, _ *[]types.ClaimRecord) { c.TotalCap = sdkmath.NewInt(5) },
+			expErrStr: "between 0 and the total cap",
+		},
+		{
+			desc:      "claimed is not the sum of the records",
+			malleate:  func(c *types.ClaimCampaign, _ *[]types.ClaimRecord) { c.Claimed = sdkmath.NewInt(20) },
+			expErrStr: "is not the sum of its claim records",
+		},
+		{
+			desc:      "record of an unknown campaign",
+			malleate:  func(_ *types.ClaimCampaign, r *[]types.ClaimRecord) { (*r)[0].CampaignId = 2 },
+			expErrStr: "unknown campaign 2",
+		},
+		{
+			desc:      "duplicate record",
+			malleate:  func(_ *types.ClaimCampaign, r *[]types.ClaimRecord) { *r = append(*r, (*r)[0]) },
+			expErrStr: "duplicate claim record",
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			c := campaign
+			records := []types.ClaimRecord{record}
+			tc.malleate(&c, &records)
+
+			genState := types.GenesisState{
+				FactoryDenoms:  []types.GenesisDenom{{Denom: denom}},
+				ClaimCampaigns: []types.ClaimCampaign{c},
+				ClaimRecords:   records,
+			}
+			err := genState.Validate()
+			if tc.expErrStr != "" {
+				require.ErrorContains(t, err, tc.expErrStr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
 func TestGenesisState_Validate(t *testing.T) {
 	for _, tc := range []struct {
 		desc     string
@@ -46,6 +129,620 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			valid: true,
 		},
+		{
+			desc: "denom hook",
+			genState: &types.GenesisState{
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom: "factory/cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8/bitcoin",
+						AuthorityMetadata: types.DenomAuthorityMetadata{
+							Admin: "cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8",
+						},
+						DenomHook: &types.DenomHook{
+							ContractAddress: "cosmos1ft6e5esdtdegnvcr3djd3ftk4kwpcr6jta8eyh",
+						},
+					},
+				},
+			},
+			valid: true,
+		},
+		{
+			desc: "invalid denom hook contract",
+			genState: &types.GenesisState{
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom: "factory/cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8/bitcoin",
+						AuthorityMetadata: types.DenomAuthorityMetadata{
+							Admin: "cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8",
+						},
+						DenomHook: &types.DenomHook{
+							ContractAddress: "contract",
+						},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid before send hook address",
+			genState: &types.GenesisState{
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom: "factory/cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8/bitcoin",
+						AuthorityMetadata: types.DenomAuthorityMetadata{
+							Admin: "cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8",
+						},
+						BeforeSendHookAddress: "contract",
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "voucher signer and used nonces",
+			genState: &types.GenesisState{
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom: "factory/cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8/bitcoin",
+						AuthorityMetadata: types.DenomAuthorityMetadata{
+							Admin: "cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8",
+						},
+						VoucherSigner:     make([]byte, 33),
+						UsedVoucherNonces: []uint64{1, 2},
+					},
+				},
+			},
+			valid: true,
+		},
+		{
+			desc: "invalid voucher signer",
+			genState: &types.GenesisState{
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom: "factory/cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8/bitcoin",
+						AuthorityMetadata: types.DenomAuthorityMetadata{
+							Admin: "cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8",
+						},
+						VoucherSigner: make([]byte, 20),
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicate voucher nonce",
+			genState: &types.GenesisState{
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom: "factory/cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8/bitcoin",
+						AuthorityMetadata: types.DenomAuthorityMetadata{
+							Admin: "cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8",
+						},
+						UsedVoucherNonces: []uint64{1, 1},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "reference ids",
+			genState: &types.GenesisState{
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom: "factory/cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8/bitcoin",
+						AuthorityMetadata: types.DenomAuthorityMetadata{
+							Admin: "cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8",
+						},
+						ReferenceIds: []types.ReferenceIDRecord{{ReferenceId: "wire-1", Height: 10}, {ReferenceId: "wire-2", Height: 12}},
+					},
+				},
+			},
+			valid: true,
+		},
+		{
+			desc: "duplicate reference id",
+			genState: &types.GenesisState{
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom: "factory/cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8/bitcoin",
+						AuthorityMetadata: types.DenomAuthorityMetadata{
+							Admin: "cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8",
+						},
+						ReferenceIds: []types.ReferenceIDRecord{{ReferenceId: "wire-1", Height: 10}, {ReferenceId: "wire-1", Height: 12}},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "empty reference id",
+			genState: &types.GenesisState{
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom: "factory/cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8/bitcoin",
+						AuthorityMetadata: types.DenomAuthorityMetadata{
+							Admin: "cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8",
+						},
+						ReferenceIds: []types.ReferenceIDRecord{{Height: 10}},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "reserve attestor and attestations",
+			genState: &types.GenesisState{
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom: "factory/cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8/bitcoin",
+						AuthorityMetadata: types.DenomAuthorityMetadata{
+							Admin: "cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8",
+						},
+						ReserveAttestor: &types.ReserveAttestor{Attestor: "cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8", MaxStaleness: time.Hour},
+						ReserveAttestations: []types.ReserveAttestation{
+							{Id: 1, Attestor: "cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8", Amount: sdkmath.NewInt(100), Timestamp: time.Unix(100, 0).UTC(), ReportHash: make([]byte, 32)},
+							{Id: 2, Attestor: "cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8", Amount: sdkmath.NewInt(100), Timestamp: time.Unix(200, 0).UTC(), ReportHash: make([]byte, 32)},
+						},
+					},
+				},
+			},
+			valid: true,
+		},
+		{
+			desc: "reserve attestor without max staleness",
+			genState: &types.GenesisState{
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom: "factory/cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8/bitcoin",
+						AuthorityMetadata: types.DenomAuthorityMetadata{
+							Admin: "cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8",
+						},
+						ReserveAttestor: &types.ReserveAttestor{Attestor: "cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8"},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "unordered reserve attestations",
+			genState: &types.GenesisState{
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom: "factory/cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8/bitcoin",
+						AuthorityMetadata: types.DenomAuthorityMetadata{
+							Admin: "cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8",
+						},
+						ReserveAttestations: []types.ReserveAttestation{
+							{Id: 2, Attestor: "cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8", Amount: sdkmath.NewInt(100), Timestamp: time.Unix(100, 0).UTC(), ReportHash: make([]byte, 32)},
+							{Id: 1, Attestor: "cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8", Amount: sdkmath.NewInt(100), Timestamp: time.Unix(200, 0).UTC(), ReportHash: make([]byte, 32)},
+						},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "reserve attestation older than the previous one",
+			genState: &types.GenesisState{
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom: "factory/cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8/bitcoin",
+						AuthorityMetadata: types.DenomAuthorityMetadata{
+							Admin: "cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8",
+						},
+						ReserveAttestations: []types.ReserveAttestation{
+							{Id: 1, Attestor: "cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8", Amount: sdkmath.NewInt(100), Timestamp: time.Unix(200, 0).UTC(), ReportHash: make([]byte, 32)},
+							{Id: 2, Attestor: "cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8", Amount: sdkmath.NewInt(100), Timestamp: time.Unix(100, 0).UTC(), ReportHash: make([]byte, 32)},
+						},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "redemptions",
+			genState: &types.GenesisState{
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom: "factory/cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8/bitcoin",
+						AuthorityMetadata: types.DenomAuthorityMetadata{
+							Admin: "cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8",
+						},
+						Redemptions: []types.RedemptionRequest{
+							{Id: 1, Holder: "cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8", Amount: sdk.NewInt64Coin("factory/cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8/bitcoin", 100), PayoutReference: "iban", Created: time.Unix(100, 0).UTC(), Status: types.REDEMPTION_STATUS_FULFILLED, SettlementReference: "wire"},
+							{Id: 2, Holder: "cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8", Amount: sdk.NewInt64Coin("factory/cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8/bitcoin", 100), PayoutReference: "iban", Created: time.Unix(100, 0).UTC(), Status: types.REDEMPTION_STATUS_OPEN},
+						},
+					},
+				},
+			},
+			valid: true,
+		},
+		{
+			desc: "redemption of another denom",
+			genState: &types.GenesisState{
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom: "factory/cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8/bitcoin",
+						AuthorityMetadata: types.DenomAuthorityMetadata{
+							Admin: "cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8",
+						},
+						Redemptions: []types.RedemptionRequest{
+							{Id: 1, Holder: "cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8", Amount: sdk.NewInt64Coin("factory/cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8/litecoin", 100), PayoutReference: "iban", Created: time.Unix(100, 0).UTC(), Status: types.REDEMPTION_STATUS_OPEN},
+						},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "unordered redemptions",
+			genState: &types.GenesisState{
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom: "factory/cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8/bitcoin",
+						AuthorityMetadata: types.DenomAuthorityMetadata{
+							Admin: "cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8",
+						},
+						Redemptions: []types.RedemptionRequest{
+							{Id: 2, Holder: "cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8", Amount: sdk.NewInt64Coin("factory/cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8/bitcoin", 100), PayoutReference: "iban", Created: time.Unix(100, 0).UTC(), Status: types.REDEMPTION_STATUS_OPEN},
+							{Id: 1, Holder: "cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8", Amount: sdk.NewInt64Coin("factory/cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8/bitcoin", 100), PayoutReference: "iban", Created: time.Unix(100, 0).UTC(), Status: types.REDEMPTION_STATUS_OPEN},
+						},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "fulfilled redemption without settlement reference",
+			genState: &types.GenesisState{
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom: "factory/cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8/bitcoin",
+						AuthorityMetadata: types.DenomAuthorityMetadata{
+							Admin: "cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8",
+						},
+						Redemptions: []types.RedemptionRequest{
+							{Id: 1, Holder: "cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8", Amount: sdk.NewInt64Coin("factory/cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8/bitcoin", 100), PayoutReference: "iban", Created: time.Unix(100, 0).UTC(), Status: types.REDEMPTION_STATUS_FULFILLED},
+						},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "vault",
+			genState: &types.GenesisState{
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom: "factory/cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8/bitcoin",
+						AuthorityMetadata: types.DenomAuthorityMetadata{
+							Admin: "cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8",
+						},
+						Vault: &types.Vault{Denom: "factory/cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8/bitcoin", BackingDenom: "wbtc", Ratio: sdkmath.NewInt(100), Escrowed: sdkmath.NewInt(10)},
+					},
+				},
+			},
+			valid: true,
+		},
+		{
+			desc: "vault of another denom",
+			genState: &types.GenesisState{
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom: "factory/cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8/bitcoin",
+						AuthorityMetadata: types.DenomAuthorityMetadata{
+							Admin: "cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8",
+						},
+						Vault: &types.Vault{Denom: "factory/cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8/litecoin", BackingDenom: "wbtc", Ratio: sdkmath.NewInt(100), Escrowed: sdkmath.NewInt(10)},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "vault with zero ratio",
+			genState: &types.GenesisState{
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom: "factory/cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8/bitcoin",
+						AuthorityMetadata: types.DenomAuthorityMetadata{
+							Admin: "cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8",
+						},
+						Vault: &types.Vault{Denom: "factory/cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8/bitcoin", BackingDenom: "wbtc", Ratio: sdkmath.ZeroInt(), Escrowed: sdkmath.NewInt(10)},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "vault with invalid backing denom",
+			genState: &types.GenesisState{
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom: "factory/cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8/bitcoin",
+						AuthorityMetadata: types.DenomAuthorityMetadata{
+							Admin: "cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8",
+						},
+						Vault: &types.Vault{Denom: "factory/cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8/bitcoin", BackingDenom: "1btc", Ratio: sdkmath.NewInt(100), Escrowed: sdkmath.NewInt(10)},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "vault with negative escrow",
+			genState: &types.GenesisState{
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom: "factory/cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8/bitcoin",
+						AuthorityMetadata: types.DenomAuthorityMetadata{
+							Admin: "cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8",
+						},
+						Vault: &types.Vault{Denom: "factory/cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8/bitcoin", BackingDenom: "wbtc", Ratio: sdkmath.NewInt(100), Escrowed: sdkmath.NewInt(-1)},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "cw20 bridge",
+			genState: &types.GenesisState{
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom: "factory/cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8/bitcoin",
+						AuthorityMetadata: types.DenomAuthorityMetadata{
+							Admin: "cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8",
+						},
+						Cw20Bridge: &types.CW20Bridge{Denom: "factory/cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8/bitcoin", ContractAddress: "cosmos14hj2tavq8fpesdwxxcu44rty3hh90vhujrvcmstl4zr3txmfvw9s4hmalr", Escrowed: sdkmath.NewInt(10)},
+					},
+				},
+			},
+			valid: true,
+		},
+		{
+			desc: "cw20 bridge of another denom",
+			genState: &types.GenesisState{
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom: "factory/cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8/bitcoin",
+						AuthorityMetadata: types.DenomAuthorityMetadata{
+							Admin: "cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8",
+						},
+						Cw20Bridge: &types.CW20Bridge{Denom: "factory/cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8/litecoin", ContractAddress: "cosmos14hj2tavq8fpesdwxxcu44rty3hh90vhujrvcmstl4zr3txmfvw9s4hmalr", Escrowed: sdkmath.NewInt(10)},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "cw20 bridge with invalid contract address",
+			genState: &types.GenesisState{
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom: "factory/cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8/bitcoin",
+						AuthorityMetadata: types.DenomAuthorityMetadata{
+							Admin: "cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8",
+						},
+						Cw20Bridge: &types.CW20Bridge{Denom: "factory/cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8/bitcoin", ContractAddress: "wasm1contract", Escrowed: sdkmath.NewInt(10)},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "cw20 bridge with negative escrow",
+			genState: &types.GenesisState{
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom: "factory/cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8/bitcoin",
+						AuthorityMetadata: types.DenomAuthorityMetadata{
+							Admin: "cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8",
+						},
+						Cw20Bridge: &types.CW20Bridge{Denom: "factory/cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8/bitcoin", ContractAddress: "cosmos14hj2tavq8fpesdwxxcu44rty3hh90vhujrvcmstl4zr3txmfvw9s4hmalr", Escrowed: sdkmath.NewInt(-1)},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "cw20 bridge of a vault denom",
+			genState: &types.GenesisState{
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom: "factory/cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8/bitcoin",
+						AuthorityMetadata: types.DenomAuthorityMetadata{
+							Admin: "cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8",
+						},
+						Vault:      &types.Vault{Denom: "factory/cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8/bitcoin", BackingDenom: "wbtc", Ratio: sdkmath.NewInt(100), Escrowed: sdkmath.NewInt(10)},
+						Cw20Bridge: &types.CW20Bridge{Denom: "factory/cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8/bitcoin", ContractAddress: "cosmos14hj2tavq8fpesdwxxcu44rty3hh90vhujrvcmstl4zr3txmfvw9s4hmalr", Escrowed: sdkmath.NewInt(10)},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "basket",
+			genState: &types.GenesisState{
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom: "factory/cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8/bitcoin",
+						AuthorityMetadata: types.DenomAuthorityMetadata{
+							Admin: "cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8",
+						},
+						Basket: &types.Basket{Denom: "factory/cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8/bitcoin", Components: sdk.NewCoins(sdk.NewInt64Coin("uatom", 10)), Escrowed: sdk.NewCoins(sdk.NewInt64Coin("uatom", 20)), Supply: sdkmath.NewInt(2), CompositionTimelock: 24 * time.Hour, PendingComposition: &types.PendingBasketComposition{Components: sdk.NewCoins(sdk.NewInt64Coin("uosmo", 10)), ExecuteAfter: time.Unix(100, 0).UTC()}},
+					},
+				},
+			},
+			valid: true,
+		},
+		{
+			desc: "basket of another denom",
+			genState: &types.GenesisState{
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom: "factory/cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8/bitcoin",
+						AuthorityMetadata: types.DenomAuthorityMetadata{
+							Admin: "cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8",
+						},
+						Basket: &types.Basket{Denom: "factory/cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8/litecoin", Components: sdk.NewCoins(sdk.NewInt64Coin("uatom", 10)), Escrowed: sdk.NewCoins(sdk.NewInt64Coin("uatom", 20)), Supply: sdkmath.NewInt(2), CompositionTimelock: 24 * time.Hour},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "basket without components",
+			genState: &types.GenesisState{
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom: "factory/cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8/bitcoin",
+						AuthorityMetadata: types.DenomAuthorityMetadata{
+							Admin: "cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8",
+						},
+						Basket: &types.Basket{Denom: "factory/cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8/bitcoin", Escrowed: sdk.NewCoins(sdk.NewInt64Coin("uatom", 20)), Supply: sdkmath.NewInt(2), CompositionTimelock: 24 * time.Hour},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "basket timelock too short",
+			genState: &types.GenesisState{
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom: "factory/cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8/bitcoin",
+						AuthorityMetadata: types.DenomAuthorityMetadata{
+							Admin: "cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8",
+						},
+						Basket: &types.Basket{Denom: "factory/cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8/bitcoin", Components: sdk.NewCoins(sdk.NewInt64Coin("uatom", 10)), Escrowed: sdk.NewCoins(sdk.NewInt64Coin("uatom", 20)), Supply: sdkmath.NewInt(2), CompositionTimelock: time.Hour},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "basket supply without escrow",
+			genState: &types.GenesisState{
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom: "factory/cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8/bitcoin",
+						AuthorityMetadata: types.DenomAuthorityMetadata{
+							Admin: "cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8",
+						},
+						Basket: &types.Basket{Denom: "factory/cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8/bitcoin", Components: sdk.NewCoins(sdk.NewInt64Coin("uatom", 10)), Supply: sdkmath.NewInt(2), CompositionTimelock: 24 * time.Hour},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "basket of a vault denom",
+			genState: &types.GenesisState{
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom: "factory/cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8/bitcoin",
+						AuthorityMetadata: types.DenomAuthorityMetadata{
+							Admin: "cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8",
+						},
+						Vault:  &types.Vault{Denom: "factory/cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8/bitcoin", BackingDenom: "wbtc", Ratio: sdkmath.NewInt(100), Escrowed: sdkmath.NewInt(10)},
+						Basket: &types.Basket{Denom: "factory/cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8/bitcoin", Components: sdk.NewCoins(sdk.NewInt64Coin("uatom", 10)), Escrowed: sdk.NewCoins(sdk.NewInt64Coin("uatom", 20)), Supply: sdkmath.NewInt(2), CompositionTimelock: 24 * time.Hour},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "vesting schedules",
+			genState: &types.GenesisState{
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom: "factory/cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8/bitcoin",
+						AuthorityMetadata: types.DenomAuthorityMetadata{
+							Admin: "cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8",
+						},
+						VestingSchedules: []types.VestingSchedule{
+							{Denom: "factory/cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8/bitcoin", Recipient: "cosmos15czt5nhlnvayqq37xun9s9yus0d6y26dx74r5p", Amount: sdkmath.NewInt(100), StartTime: time.Unix(100, 0).UTC(), EndTime: time.Unix(200, 0).UTC()},
+							{Denom: "factory/cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8/bitcoin", Recipient: "cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8", Amount: sdkmath.NewInt(100), StartTime: time.Unix(100, 0).UTC(), Periods: []types.VestingPeriod{{Length: time.Hour, Amount: sdkmath.NewInt(40)}, {Length: time.Hour, Amount: sdkmath.NewInt(60)}}},
+						},
+					},
+				},
+			},
+			valid: true,
+		},
+		{
+			desc: "vesting schedule of another denom",
+			genState: &types.GenesisState{
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom: "factory/cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8/bitcoin",
+						AuthorityMetadata: types.DenomAuthorityMetadata{
+							Admin: "cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8",
+						},
+						VestingSchedules: []types.VestingSchedule{
+							{Denom: "factory/cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8/litecoin", Recipient: "cosmos15czt5nhlnvayqq37xun9s9yus0d6y26dx74r5p", Amount: sdkmath.NewInt(100), StartTime: time.Unix(100, 0).UTC(), EndTime: time.Unix(200, 0).UTC()},
+						},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicate vesting schedule recipient",
+			genState: &types.GenesisState{
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom: "factory/cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8/bitcoin",
+						AuthorityMetadata: types.DenomAuthorityMetadata{
+							Admin: "cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8",
+						},
+						VestingSchedules: []types.VestingSchedule{
+							{Denom: "factory/cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8/bitcoin", Recipient: "cosmos15czt5nhlnvayqq37xun9s9yus0d6y26dx74r5p", Amount: sdkmath.NewInt(100), StartTime: time.Unix(100, 0).UTC(), EndTime: time.Unix(200, 0).UTC()},
+							{Denom: "factory/cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8/bitcoin", Recipient: "cosmos15czt5nhlnvayqq37xun9s9yus0d6y26dx74r5p", Amount: sdkmath.NewInt(100), StartTime: time.Unix(100, 0).UTC(), EndTime: time.Unix(200, 0).UTC()},
+						},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "vesting periods not adding up to the amount",
+			genState: &types.GenesisState{
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom: "factory/cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8/bitcoin",
+						AuthorityMetadata: types.DenomAuthorityMetadata{
+							Admin: "cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8",
+						},
+						VestingSchedules: []types.VestingSchedule{
+							{Denom: "factory/cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8/bitcoin", Recipient: "cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8", Amount: sdkmath.NewInt(100), StartTime: time.Unix(100, 0).UTC(), Periods: []types.VestingPeriod{{Length: time.Hour, Amount: sdkmath.NewInt(40)}, {Length: time.Hour, Amount: sdkmath.NewInt(50)}}},
+						},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "cw20 contract bound to several denoms",
+			genState: &types.GenesisState{
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom: "factory/cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8/bitcoin",
+						AuthorityMetadata: types.DenomAuthorityMetadata{
+							Admin: "cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8",
+						},
+						Cw20Bridge: &types.CW20Bridge{Denom: "factory/cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8/bitcoin", ContractAddress: "cosmos14hj2tavq8fpesdwxxcu44rty3hh90vhujrvcmstl4zr3txmfvw9s4hmalr", Escrowed: sdkmath.NewInt(10)},
+					},
+					{
+						Denom: "factory/cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8/litecoin",
+						AuthorityMetadata: types.DenomAuthorityMetadata{
+							Admin: "cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8",
+						},
+						Cw20Bridge: &types.CW20Bridge{Denom: "factory/cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8/litecoin", ContractAddress: "cosmos14hj2tavq8fpesdwxxcu44rty3hh90vhujrvcmstl4zr3txmfvw9s4hmalr", Escrowed: sdkmath.NewInt(10)},
+					},
+				},
+			},
+			valid: false,
+		},
 		{
 			desc: "empty admin",
 			genState: &types.GenesisState{
@@ -0,0 +1,86 @@
+package types
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// TokenFactoryHooks is the interface other modules implement to react to tokenfactory
+// operations. The hooks are called after the state changes of the operation, and an error
+// returned by a hook reverts the message.
+type TokenFactoryHooks interface {
+	AfterDenomCreated(ctx context.Context, denom string, creator string) error
+	AfterMint(ctx context.Context, amount sdk.Coin, mintToAddress string) error
+	AfterBurn(ctx context.Context, amount sdk.Coin, burnFromAddress string) error
+	AfterForceTransfer(ctx context.Context, amount sdk.Coin, fromAddress, toAddress string) error
+	// AfterAdminChanged is called by MsgChangeAdmin only, so previousAdmin is never empty. It is
+	// called with an empty newAdmin when the admin has been renounced.
+	AfterAdminChanged(ctx context.Context, denom string, previousAdmin, newAdmin string) error
+	AfterMetadataSet(ctx context.Context, denom string, metadata banktypes.Metadata) error
+}
+
+var _ TokenFactoryHooks = MultiTokenFactoryHooks{}
+
+// MultiTokenFactoryHooks combines multiple tokenfactory hooks, all hook functions are run in
+// array sequence and the first error is returned.
+type MultiTokenFactoryHooks []TokenFactoryHooks
+
+func NewMultiTokenFactoryHooks(hooks ...TokenFactoryHooks) MultiTokenFactoryHooks {
+	return hooks
+}
+
+func (h MultiTokenFactoryHooks) AfterDenomCreated(ctx context.Context, denom string, creator string) error {
+	for i := range h {
+		if err := h[i].AfterDenomCreated(ctx, denom, creator); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h MultiTokenFactoryHooks) AfterMint(ctx context.Context, amount sdk.Coin, mintToAddress string) error {
+	for i := range h {
+		if err := h[i].AfterMint(ctx, amount, mintToAddress); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h MultiTokenFactoryHooks) AfterBurn(ctx context.Context, amount sdk.Coin, burnFromAddress string) error {
+	for i := range h {
+		if err := h[i].AfterBurn(ctx, amount, burnFromAddress); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h MultiTokenFactoryHooks) AfterForceTransfer(ctx context.Context, amount sdk.Coin, fromAddress, toAddress string) error {
+	for i := range h {
+		if err := h[i].AfterForceTransfer(ctx, amount, fromAddress, toAddress); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h MultiTokenFactoryHooks) AfterAdminChanged(ctx context.Context, denom string, previousAdmin, newAdmin string) error {
+	for i := range h {
+		if err := h[i].AfterAdminChanged(ctx, denom, previousAdmin, newAdmin); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h MultiTokenFactoryHooks) AfterMetadataSet(ctx context.Context, denom string, metadata banktypes.Metadata) error {
+	for i := range h {
+		if err := h[i].AfterMetadataSet(ctx, denom, metadata); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -2,6 +2,9 @@ package types
 
 import (
 	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 var ParamsKey = []byte{0x00}
@@ -21,6 +24,9 @@ const (
 
 	// MemStoreKey defines the in-memory store key
 	MemStoreKey = "mem_tokenfactory"
+
+	// TStoreKey defines the transient store key
+	TStoreKey = "transient_tokenfactory"
 )
 
 // KeySeparator is used to combine parts of the keys in the store
@@ -28,9 +34,30 @@ const KeySeparator = "|"
 
 var (
 	DenomAuthorityMetadataKey = "authoritymetadata"
+	DenomHookKey              = "denomhook"
+	BeforeSendHookAddressKey  = "beforesendhook"
 	DenomsPrefixKey           = "denoms"
 	CreatorPrefixKey          = "creator"
 	AdminPrefixKey            = "admin"
+	ClaimCampaignPrefixKey    = "claimcampaign"
+	ClaimRecordPrefixKey      = "claimrecord"
+	NextClaimCampaignIDKey    = "nextclaimcampaignid"
+	VoucherSignerKey          = "vouchersigner"
+	VoucherNoncePrefixKey     = "vouchernonce"
+	ReferenceIDPrefixKey      = "referenceid"
+	ReferenceIDHeightPrefix   = "referenceidheight"
+	ReserveAttestorKey        = "reserveattestor"
+	ReserveAttestationPrefix  = "reserveattestation"
+	RedemptionPrefixKey       = "redemption"
+	OpenRedemptionPrefixKey   = "openredemption"
+	RedemptionTimeoutPrefix   = "redemptiontimeout"
+	VaultPrefixKey            = "vault"
+	CW20BridgePrefixKey       = "cw20bridge"
+	CW20ContractPrefixKey     = "cw20contract"
+	BasketPrefixKey           = "basket"
+	BasketCompositionPrefix   = "basketcomposition"
+	VestingPrefixKey          = "vesting"
+	EscrowPrefixKey           = "escrow"
 )
 
 // GetDenomPrefixStore returns the store prefix where all the data associated with a specific denom
@@ -49,3 +76,105 @@ func GetCreatorPrefix(creator string) []byte {
 func GetCreatorsPrefix() []byte {
 	return []byte(strings.Join([]string{CreatorPrefixKey, ""}, KeySeparator))
 }
+
+// GetClaimCampaignKey returns the store key of a claim campaign
+func GetClaimCampaignKey(campaignID uint64) []byte {
+	return append([]byte(ClaimCampaignPrefixKey+KeySeparator), sdk.Uint64ToBigEndian(campaignID)...)
+}
+
+// GetClaimRecordPrefix returns the store prefix where the claims of a campaign are stored
+func GetClaimRecordPrefix(campaignID uint64) []byte {
+	key := append([]byte(ClaimRecordPrefixKey+KeySeparator), sdk.Uint64ToBigEndian(campaignID)...)
+	return append(key, KeySeparator...)
+}
+
+// GetVoucherNoncePrefix returns the prefix, in the store of a denom, where the redeemed mint
+// voucher nonces are stored
+func GetVoucherNoncePrefix() []byte {
+	return []byte(VoucherNoncePrefixKey + KeySeparator)
+}
+
+// GetReserveAttestationPrefix returns the prefix, in the store of a denom, where the reserve
+// attestations are stored by id
+func GetReserveAttestationPrefix() []byte {
+	return []byte(ReserveAttestationPrefix + KeySeparator)
+}
+
+// GetRedemptionPrefix returns the prefix, in the store of a denom, where the redemption requests
+// are stored by id
+func GetRedemptionPrefix() []byte {
+	return []byte(RedemptionPrefixKey + KeySeparator)
+}
+
+// GetOpenRedemptionPrefix returns the prefix, in the store of a denom, where the ids of the open
+// redemption requests are indexed
+func GetOpenRedemptionPrefix() []byte {
+	return []byte(OpenRedemptionPrefixKey + KeySeparator)
+}
+
+// GetRedemptionTimeoutKey returns the key indexing an open redemption request of a denom by its
+// timeout, for the refunds
+func GetRedemptionTimeoutKey(timeout time.Time, denom string, id uint64) []byte {
+	key := append([]byte(RedemptionTimeoutPrefix+KeySeparator), sdk.FormatTimeBytes(timeout)...)
+	key = append(key, []byte(KeySeparator+denom+KeySeparator)...)
+	return append(key, sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetVaultPrefix returns the store prefix where the vaults are stored by denom
+func GetVaultPrefix() []byte {
+	return []byte(VaultPrefixKey + KeySeparator)
+}
+
+// GetCW20BridgePrefix returns the store prefix where the CW20 bridges are stored by denom
+func GetCW20BridgePrefix() []byte {
+	return []byte(CW20BridgePrefixKey + KeySeparator)
+}
+
+// GetCW20ContractPrefix returns the store prefix where the denoms bound to CW20 contracts are
+// indexed by contract address
+func GetCW20ContractPrefix() []byte {
+	return []byte(CW20ContractPrefixKey + KeySeparator)
+}
+
+// GetBasketPrefix returns the store prefix where the baskets are stored by denom
+func GetBasketPrefix() []byte {
+	return []byte(BasketPrefixKey + KeySeparator)
+}
+
+// GetBasketCompositionKey returns the key indexing the pending composition change of a basket by
+// its execution time, for the end blocker
+func GetBasketCompositionKey(executeAfter time.Time, denom string) []byte {
+	key := append([]byte(BasketCompositionPrefix+KeySeparator), sdk.FormatTimeBytes(executeAfter)...)
+	return append(key, []byte(KeySeparator+denom)...)
+}
+
+// GetEscrowPrefix returns the store prefix where the denoms of the vaults and the baskets are
+// indexed by the address of their escrow account
+func GetEscrowPrefix() []byte {
+	return []byte(EscrowPrefixKey + KeySeparator)
+}
+
+// GetVestingPrefix returns the prefix, in the store of a denom, where the vesting schedules are
+// stored by recipient
+func GetVestingPrefix() []byte {
+	return []byte(VestingPrefixKey + KeySeparator)
+}
+
+// GetReferenceIDPrefix returns the prefix, in the store of a denom, where the reference ids of
+// MsgMint and MsgBurn are stored
+func GetReferenceIDPrefix() []byte {
+	return []byte(ReferenceIDPrefixKey + KeySeparator)
+}
+
+// GetReferenceIDHeightPrefix returns the store prefix where the reference ids used at a height
+// are indexed, for pruning
+func GetReferenceIDHeightPrefix(height int64) []byte {
+	key := append([]byte(ReferenceIDHeightPrefix+KeySeparator), sdk.Uint64ToBigEndian(uint64(height))...)
+	return append(key, KeySeparator...)
+}
+
+// GetReferenceIDHeightKey returns the key indexing a reference id of a denom by the height it was
+// used at
+func GetReferenceIDHeightKey(height int64, denom, referenceID string) []byte {
+	return append(GetReferenceIDHeightPrefix(height), []byte(denom+KeySeparator+referenceID)...)
+}
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"time"
+
 	errorsmod "cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
 
@@ -10,12 +12,37 @@ import (
 )
 
 const (
-	TypeMsgCreateDenom      = "create_denom"
-	TypeMsgMint             = "tf_mint"
-	TypeMsgBurn             = "tf_burn"
-	TypeMsgForceTransfer    = "force_transfer"
-	TypeMsgChangeAdmin      = "change_admin"
-	TypeMsgSetDenomMetadata = "set_denom_metadata"
+	TypeMsgCreateDenom       = "create_denom"
+	TypeMsgMint              = "tf_mint"
+	TypeMsgBurn              = "tf_burn"
+	TypeMsgForceTransfer     = "force_transfer"
+	TypeMsgChangeAdmin       = "change_admin"
+	TypeMsgSetDenomMetadata  = "set_denom_metadata"
+	TypeMsgSetDenomHook      = "set_denom_hook"
+	TypeMsgSetBeforeSendHook = "set_before_send_hook"
+	TypeMsgMultiMint         = "tf_multi_mint"
+	TypeMsgMultiBurn         = "tf_multi_burn"
+	TypeMsgCreateClaimCamp   = "create_claim_campaign"
+	TypeMsgClaim             = "claim"
+	TypeMsgCloseClaimCamp    = "close_claim_campaign"
+	TypeMsgSetVoucherSigner  = "set_voucher_signer"
+	TypeMsgRedeemMintVoucher = "redeem_mint_voucher"
+	TypeMsgSetAttestor       = "set_reserve_attestor"
+	TypeMsgAttestReserve     = "attest_reserve"
+	TypeMsgRequestRedemption = "request_redemption"
+	TypeMsgFulfillRedemption = "fulfill_redemption"
+	TypeMsgRejectRedemption  = "reject_redemption"
+	TypeMsgCreateVault       = "create_vault"
+	TypeMsgVaultDeposit      = "vault_deposit"
+	TypeMsgVaultWithdraw     = "vault_withdraw"
+	TypeMsgRegisterCW20      = "register_cw20"
+	TypeMsgWithdrawCW20      = "withdraw_cw20"
+	TypeMsgCreateBasket      = "create_basket"
+	TypeMsgBasketMint        = "basket_mint"
+	TypeMsgBasketRedeem      = "basket_redeem"
+	TypeMsgSetBasketComp     = "set_basket_composition"
+	TypeMsgMintVesting       = "mint_vesting"
+	TypeMsgClawbackVesting   = "clawback_vesting"
 )
 
 var _ sdk.Msg = &MsgCreateDenom{}
@@ -41,6 +68,12 @@ func (m MsgCreateDenom) ValidateBasic() error {
 		return errorsmod.Wrap(ErrInvalidDenom, err.Error())
 	}
 
+	if m.DenomHook != nil {
+		if err := m.DenomHook.Validate(); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -90,7 +123,7 @@ func (m MsgMint) ValidateBasic() error {
 		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, m.Amount.String())
 	}
 
-	return nil
+	return ValidateReferenceID(m.ReferenceId)
 }
 
 func (m MsgMint) GetSignBytes() []byte {
@@ -140,7 +173,7 @@ func (m MsgBurn) ValidateBasic() error {
 		}
 	}
 
-	return nil
+	return ValidateReferenceID(m.ReferenceId)
 }
 
 func (m MsgBurn) GetSignBytes() []byte {
@@ -278,6 +311,1021 @@ func (m MsgSetDenomMetadata) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{sender}
 }
 
+var _ sdk.Msg = &MsgSetDenomHook{}
+
+// NewMsgSetDenomHook creates a message to set the hook contract of a denom, a nil hook removes it
+func NewMsgSetDenomHook(sender, denom string, hook *DenomHook) *MsgSetDenomHook {
+	return &MsgSetDenomHook{
+		Sender:    sender,
+		Denom:     denom,
+		DenomHook: hook,
+	}
+}
+
+func (m MsgSetDenomHook) Route() string { return RouterKey }
+func (m MsgSetDenomHook) Type() string  { return TypeMsgSetDenomHook }
+func (m MsgSetDenomHook) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	_, _, err = DeconstructDenom(m.Denom)
+	if err != nil {
+		return err
+	}
+
+	if m.DenomHook != nil {
+		if err := m.DenomHook.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m MsgSetDenomHook) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgSetDenomHook) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgSetBeforeSendHook{}
+
+// NewMsgSetBeforeSendHook creates a message to set the before send hook contract of a denom, an
+// empty address removes it
+func NewMsgSetBeforeSendHook(sender, denom, cosmwasmAddress string) *MsgSetBeforeSendHook {
+	return &MsgSetBeforeSendHook{
+		Sender:          sender,
+		Denom:           denom,
+		CosmwasmAddress: cosmwasmAddress,
+	}
+}
+
+func (m MsgSetBeforeSendHook) Route() string { return RouterKey }
+func (m MsgSetBeforeSendHook) Type() string  { return TypeMsgSetBeforeSendHook }
+func (m MsgSetBeforeSendHook) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	_, _, err = DeconstructDenom(m.Denom)
+	if err != nil {
+		return err
+	}
+
+	if m.CosmwasmAddress != "" {
+		_, err = sdk.AccAddressFromBech32(m.CosmwasmAddress)
+		if err != nil {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid cosmwasm contract address (%s)", err)
+		}
+	}
+
+	return nil
+}
+
+func (m MsgSetBeforeSendHook) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgSetBeforeSendHook) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgMultiMint{}
+
+// NewMsgMultiMint creates a message to mint tokens to several recipients
+func NewMsgMultiMint(sender, denom string, outputs []MultiMintOutput) *MsgMultiMint {
+	return &MsgMultiMint{
+		Sender:  sender,
+		Denom:   denom,
+		Outputs: outputs,
+	}
+}
+
+func (m MsgMultiMint) Route() string { return RouterKey }
+func (m MsgMultiMint) Type() string  { return TypeMsgMultiMint }
+func (m MsgMultiMint) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	_, _, err = DeconstructDenom(m.Denom)
+	if err != nil {
+		return err
+	}
+
+	if len(m.Outputs) == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "no outputs")
+	}
+
+	seen := make(map[string]bool, len(m.Outputs))
+	for _, output := range m.Outputs {
+		_, err = sdk.AccAddressFromBech32(output.Address)
+		if err != nil {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid output address (%s)", err)
+		}
+
+		if seen[output.Address] {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate output address %s", output.Address)
+		}
+		seen[output.Address] = true
+
+		if output.Amount.IsNil() || !output.Amount.IsPositive() {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "invalid amount for %s", output.Address)
+		}
+	}
+
+	return nil
+}
+
+func (m MsgMultiMint) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgMultiMint) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgMultiBurn{}
+
+// NewMsgMultiBurn creates a message to burn tokens from several accounts
+func NewMsgMultiBurn(sender, denom string, inputs []MultiBurnInput) *MsgMultiBurn {
+	return &MsgMultiBurn{
+		Sender: sender,
+		Denom:  denom,
+		Inputs: inputs,
+	}
+}
+
+func (m MsgMultiBurn) Route() string { return RouterKey }
+func (m MsgMultiBurn) Type() string  { return TypeMsgMultiBurn }
+func (m MsgMultiBurn) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	_, _, err = DeconstructDenom(m.Denom)
+	if err != nil {
+		return err
+	}
+
+	if len(m.Inputs) == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "no inputs")
+	}
+
+	seen := make(map[string]bool, len(m.Inputs))
+	for _, input := range m.Inputs {
+		_, err = sdk.AccAddressFromBech32(input.Address)
+		if err != nil {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid input address (%s)", err)
+		}
+
+		if seen[input.Address] {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate input address %s", input.Address)
+		}
+		seen[input.Address] = true
+
+		if input.Amount.IsNil() || !input.Amount.IsPositive() {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "invalid amount for %s", input.Address)
+		}
+	}
+
+	return nil
+}
+
+func (m MsgMultiBurn) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgMultiBurn) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgCreateClaimCampaign{}
+
+// NewMsgCreateClaimCampaign creates a message to register a claim campaign, a nil expiry never
+// expires
+func NewMsgCreateClaimCampaign(sender, denom string, merkleRoot []byte, totalCap sdkmath.Int, expiry *time.Time) *MsgCreateClaimCampaign {
+	return &MsgCreateClaimCampaign{
+		Sender:     sender,
+		Denom:      denom,
+		MerkleRoot: merkleRoot,
+		TotalCap:   totalCap,
+		Expiry:     expiry,
+	}
+}
+
+func (m MsgCreateClaimCampaign) Route() string { return RouterKey }
+func (m MsgCreateClaimCampaign) Type() string  { return TypeMsgCreateClaimCamp }
+func (m MsgCreateClaimCampaign) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	_, _, err = DeconstructDenom(m.Denom)
+	if err != nil {
+		return err
+	}
+
+	if len(m.MerkleRoot) != ClaimMerkleRootLength {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "merkle root must be %d bytes, got %d", ClaimMerkleRootLength, len(m.MerkleRoot))
+	}
+
+	if m.TotalCap.IsNil() || !m.TotalCap.IsPositive() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, "total cap must be positive")
+	}
+
+	return nil
+}
+
+func (m MsgCreateClaimCampaign) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgCreateClaimCampaign) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgClaim{}
+
+// NewMsgClaim creates a message to claim the amount of the sender in a claim campaign
+func NewMsgClaim(sender string, campaignID uint64, amount sdkmath.Int, proof [][]byte) *MsgClaim {
+	return &MsgClaim{
+		Sender:     sender,
+		CampaignId: campaignID,
+		Amount:     amount,
+		Proof:      proof,
+	}
+}
+
+func (m MsgClaim) Route() string { return RouterKey }
+func (m MsgClaim) Type() string  { return TypeMsgClaim }
+func (m MsgClaim) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	if m.Amount.IsNil() || !m.Amount.IsPositive() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, "amount must be positive")
+	}
+
+	for _, sibling := range m.Proof {
+		if len(sibling) != ClaimMerkleRootLength {
+			return errorsmod.Wrapf(ErrInvalidClaimProof, "proof hashes must be %d bytes, got %d", ClaimMerkleRootLength, len(sibling))
+		}
+	}
+
+	return nil
+}
+
+func (m MsgClaim) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgClaim) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgCloseClaimCampaign{}
+
+// NewMsgCloseClaimCampaign creates a message to close a claim campaign
+func NewMsgCloseClaimCampaign(sender string, campaignID uint64) *MsgCloseClaimCampaign {
+	return &MsgCloseClaimCampaign{
+		Sender:     sender,
+		CampaignId: campaignID,
+	}
+}
+
+func (m MsgCloseClaimCampaign) Route() string { return RouterKey }
+func (m MsgCloseClaimCampaign) Type() string  { return TypeMsgCloseClaimCamp }
+func (m MsgCloseClaimCampaign) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	return nil
+}
+
+func (m MsgCloseClaimCampaign) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgCloseClaimCampaign) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgSetVoucherSigner{}
+
+// NewMsgSetVoucherSigner creates a message to set the voucher signer of a denom, an empty public
+// key removes it
+func NewMsgSetVoucherSigner(sender, denom string, pubKey []byte) *MsgSetVoucherSigner {
+	return &MsgSetVoucherSigner{
+		Sender: sender,
+		Denom:  denom,
+		PubKey: pubKey,
+	}
+}
+
+func (m MsgSetVoucherSigner) Route() string { return RouterKey }
+func (m MsgSetVoucherSigner) Type() string  { return TypeMsgSetVoucherSigner }
+func (m MsgSetVoucherSigner) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	_, _, err = DeconstructDenom(m.Denom)
+	if err != nil {
+		return err
+	}
+
+	return ValidateVoucherSigner(m.PubKey)
+}
+
+func (m MsgSetVoucherSigner) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgSetVoucherSigner) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgRedeemMintVoucher{}
+
+// NewMsgRedeemMintVoucher creates a message to redeem a signed mint voucher
+func NewMsgRedeemMintVoucher(sender string, voucher MintVoucher, signature []byte) *MsgRedeemMintVoucher {
+	return &MsgRedeemMintVoucher{
+		Sender:    sender,
+		Voucher:   voucher,
+		Signature: signature,
+	}
+}
+
+func (m MsgRedeemMintVoucher) Route() string { return RouterKey }
+func (m MsgRedeemMintVoucher) Type() string  { return TypeMsgRedeemMintVoucher }
+func (m MsgRedeemMintVoucher) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	if err := m.Voucher.Validate(); err != nil {
+		return err
+	}
+
+	if len(m.Signature) == 0 {
+		return errorsmod.Wrap(ErrInvalidVoucherSignature, "signature is empty")
+	}
+
+	return nil
+}
+
+func (m MsgRedeemMintVoucher) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgRedeemMintVoucher) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgSetReserveAttestor{}
+
+// NewMsgSetReserveAttestor creates a message to set the reserve attestor of a denom, an empty
+// attestor removes it
+func NewMsgSetReserveAttestor(sender, denom, attestor string, maxStaleness time.Duration) *MsgSetReserveAttestor {
+	return &MsgSetReserveAttestor{
+		Sender:       sender,
+		Denom:        denom,
+		Attestor:     attestor,
+		MaxStaleness: maxStaleness,
+	}
+}
+
+func (m MsgSetReserveAttestor) Route() string { return RouterKey }
+func (m MsgSetReserveAttestor) Type() string  { return TypeMsgSetAttestor }
+func (m MsgSetReserveAttestor) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	_, _, err = DeconstructDenom(m.Denom)
+	if err != nil {
+		return err
+	}
+
+	return ValidateReserveAttestor(m.Attestor, m.MaxStaleness)
+}
+
+func (m MsgSetReserveAttestor) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgSetReserveAttestor) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgAttestReserve{}
+
+// NewMsgAttestReserve creates a message to attest the reserve backing a denom
+func NewMsgAttestReserve(sender, denom string, amount sdkmath.Int, timestamp time.Time, reportHash []byte) *MsgAttestReserve {
+	return &MsgAttestReserve{
+		Sender:     sender,
+		Denom:      denom,
+		Amount:     amount,
+		Timestamp:  timestamp,
+		ReportHash: reportHash,
+	}
+}
+
+func (m MsgAttestReserve) Route() string { return RouterKey }
+func (m MsgAttestReserve) Type() string  { return TypeMsgAttestReserve }
+func (m MsgAttestReserve) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	_, _, err = DeconstructDenom(m.Denom)
+	if err != nil {
+		return err
+	}
+
+	if m.Amount.IsNil() || m.Amount.IsNegative() {
+		return errorsmod.Wrap(ErrInvalidAttestation, "amount can not be negative")
+	}
+
+	if m.Timestamp.IsZero() {
+		return errorsmod.Wrap(ErrInvalidAttestation, "timestamp must be set")
+	}
+
+	return ValidateReportHash(m.ReportHash)
+}
+
+func (m MsgAttestReserve) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgAttestReserve) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgRequestRedemption{}
+
+// NewMsgRequestRedemption creates a message to escrow factory tokens in a redemption request
+func NewMsgRequestRedemption(sender string, amount sdk.Coin, payoutReference string) *MsgRequestRedemption {
+	return &MsgRequestRedemption{
+		Sender:          sender,
+		Amount:          amount,
+		PayoutReference: payoutReference,
+	}
+}
+
+func (m MsgRequestRedemption) Route() string { return RouterKey }
+func (m MsgRequestRedemption) Type() string  { return TypeMsgRequestRedemption }
+func (m MsgRequestRedemption) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	if !m.Amount.IsValid() || m.Amount.Amount.Equal(sdkmath.ZeroInt()) {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, m.Amount.String())
+	}
+
+	_, _, err = DeconstructDenom(m.Amount.Denom)
+	if err != nil {
+		return err
+	}
+
+	return ValidateRedemptionReference(m.PayoutReference)
+}
+
+func (m MsgRequestRedemption) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgRequestRedemption) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgFulfillRedemption{}
+
+// NewMsgFulfillRedemption creates a message to burn the escrowed tokens of a redemption request
+// paid out off-chain
+func NewMsgFulfillRedemption(sender, denom string, id uint64, settlementReference string) *MsgFulfillRedemption {
+	return &MsgFulfillRedemption{
+		Sender:              sender,
+		Denom:               denom,
+		Id:                  id,
+		SettlementReference: settlementReference,
+	}
+}
+
+func (m MsgFulfillRedemption) Route() string { return RouterKey }
+func (m MsgFulfillRedemption) Type() string  { return TypeMsgFulfillRedemption }
+func (m MsgFulfillRedemption) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	_, _, err = DeconstructDenom(m.Denom)
+	if err != nil {
+		return err
+	}
+
+	return ValidateRedemptionReference(m.SettlementReference)
+}
+
+func (m MsgFulfillRedemption) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgFulfillRedemption) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgRejectRedemption{}
+
+// NewMsgRejectRedemption creates a message to return the escrowed tokens of a redemption request
+// to its holder
+func NewMsgRejectRedemption(sender, denom string, id uint64, reason string) *MsgRejectRedemption {
+	return &MsgRejectRedemption{
+		Sender: sender,
+		Denom:  denom,
+		Id:     id,
+		Reason: reason,
+	}
+}
+
+func (m MsgRejectRedemption) Route() string { return RouterKey }
+func (m MsgRejectRedemption) Type() string  { return TypeMsgRejectRedemption }
+func (m MsgRejectRedemption) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	_, _, err = DeconstructDenom(m.Denom)
+	if err != nil {
+		return err
+	}
+
+	return ValidateRejectReason(m.Reason)
+}
+
+func (m MsgRejectRedemption) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgRejectRedemption) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgCreateVault{}
+
+// NewMsgCreateVault creates a message to create a factory denom bound to a backing denom
+func NewMsgCreateVault(sender, subdenom, backingDenom string, ratio sdkmath.Int) *MsgCreateVault {
+	return &MsgCreateVault{
+		Sender:       sender,
+		Subdenom:     subdenom,
+		BackingDenom: backingDenom,
+		Ratio:        ratio,
+	}
+}
+
+func (m MsgCreateVault) Route() string { return RouterKey }
+func (m MsgCreateVault) Type() string  { return TypeMsgCreateVault }
+func (m MsgCreateVault) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	denom, err := GetTokenDenom(m.Sender, m.Subdenom)
+	if err != nil {
+		return errorsmod.Wrap(ErrInvalidDenom, err.Error())
+	}
+
+	if m.BackingDenom == denom {
+		return errorsmod.Wrap(ErrInvalidVault, "a vault can not be backed by its own denom")
+	}
+
+	return ValidateVaultBacking(m.BackingDenom, m.Ratio)
+}
+
+func (m MsgCreateVault) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgCreateVault) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgVaultDeposit{}
+
+// NewMsgVaultDeposit creates a message to deposit an amount of the backing denom of a vault,
+// minting the vault denom
+func NewMsgVaultDeposit(sender, denom string, amount sdkmath.Int) *MsgVaultDeposit {
+	return &MsgVaultDeposit{
+		Sender: sender,
+		Denom:  denom,
+		Amount: amount,
+	}
+}
+
+func (m MsgVaultDeposit) Route() string { return RouterKey }
+func (m MsgVaultDeposit) Type() string  { return TypeMsgVaultDeposit }
+func (m MsgVaultDeposit) ValidateBasic() error {
+	return validateDenomTransfer(m.Sender, m.Denom, m.Amount)
+}
+
+func (m MsgVaultDeposit) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgVaultDeposit) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgVaultWithdraw{}
+
+// NewMsgVaultWithdraw creates a message to withdraw an amount of the backing denom of a vault,
+// burning the vault denom
+func NewMsgVaultWithdraw(sender, denom string, amount sdkmath.Int) *MsgVaultWithdraw {
+	return &MsgVaultWithdraw{
+		Sender: sender,
+		Denom:  denom,
+		Amount: amount,
+	}
+}
+
+func (m MsgVaultWithdraw) Route() string { return RouterKey }
+func (m MsgVaultWithdraw) Type() string  { return TypeMsgVaultWithdraw }
+func (m MsgVaultWithdraw) ValidateBasic() error {
+	return validateDenomTransfer(m.Sender, m.Denom, m.Amount)
+}
+
+func (m MsgVaultWithdraw) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgVaultWithdraw) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+// validateDenomTransfer validates the fields shared by the vault and basket messages, moving an
+// amount in or out of a vault or basket denom
+func validateDenomTransfer(sender, denom string, amount sdkmath.Int) error {
+	_, err := sdk.AccAddressFromBech32(sender)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	_, _, err = DeconstructDenom(denom)
+	if err != nil {
+		return err
+	}
+
+	if amount.IsNil() || !amount.IsPositive() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, "amount must be positive")
+	}
+
+	return nil
+}
+
+var _ sdk.Msg = &MsgRegisterCW20{}
+
+// NewMsgRegisterCW20 creates a message to bind a factory denom without supply to a CW20 contract
+func NewMsgRegisterCW20(sender, denom, contractAddress string) *MsgRegisterCW20 {
+	return &MsgRegisterCW20{
+		Sender:          sender,
+		Denom:           denom,
+		ContractAddress: contractAddress,
+	}
+}
+
+func (m MsgRegisterCW20) Route() string { return RouterKey }
+func (m MsgRegisterCW20) Type() string  { return TypeMsgRegisterCW20 }
+func (m MsgRegisterCW20) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	_, _, err = DeconstructDenom(m.Denom)
+	if err != nil {
+		return err
+	}
+
+	_, err = sdk.AccAddressFromBech32(m.ContractAddress)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid contract address (%s)", err)
+	}
+
+	return nil
+}
+
+func (m MsgRegisterCW20) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgRegisterCW20) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgWithdrawCW20{}
+
+// NewMsgWithdrawCW20 creates a message to burn a factory denom bound to a CW20 contract, and
+// withdraw the same amount of CW20 tokens
+func NewMsgWithdrawCW20(sender string, amount sdk.Coin) *MsgWithdrawCW20 {
+	return &MsgWithdrawCW20{
+		Sender: sender,
+		Amount: amount,
+	}
+}
+
+func (m MsgWithdrawCW20) Route() string { return RouterKey }
+func (m MsgWithdrawCW20) Type() string  { return TypeMsgWithdrawCW20 }
+func (m MsgWithdrawCW20) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	if !m.Amount.IsValid() || m.Amount.Amount.Equal(sdkmath.ZeroInt()) {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, m.Amount.String())
+	}
+
+	_, _, err = DeconstructDenom(m.Amount.Denom)
+	return err
+}
+
+func (m MsgWithdrawCW20) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgWithdrawCW20) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgCreateBasket{}
+
+// NewMsgCreateBasket creates a message to create a factory denom bound to a composition of other
+// denoms
+func NewMsgCreateBasket(sender, subdenom string, components sdk.Coins, compositionTimelock time.Duration) *MsgCreateBasket {
+	return &MsgCreateBasket{
+		Sender:              sender,
+		Subdenom:            subdenom,
+		Components:          components,
+		CompositionTimelock: compositionTimelock,
+	}
+}
+
+func (m MsgCreateBasket) Route() string { return RouterKey }
+func (m MsgCreateBasket) Type() string  { return TypeMsgCreateBasket }
+func (m MsgCreateBasket) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	denom, err := GetTokenDenom(m.Sender, m.Subdenom)
+	if err != nil {
+		return errorsmod.Wrap(ErrInvalidDenom, err.Error())
+	}
+
+	if err := ValidateBasketComponents(denom, m.Components); err != nil {
+		return err
+	}
+
+	return ValidateBasketCompositionTimelock(m.CompositionTimelock)
+}
+
+func (m MsgCreateBasket) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgCreateBasket) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgBasketMint{}
+
+// NewMsgBasketMint creates a message to deposit the components of an amount of a basket denom,
+// minting the amount
+func NewMsgBasketMint(sender, denom string, amount sdkmath.Int) *MsgBasketMint {
+	return &MsgBasketMint{
+		Sender: sender,
+		Denom:  denom,
+		Amount: amount,
+	}
+}
+
+func (m MsgBasketMint) Route() string { return RouterKey }
+func (m MsgBasketMint) Type() string  { return TypeMsgBasketMint }
+func (m MsgBasketMint) ValidateBasic() error {
+	return validateDenomTransfer(m.Sender, m.Denom, m.Amount)
+}
+
+func (m MsgBasketMint) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgBasketMint) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgBasketRedeem{}
+
+// NewMsgBasketRedeem creates a message to burn an amount of a basket denom, redeeming its share
+// of the basket
+func NewMsgBasketRedeem(sender, denom string, amount sdkmath.Int) *MsgBasketRedeem {
+	return &MsgBasketRedeem{
+		Sender: sender,
+		Denom:  denom,
+		Amount: amount,
+	}
+}
+
+func (m MsgBasketRedeem) Route() string { return RouterKey }
+func (m MsgBasketRedeem) Type() string  { return TypeMsgBasketRedeem }
+func (m MsgBasketRedeem) ValidateBasic() error {
+	return validateDenomTransfer(m.Sender, m.Denom, m.Amount)
+}
+
+func (m MsgBasketRedeem) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgBasketRedeem) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgSetBasketComposition{}
+
+// NewMsgSetBasketComposition creates a message to schedule a change of the components of a
+// basket, or to cancel it with no components
+func NewMsgSetBasketComposition(sender, denom string, components sdk.Coins) *MsgSetBasketComposition {
+	return &MsgSetBasketComposition{
+		Sender:     sender,
+		Denom:      denom,
+		Components: components,
+	}
+}
+
+func (m MsgSetBasketComposition) Route() string { return RouterKey }
+func (m MsgSetBasketComposition) Type() string  { return TypeMsgSetBasketComp }
+func (m MsgSetBasketComposition) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	_, _, err = DeconstructDenom(m.Denom)
+	if err != nil {
+		return err
+	}
+
+	// no components cancel the pending change
+	if len(m.Components) == 0 {
+		return nil
+	}
+
+	return ValidateBasketComponents(m.Denom, m.Components)
+}
+
+func (m MsgSetBasketComposition) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgSetBasketComposition) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgMintVesting{}
+
+// NewMsgMintVesting creates a message to mint tokens into a vesting schedule of the recipient,
+// continuous with an end time and periodic with periods
+func NewMsgMintVesting(sender string, amount sdk.Coin, recipient string, startTime, endTime time.Time, periods []VestingPeriod) *MsgMintVesting {
+	return &MsgMintVesting{
+		Sender:    sender,
+		Amount:    amount,
+		Recipient: recipient,
+		StartTime: startTime,
+		EndTime:   endTime,
+		Periods:   periods,
+	}
+}
+
+func (m MsgMintVesting) Route() string { return RouterKey }
+func (m MsgMintVesting) Type() string  { return TypeMsgMintVesting }
+func (m MsgMintVesting) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	if !m.Amount.IsValid() || m.Amount.Amount.IsZero() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, m.Amount.String())
+	}
+
+	// a zero start time is the block time, which only the keeper can check the end time against
+	return m.Schedule(m.StartTime).Validate()
+}
+
+// Schedule returns the vesting schedule minted by the message, starting at a time.
+func (m MsgMintVesting) Schedule(startTime time.Time) VestingSchedule {
+	return VestingSchedule{
+		Denom:     m.Amount.Denom,
+		Recipient: m.Recipient,
+		Amount:    m.Amount.Amount,
+		StartTime: startTime,
+		EndTime:   m.EndTime,
+		Periods:   m.Periods,
+	}
+}
+
+func (m MsgMintVesting) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgMintVesting) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgClawbackVesting{}
+
+// NewMsgClawbackVesting creates a message to claw back the unvested tokens of a vesting
+// schedule of a holder
+func NewMsgClawbackVesting(sender, denom, holder string) *MsgClawbackVesting {
+	return &MsgClawbackVesting{
+		Sender: sender,
+		Denom:  denom,
+		Holder: holder,
+	}
+}
+
+func (m MsgClawbackVesting) Route() string { return RouterKey }
+func (m MsgClawbackVesting) Type() string  { return TypeMsgClawbackVesting }
+func (m MsgClawbackVesting) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	_, err = sdk.AccAddressFromBech32(m.Holder)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid holder address (%s)", err)
+	}
+
+	_, _, err = DeconstructDenom(m.Denom)
+	return err
+}
+
+func (m MsgClawbackVesting) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgClawbackVesting) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
 var _ sdk.Msg = &MsgUpdateParams{}
 
 // GetSignBytes implements the LegacyMsg interface.
@@ -2,7 +2,9 @@ package types_test
 
 import (
 	fmt "fmt"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/cosmos/tokenfactory/x/tokenfactory/testhelpers"
 	"github.com/cosmos/tokenfactory/x/tokenfactory/types"
@@ -12,6 +14,7 @@ import (
 
 	sdkmath "cosmossdk.io/math"
 
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 )
@@ -118,6 +121,22 @@ func TestMsgCreateDenom(t *testing.T) {
 			}),
 			expectPass: false,
 		},
+		{
+			name: "proper denom hook",
+			msg: createMsg(func(msg types.MsgCreateDenom) types.MsgCreateDenom {
+				msg.DenomHook = &types.DenomHook{ContractAddress: addr1.String()}
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "invalid denom hook contract",
+			msg: createMsg(func(msg types.MsgCreateDenom) types.MsgCreateDenom {
+				msg.DenomHook = &types.DenomHook{ContractAddress: "contract"}
+				return msg
+			}),
+			expectPass: false,
+		},
 	}
 
 	for _, test := range tests {
@@ -191,6 +210,22 @@ func TestMsgMint(t *testing.T) {
 			}),
 			expectPass: false,
 		},
+		{
+			name: "reference id",
+			msg: createMsg(func(msg types.MsgMint) types.MsgMint {
+				msg.ReferenceId = "wire-2024-0001"
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "reference id too long",
+			msg: createMsg(func(msg types.MsgMint) types.MsgMint {
+				msg.ReferenceId = strings.Repeat("a", types.MaxReferenceIDLength+1)
+				return msg
+			}),
+			expectPass: false,
+		},
 	}
 
 	for _, test := range tests {
@@ -261,6 +296,15 @@ func TestMsgBurn(t *testing.T) {
 			},
 			expectPass: false,
 		},
+		{
+			name: "reference id too long",
+			msg: func() *types.MsgBurn {
+				msg := *baseMsg
+				msg.ReferenceId = strings.Repeat("a", types.MaxReferenceIDLength+1)
+				return &msg
+			},
+			expectPass: false,
+		},
 	}
 
 	for _, test := range tests {
@@ -451,3 +495,2028 @@ func TestMsgSetDenomMetadata(t *testing.T) {
 		}
 	}
 }
+
+// TestMsgSetDenomHook tests if valid/invalid set denom hook messages are properly validated/invalidated
+func TestMsgSetDenomHook(t *testing.T) {
+	// generate a private/public key pair and get the respective address
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address())
+	pk2 := ed25519.GenPrivKey().PubKey()
+	addr2 := sdk.AccAddress(pk2.Address())
+	tokenFactoryDenom := fmt.Sprintf("factory/%s/bitcoin", addr1.String())
+
+	// make a proper setDenomHook message
+	baseMsg := *types.NewMsgSetDenomHook(
+		addr1.String(),
+		tokenFactoryDenom,
+		&types.DenomHook{ContractAddress: addr2.String(), Strict: true},
+	)
+
+	// validate setDenomHook message was created as intended
+	require.Equal(t, baseMsg.Route(), types.RouterKey)
+	require.Equal(t, baseMsg.Type(), "set_denom_hook")
+	signers := baseMsg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1.String())
+
+	tests := []struct {
+		name       string
+		msg        func() types.MsgSetDenomHook
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: func() types.MsgSetDenomHook {
+				return baseMsg
+			},
+			expectPass: true,
+		},
+		{
+			name: "hook removal",
+			msg: func() types.MsgSetDenomHook {
+				msg := baseMsg
+				msg.DenomHook = nil
+				return msg
+			},
+			expectPass: true,
+		},
+		{
+			name: "empty sender",
+			msg: func() types.MsgSetDenomHook {
+				msg := baseMsg
+				msg.Sender = ""
+				return msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "invalid denom",
+			msg: func() types.MsgSetDenomHook {
+				msg := baseMsg
+				msg.Denom = "bitcoin"
+				return msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "invalid hook contract",
+			msg: func() types.MsgSetDenomHook {
+				msg := baseMsg
+				msg.DenomHook = &types.DenomHook{ContractAddress: "contract"}
+				return msg
+			},
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg().ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg().ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
+// TestMsgSetBeforeSendHook tests if valid/invalid set before send hook messages are properly validated/invalidated
+func TestMsgSetBeforeSendHook(t *testing.T) {
+	// generate a private/public key pair and get the respective address
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address())
+	pk2 := ed25519.GenPrivKey().PubKey()
+	addr2 := sdk.AccAddress(pk2.Address())
+	tokenFactoryDenom := fmt.Sprintf("factory/%s/bitcoin", addr1.String())
+
+	// make a proper setBeforeSendHook message
+	baseMsg := *types.NewMsgSetBeforeSendHook(
+		addr1.String(),
+		tokenFactoryDenom,
+		addr2.String(),
+	)
+
+	// validate setBeforeSendHook message was created as intended
+	require.Equal(t, baseMsg.Route(), types.RouterKey)
+	require.Equal(t, baseMsg.Type(), "set_before_send_hook")
+	signers := baseMsg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1.String())
+
+	tests := []struct {
+		name       string
+		msg        func() types.MsgSetBeforeSendHook
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: func() types.MsgSetBeforeSendHook {
+				return baseMsg
+			},
+			expectPass: true,
+		},
+		{
+			name: "hook removal",
+			msg: func() types.MsgSetBeforeSendHook {
+				msg := baseMsg
+				msg.CosmwasmAddress = ""
+				return msg
+			},
+			expectPass: true,
+		},
+		{
+			name: "empty sender",
+			msg: func() types.MsgSetBeforeSendHook {
+				msg := baseMsg
+				msg.Sender = ""
+				return msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "invalid denom",
+			msg: func() types.MsgSetBeforeSendHook {
+				msg := baseMsg
+				msg.Denom = "bitcoin"
+				return msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "invalid hook contract",
+			msg: func() types.MsgSetBeforeSendHook {
+				msg := baseMsg
+				msg.CosmwasmAddress = "contract"
+				return msg
+			},
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg().ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg().ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
+// TestMsgMultiMint tests if valid/invalid multi mint messages are properly validated/invalidated
+func TestMsgMultiMint(t *testing.T) {
+	// generate a private/public key pair and get the respective address
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address())
+	pk2 := ed25519.GenPrivKey().PubKey()
+	addr2 := sdk.AccAddress(pk2.Address())
+	tokenFactoryDenom := fmt.Sprintf("factory/%s/bitcoin", addr1.String())
+
+	// make a proper multiMint message
+	baseMsg := *types.NewMsgMultiMint(
+		addr1.String(),
+		tokenFactoryDenom,
+		[]types.MultiMintOutput{
+			{Address: addr1.String(), Amount: sdkmath.NewInt(100)},
+			{Address: addr2.String(), Amount: sdkmath.NewInt(200)},
+		},
+	)
+
+	// validate multiMint message was created as intended
+	require.Equal(t, baseMsg.Route(), types.RouterKey)
+	require.Equal(t, baseMsg.Type(), "tf_multi_mint")
+	signers := baseMsg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1.String())
+
+	tests := []struct {
+		name       string
+		msg        func() types.MsgMultiMint
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: func() types.MsgMultiMint {
+				return baseMsg
+			},
+			expectPass: true,
+		},
+		{
+			name: "empty sender",
+			msg: func() types.MsgMultiMint {
+				msg := baseMsg
+				msg.Sender = ""
+				return msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "invalid denom",
+			msg: func() types.MsgMultiMint {
+				msg := baseMsg
+				msg.Denom = "bitcoin"
+				return msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "no outputs",
+			msg: func() types.MsgMultiMint {
+				msg := baseMsg
+				msg.Outputs = nil
+				return msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "invalid output address",
+			msg: func() types.MsgMultiMint {
+				msg := baseMsg
+				msg.Outputs = []types.MultiMintOutput{{Address: "address", Amount: sdkmath.NewInt(100)}}
+				return msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "duplicate output address",
+			msg: func() types.MsgMultiMint {
+				msg := baseMsg
+				msg.Outputs = []types.MultiMintOutput{
+					{Address: addr2.String(), Amount: sdkmath.NewInt(100)},
+					{Address: addr2.String(), Amount: sdkmath.NewInt(100)},
+				}
+				return msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "zero amount",
+			msg: func() types.MsgMultiMint {
+				msg := baseMsg
+				msg.Outputs = []types.MultiMintOutput{{Address: addr2.String(), Amount: sdkmath.ZeroInt()}}
+				return msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "nil amount",
+			msg: func() types.MsgMultiMint {
+				msg := baseMsg
+				msg.Outputs = []types.MultiMintOutput{{Address: addr2.String()}}
+				return msg
+			},
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg().ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg().ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
+// TestMsgMultiBurn tests if valid/invalid multi burn messages are properly validated/invalidated
+func TestMsgMultiBurn(t *testing.T) {
+	// generate a private/public key pair and get the respective address
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address())
+	pk2 := ed25519.GenPrivKey().PubKey()
+	addr2 := sdk.AccAddress(pk2.Address())
+	tokenFactoryDenom := fmt.Sprintf("factory/%s/bitcoin", addr1.String())
+
+	// make a proper multiBurn message
+	baseMsg := *types.NewMsgMultiBurn(
+		addr1.String(),
+		tokenFactoryDenom,
+		[]types.MultiBurnInput{
+			{Address: addr1.String(), Amount: sdkmath.NewInt(100)},
+			{Address: addr2.String(), Amount: sdkmath.NewInt(200)},
+		},
+	)
+
+	// validate multiBurn message was created as intended
+	require.Equal(t, baseMsg.Route(), types.RouterKey)
+	require.Equal(t, baseMsg.Type(), "tf_multi_burn")
+	signers := baseMsg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1.String())
+
+	tests := []struct {
+		name       string
+		msg        func() types.MsgMultiBurn
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: func() types.MsgMultiBurn {
+				return baseMsg
+			},
+			expectPass: true,
+		},
+		{
+			name: "empty sender",
+			msg: func() types.MsgMultiBurn {
+				msg := baseMsg
+				msg.Sender = ""
+				return msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "invalid denom",
+			msg: func() types.MsgMultiBurn {
+				msg := baseMsg
+				msg.Denom = "bitcoin"
+				return msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "no inputs",
+			msg: func() types.MsgMultiBurn {
+				msg := baseMsg
+				msg.Inputs = nil
+				return msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "invalid input address",
+			msg: func() types.MsgMultiBurn {
+				msg := baseMsg
+				msg.Inputs = []types.MultiBurnInput{{Address: "address", Amount: sdkmath.NewInt(100)}}
+				return msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "duplicate input address",
+			msg: func() types.MsgMultiBurn {
+				msg := baseMsg
+				msg.Inputs = []types.MultiBurnInput{
+					{Address: addr2.String(), Amount: sdkmath.NewInt(100)},
+					{Address: addr2.String(), Amount: sdkmath.NewInt(100)},
+				}
+				return msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "negative amount",
+			msg: func() types.MsgMultiBurn {
+				msg := baseMsg
+				msg.Inputs = []types.MultiBurnInput{{Address: addr2.String(), Amount: sdkmath.NewInt(-1)}}
+				return msg
+			},
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg().ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg().ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
+// TestMsgCreateClaimCampaign tests if valid/invalid create claim campaign messages are properly validated/invalidated
+func TestMsgCreateClaimCampaign(t *testing.T) {
+	// generate a private/public key pair and get the respective address
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address())
+	tokenFactoryDenom := fmt.Sprintf("factory/%s/bitcoin", addr1.String())
+
+	// make a proper createClaimCampaign message
+	baseMsg := *types.NewMsgCreateClaimCampaign(
+		addr1.String(),
+		tokenFactoryDenom,
+		types.ClaimLeafHash(addr1.String(), sdkmath.NewInt(100)),
+		sdkmath.NewInt(1000),
+		nil,
+	)
+
+	// validate createClaimCampaign message was created as intended
+	require.Equal(t, baseMsg.Route(), types.RouterKey)
+	require.Equal(t, baseMsg.Type(), "create_claim_campaign")
+	signers := baseMsg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1.String())
+
+	tests := []struct {
+		name       string
+		msg        func() types.MsgCreateClaimCampaign
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: func() types.MsgCreateClaimCampaign {
+				return baseMsg
+			},
+			expectPass: true,
+		},
+		{
+			name: "empty sender",
+			msg: func() types.MsgCreateClaimCampaign {
+				msg := baseMsg
+				msg.Sender = ""
+				return msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "invalid denom",
+			msg: func() types.MsgCreateClaimCampaign {
+				msg := baseMsg
+				msg.Denom = "bitcoin"
+				return msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "short merkle root",
+			msg: func() types.MsgCreateClaimCampaign {
+				msg := baseMsg
+				msg.MerkleRoot = msg.MerkleRoot[:20]
+				return msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "zero total cap",
+			msg: func() types.MsgCreateClaimCampaign {
+				msg := baseMsg
+				msg.TotalCap = sdkmath.ZeroInt()
+				return msg
+			},
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg().ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg().ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
+// TestMsgClaim tests if valid/invalid claim messages are properly validated/invalidated
+func TestMsgClaim(t *testing.T) {
+	// generate a private/public key pair and get the respective address
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address())
+
+	// make a proper claim message
+	baseMsg := *types.NewMsgClaim(
+		addr1.String(),
+		1,
+		sdkmath.NewInt(100),
+		[][]byte{types.ClaimLeafHash(addr1.String(), sdkmath.NewInt(1))},
+	)
+
+	// validate claim message was created as intended
+	require.Equal(t, baseMsg.Route(), types.RouterKey)
+	require.Equal(t, baseMsg.Type(), "claim")
+	signers := baseMsg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1.String())
+
+	tests := []struct {
+		name       string
+		msg        func() types.MsgClaim
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: func() types.MsgClaim {
+				return baseMsg
+			},
+			expectPass: true,
+		},
+		{
+			name: "empty proof",
+			msg: func() types.MsgClaim {
+				msg := baseMsg
+				msg.Proof = nil
+				return msg
+			},
+			expectPass: true,
+		},
+		{
+			name: "empty sender",
+			msg: func() types.MsgClaim {
+				msg := baseMsg
+				msg.Sender = ""
+				return msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "zero amount",
+			msg: func() types.MsgClaim {
+				msg := baseMsg
+				msg.Amount = sdkmath.ZeroInt()
+				return msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "invalid proof hash",
+			msg: func() types.MsgClaim {
+				msg := baseMsg
+				msg.Proof = [][]byte{{1, 2, 3}}
+				return msg
+			},
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg().ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg().ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
+func TestMsgSetVoucherSigner(t *testing.T) {
+	// generate a private/public key pair and get the respective address
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address())
+
+	// make a proper set voucher signer message
+	baseMsg := *types.NewMsgSetVoucherSigner(
+		addr1.String(),
+		"factory/"+addr1.String()+"/bitcoin",
+		secp256k1.GenPrivKey().PubKey().Bytes(),
+	)
+
+	// validate set voucher signer message was created as intended
+	require.Equal(t, baseMsg.Route(), types.RouterKey)
+	require.Equal(t, baseMsg.Type(), "set_voucher_signer")
+	signers := baseMsg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1.String())
+
+	tests := []struct {
+		name       string
+		msg        func() types.MsgSetVoucherSigner
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: func() types.MsgSetVoucherSigner {
+				return baseMsg
+			},
+			expectPass: true,
+		},
+		{
+			name: "empty public key removes the signer",
+			msg: func() types.MsgSetVoucherSigner {
+				msg := baseMsg
+				msg.PubKey = nil
+				return msg
+			},
+			expectPass: true,
+		},
+		{
+			name: "empty sender",
+			msg: func() types.MsgSetVoucherSigner {
+				msg := baseMsg
+				msg.Sender = ""
+				return msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "invalid denom",
+			msg: func() types.MsgSetVoucherSigner {
+				msg := baseMsg
+				msg.Denom = "bitcoin"
+				return msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "ed25519 public key",
+			msg: func() types.MsgSetVoucherSigner {
+				msg := baseMsg
+				msg.PubKey = pk1.Bytes()
+				return msg
+			},
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg().ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg().ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
+func TestMsgRedeemMintVoucher(t *testing.T) {
+	// generate a private/public key pair and get the respective address
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address())
+
+	// make a proper redeem mint voucher message
+	baseMsg := *types.NewMsgRedeemMintVoucher(
+		addr1.String(),
+		types.MintVoucher{
+			Denom:     "factory/" + addr1.String() + "/bitcoin",
+			Amount:    sdkmath.NewInt(100),
+			Recipient: addr1.String(),
+			Nonce:     1,
+			Expiry:    time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+		[]byte("signature"),
+	)
+
+	// validate redeem mint voucher message was created as intended
+	require.Equal(t, baseMsg.Route(), types.RouterKey)
+	require.Equal(t, baseMsg.Type(), "redeem_mint_voucher")
+	signers := baseMsg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1.String())
+
+	tests := []struct {
+		name       string
+		msg        func() types.MsgRedeemMintVoucher
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: func() types.MsgRedeemMintVoucher {
+				return baseMsg
+			},
+			expectPass: true,
+		},
+		{
+			name: "empty sender",
+			msg: func() types.MsgRedeemMintVoucher {
+				msg := baseMsg
+				msg.Sender = ""
+				return msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "invalid denom",
+			msg: func() types.MsgRedeemMintVoucher {
+				msg := baseMsg
+				msg.Voucher.Denom = "bitcoin"
+				return msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "zero amount",
+			msg: func() types.MsgRedeemMintVoucher {
+				msg := baseMsg
+				msg.Voucher.Amount = sdkmath.ZeroInt()
+				return msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "invalid recipient",
+			msg: func() types.MsgRedeemMintVoucher {
+				msg := baseMsg
+				msg.Voucher.Recipient = "recipient"
+				return msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "no expiry",
+			msg: func() types.MsgRedeemMintVoucher {
+				msg := baseMsg
+				msg.Voucher.Expiry = time.Time{}
+				return msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "empty signature",
+			msg: func() types.MsgRedeemMintVoucher {
+				msg := baseMsg
+				msg.Signature = nil
+				return msg
+			},
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg().ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg().ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
+func TestMsgSetReserveAttestor(t *testing.T) {
+	// generate a private/public key pair and get the respective address
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address())
+
+	// make a proper set reserve attestor message
+	baseMsg := *types.NewMsgSetReserveAttestor(
+		addr1.String(),
+		"factory/"+addr1.String()+"/usd",
+		addr1.String(),
+		24*time.Hour,
+	)
+
+	// validate set reserve attestor message was created as intended
+	require.Equal(t, baseMsg.Route(), types.RouterKey)
+	require.Equal(t, baseMsg.Type(), "set_reserve_attestor")
+	signers := baseMsg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1.String())
+
+	tests := []struct {
+		name       string
+		msg        func() types.MsgSetReserveAttestor
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: func() types.MsgSetReserveAttestor {
+				return baseMsg
+			},
+			expectPass: true,
+		},
+		{
+			name: "empty attestor removes the ceiling",
+			msg: func() types.MsgSetReserveAttestor {
+				msg := baseMsg
+				msg.Attestor = ""
+				msg.MaxStaleness = 0
+				return msg
+			},
+			expectPass: true,
+		},
+		{
+			name: "empty sender",
+			msg: func() types.MsgSetReserveAttestor {
+				msg := baseMsg
+				msg.Sender = ""
+				return msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "invalid denom",
+			msg: func() types.MsgSetReserveAttestor {
+				msg := baseMsg
+				msg.Denom = "usd"
+				return msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "invalid attestor",
+			msg: func() types.MsgSetReserveAttestor {
+				msg := baseMsg
+				msg.Attestor = "attestor"
+				return msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "zero max staleness",
+			msg: func() types.MsgSetReserveAttestor {
+				msg := baseMsg
+				msg.MaxStaleness = 0
+				return msg
+			},
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg().ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg().ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
+func TestMsgAttestReserve(t *testing.T) {
+	// generate a private/public key pair and get the respective address
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address())
+
+	// make a proper attest reserve message
+	baseMsg := *types.NewMsgAttestReserve(
+		addr1.String(),
+		"factory/"+addr1.String()+"/usd",
+		sdkmath.NewInt(1_000_000),
+		time.Date(2024, 6, 30, 0, 0, 0, 0, time.UTC),
+		make([]byte, types.ReportHashLength),
+	)
+
+	// validate attest reserve message was created as intended
+	require.Equal(t, baseMsg.Route(), types.RouterKey)
+	require.Equal(t, baseMsg.Type(), "attest_reserve")
+	signers := baseMsg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1.String())
+
+	tests := []struct {
+		name       string
+		msg        func() types.MsgAttestReserve
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: func() types.MsgAttestReserve {
+				return baseMsg
+			},
+			expectPass: true,
+		},
+		{
+			name: "zero reserve",
+			msg: func() types.MsgAttestReserve {
+				msg := baseMsg
+				msg.Amount = sdkmath.ZeroInt()
+				return msg
+			},
+			expectPass: true,
+		},
+		{
+			name: "empty sender",
+			msg: func() types.MsgAttestReserve {
+				msg := baseMsg
+				msg.Sender = ""
+				return msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "invalid denom",
+			msg: func() types.MsgAttestReserve {
+				msg := baseMsg
+				msg.Denom = "usd"
+				return msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "negative reserve",
+			msg: func() types.MsgAttestReserve {
+				msg := baseMsg
+				msg.Amount = sdkmath.NewInt(-1)
+				return msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "no timestamp",
+			msg: func() types.MsgAttestReserve {
+				msg := baseMsg
+				msg.Timestamp = time.Time{}
+				return msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "report hash not sha256",
+			msg: func() types.MsgAttestReserve {
+				msg := baseMsg
+				msg.ReportHash = []byte("report")
+				return msg
+			},
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg().ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg().ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
+// TestMsgRequestRedemption tests if valid/invalid request redemption messages are properly validated/invalidated
+func TestMsgRequestRedemption(t *testing.T) {
+	// generate a private/public key pair and get the respective address
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address())
+
+	// make a proper request redemption message
+	baseMsg := *types.NewMsgRequestRedemption(
+		addr1.String(),
+		sdk.NewInt64Coin("factory/"+addr1.String()+"/usd", 1000),
+		"IBAN:DE89370400440532013000",
+	)
+
+	// validate request redemption message was created as intended
+	require.Equal(t, baseMsg.Route(), types.RouterKey)
+	require.Equal(t, baseMsg.Type(), "request_redemption")
+	signers := baseMsg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1.String())
+
+	tests := []struct {
+		name       string
+		msg        func() types.MsgRequestRedemption
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: func() types.MsgRequestRedemption {
+				return baseMsg
+			},
+			expectPass: true,
+		},
+		{
+			name: "empty sender",
+			msg: func() types.MsgRequestRedemption {
+				msg := baseMsg
+				msg.Sender = ""
+				return msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "zero amount",
+			msg: func() types.MsgRequestRedemption {
+				msg := baseMsg
+				msg.Amount = sdk.NewInt64Coin(baseMsg.Amount.Denom, 0)
+				return msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "not a factory denom",
+			msg: func() types.MsgRequestRedemption {
+				msg := baseMsg
+				msg.Amount = sdk.NewInt64Coin("stake", 1000)
+				return msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "empty payout reference",
+			msg: func() types.MsgRequestRedemption {
+				msg := baseMsg
+				msg.PayoutReference = ""
+				return msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "payout reference too long",
+			msg: func() types.MsgRequestRedemption {
+				msg := baseMsg
+				msg.PayoutReference = strings.Repeat("a", types.MaxRedemptionReferenceLength+1)
+				return msg
+			},
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg().ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg().ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
+// TestMsgFulfillRedemption tests if valid/invalid fulfill redemption messages are properly validated/invalidated
+func TestMsgFulfillRedemption(t *testing.T) {
+	// generate a private/public key pair and get the respective address
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address())
+
+	// make a proper fulfill redemption message
+	baseMsg := *types.NewMsgFulfillRedemption(addr1.String(), "factory/"+addr1.String()+"/usd", 1, "wire-2024-0001")
+
+	// validate fulfill redemption message was created as intended
+	require.Equal(t, baseMsg.Route(), types.RouterKey)
+	require.Equal(t, baseMsg.Type(), "fulfill_redemption")
+	signers := baseMsg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1.String())
+
+	tests := []struct {
+		name       string
+		msg        func() types.MsgFulfillRedemption
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: func() types.MsgFulfillRedemption {
+				return baseMsg
+			},
+			expectPass: true,
+		},
+		{
+			name: "empty sender",
+			msg: func() types.MsgFulfillRedemption {
+				msg := baseMsg
+				msg.Sender = ""
+				return msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "invalid denom",
+			msg: func() types.MsgFulfillRedemption {
+				msg := baseMsg
+				msg.Denom = "usd"
+				return msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "empty settlement reference",
+			msg: func() types.MsgFulfillRedemption {
+				msg := baseMsg
+				msg.SettlementReference = ""
+				return msg
+			},
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg().ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg().ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
+// TestMsgRejectRedemption tests if valid/invalid reject redemption messages are properly validated/invalidated
+func TestMsgRejectRedemption(t *testing.T) {
+	// generate a private/public key pair and get the respective address
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address())
+
+	// make a proper reject redemption message
+	baseMsg := *types.NewMsgRejectRedemption(addr1.String(), "factory/"+addr1.String()+"/usd", 1, "unknown payout account")
+
+	// validate reject redemption message was created as intended
+	require.Equal(t, baseMsg.Route(), types.RouterKey)
+	require.Equal(t, baseMsg.Type(), "reject_redemption")
+	signers := baseMsg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1.String())
+
+	tests := []struct {
+		name       string
+		msg        func() types.MsgRejectRedemption
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: func() types.MsgRejectRedemption {
+				return baseMsg
+			},
+			expectPass: true,
+		},
+		{
+			name: "no reason",
+			msg: func() types.MsgRejectRedemption {
+				msg := baseMsg
+				msg.Reason = ""
+				return msg
+			},
+			expectPass: true,
+		},
+		{
+			name: "empty sender",
+			msg: func() types.MsgRejectRedemption {
+				msg := baseMsg
+				msg.Sender = ""
+				return msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "invalid denom",
+			msg: func() types.MsgRejectRedemption {
+				msg := baseMsg
+				msg.Denom = "usd"
+				return msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "reason too long",
+			msg: func() types.MsgRejectRedemption {
+				msg := baseMsg
+				msg.Reason = strings.Repeat("a", types.MaxRedemptionReferenceLength+1)
+				return msg
+			},
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg().ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg().ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
+func TestMsgCreateVault(t *testing.T) {
+	// generate a private/public key pair and get the respective address
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address())
+
+	// make a proper create vault message
+	baseMsg := *types.NewMsgCreateVault(addr1.String(), "watom", "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2", sdkmath.NewInt(1_000_000_000_000))
+
+	// validate create vault message was created as intended
+	require.Equal(t, baseMsg.Route(), types.RouterKey)
+	require.Equal(t, baseMsg.Type(), "create_vault")
+	signers := baseMsg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1.String())
+
+	tests := []struct {
+		name       string
+		msg        func() types.MsgCreateVault
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: func() types.MsgCreateVault {
+				return baseMsg
+			},
+			expectPass: true,
+		},
+		{
+			name: "backed by a factory denom",
+			msg: func() types.MsgCreateVault {
+				msg := baseMsg
+				msg.BackingDenom = "factory/" + addr1.String() + "/atom"
+				return msg
+			},
+			expectPass: true,
+		},
+		{
+			name: "empty sender",
+			msg: func() types.MsgCreateVault {
+				msg := baseMsg
+				msg.Sender = ""
+				return msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "invalid subdenom",
+			msg: func() types.MsgCreateVault {
+				msg := baseMsg
+				msg.Subdenom = "wa tom"
+				return msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "invalid backing denom",
+			msg: func() types.MsgCreateVault {
+				msg := baseMsg
+				msg.BackingDenom = "1atom"
+				return msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "backed by itself",
+			msg: func() types.MsgCreateVault {
+				msg := baseMsg
+				msg.BackingDenom = "factory/" + addr1.String() + "/watom"
+				return msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "zero ratio",
+			msg: func() types.MsgCreateVault {
+				msg := baseMsg
+				msg.Ratio = sdkmath.ZeroInt()
+				return msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "ratio too large",
+			msg: func() types.MsgCreateVault {
+				msg := baseMsg
+				msg.Ratio = types.MaxVaultRatio.AddRaw(1)
+				return msg
+			},
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg().ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg().ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
+func TestMsgVaultDeposit(t *testing.T) {
+	// generate a private/public key pair and get the respective address
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address())
+
+	// make a proper vault deposit message
+	baseMsg := *types.NewMsgVaultDeposit(addr1.String(), "factory/"+addr1.String()+"/watom", sdkmath.NewInt(100))
+
+	// validate vault deposit message was created as intended
+	require.Equal(t, baseMsg.Route(), types.RouterKey)
+	require.Equal(t, baseMsg.Type(), "vault_deposit")
+	signers := baseMsg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1.String())
+
+	tests := []struct {
+		name       string
+		msg        func() types.MsgVaultDeposit
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: func() types.MsgVaultDeposit {
+				return baseMsg
+			},
+			expectPass: true,
+		},
+		{
+			name: "empty sender",
+			msg: func() types.MsgVaultDeposit {
+				msg := baseMsg
+				msg.Sender = ""
+				return msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "invalid denom",
+			msg: func() types.MsgVaultDeposit {
+				msg := baseMsg
+				msg.Denom = "watom"
+				return msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "zero amount",
+			msg: func() types.MsgVaultDeposit {
+				msg := baseMsg
+				msg.Amount = sdkmath.ZeroInt()
+				return msg
+			},
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg().ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg().ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
+func TestMsgVaultWithdraw(t *testing.T) {
+	// generate a private/public key pair and get the respective address
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address())
+
+	// make a proper vault withdraw message
+	baseMsg := *types.NewMsgVaultWithdraw(addr1.String(), "factory/"+addr1.String()+"/watom", sdkmath.NewInt(100))
+
+	// validate vault withdraw message was created as intended
+	require.Equal(t, baseMsg.Route(), types.RouterKey)
+	require.Equal(t, baseMsg.Type(), "vault_withdraw")
+	signers := baseMsg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1.String())
+
+	tests := []struct {
+		name       string
+		msg        func() types.MsgVaultWithdraw
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: func() types.MsgVaultWithdraw {
+				return baseMsg
+			},
+			expectPass: true,
+		},
+		{
+			name: "empty sender",
+			msg: func() types.MsgVaultWithdraw {
+				msg := baseMsg
+				msg.Sender = ""
+				return msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "invalid denom",
+			msg: func() types.MsgVaultWithdraw {
+				msg := baseMsg
+				msg.Denom = "watom"
+				return msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "negative amount",
+			msg: func() types.MsgVaultWithdraw {
+				msg := baseMsg
+				msg.Amount = sdkmath.NewInt(-1)
+				return msg
+			},
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg().ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg().ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
+func TestMsgRegisterCW20(t *testing.T) {
+	// generate a private/public key pair and get the respective address
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address())
+	contract := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+
+	// make a proper register cw20 message
+	baseMsg := *types.NewMsgRegisterCW20(addr1.String(), "factory/"+addr1.String()+"/wrapped", contract.String())
+
+	// validate register cw20 message was created as intended
+	require.Equal(t, baseMsg.Route(), types.RouterKey)
+	require.Equal(t, baseMsg.Type(), "register_cw20")
+	signers := baseMsg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1.String())
+
+	tests := []struct {
+		name       string
+		msg        func() types.MsgRegisterCW20
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: func() types.MsgRegisterCW20 {
+				return baseMsg
+			},
+			expectPass: true,
+		},
+		{
+			name: "empty sender",
+			msg: func() types.MsgRegisterCW20 {
+				msg := baseMsg
+				msg.Sender = ""
+				return msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "invalid denom",
+			msg: func() types.MsgRegisterCW20 {
+				msg := baseMsg
+				msg.Denom = "wrapped"
+				return msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "invalid contract address",
+			msg: func() types.MsgRegisterCW20 {
+				msg := baseMsg
+				msg.ContractAddress = "contract"
+				return msg
+			},
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg().ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg().ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
+func TestMsgWithdrawCW20(t *testing.T) {
+	// generate a private/public key pair and get the respective address
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address())
+
+	// make a proper withdraw cw20 message
+	baseMsg := *types.NewMsgWithdrawCW20(addr1.String(), sdk.NewInt64Coin("factory/"+addr1.String()+"/wrapped", 100))
+
+	// validate withdraw cw20 message was created as intended
+	require.Equal(t, baseMsg.Route(), types.RouterKey)
+	require.Equal(t, baseMsg.Type(), "withdraw_cw20")
+	signers := baseMsg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1.String())
+
+	tests := []struct {
+		name       string
+		msg        func() types.MsgWithdrawCW20
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: func() types.MsgWithdrawCW20 {
+				return baseMsg
+			},
+			expectPass: true,
+		},
+		{
+			name: "empty sender",
+			msg: func() types.MsgWithdrawCW20 {
+				msg := baseMsg
+				msg.Sender = ""
+				return msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "not a factory denom",
+			msg: func() types.MsgWithdrawCW20 {
+				msg := baseMsg
+				msg.Amount = sdk.NewInt64Coin("uatom", 100)
+				return msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "zero amount",
+			msg: func() types.MsgWithdrawCW20 {
+				msg := baseMsg
+				msg.Amount = sdk.NewInt64Coin(baseMsg.Amount.Denom, 0)
+				return msg
+			},
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg().ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg().ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
+func TestMsgCreateBasket(t *testing.T) {
+	// generate a private/public key pair and get the respective address
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address())
+
+	// make a proper create basket message
+	baseMsg := *types.NewMsgCreateBasket(addr1.String(), "index", sdk.NewCoins(sdk.NewInt64Coin("uatom", 10), sdk.NewInt64Coin("factory/"+addr1.String()+"/usd", 5)), 7*24*time.Hour)
+
+	// validate create basket message was created as intended
+	require.Equal(t, baseMsg.Route(), types.RouterKey)
+	require.Equal(t, baseMsg.Type(), "create_basket")
+	signers := baseMsg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1.String())
+
+	tests := []struct {
+		name       string
+		msg        func() types.MsgCreateBasket
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: func() types.MsgCreateBasket {
+				return baseMsg
+			},
+			expectPass: true,
+		},
+		{
+			name: "empty sender",
+			msg: func() types.MsgCreateBasket {
+				msg := baseMsg
+				msg.Sender = ""
+				return msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "invalid subdenom",
+			msg: func() types.MsgCreateBasket {
+				msg := baseMsg
+				msg.Subdenom = "in dex"
+				return msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "no components",
+			msg: func() types.MsgCreateBasket {
+				msg := baseMsg
+				msg.Components = sdk.NewCoins()
+				return msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "zero component",
+			msg: func() types.MsgCreateBasket {
+				msg := baseMsg
+				msg.Components = sdk.Coins{sdk.NewInt64Coin("uatom", 0)}
+				return msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "composed of itself",
+			msg: func() types.MsgCreateBasket {
+				msg := baseMsg
+				msg.Components = sdk.NewCoins(sdk.NewInt64Coin("factory/"+addr1.String()+"/index", 1))
+				return msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "too many components",
+			msg: func() types.MsgCreateBasket {
+				msg := baseMsg
+				msg.Components = sdk.NewCoins()
+				for i := 0; i <= types.MaxBasketComponents; i++ {
+					msg.Components = msg.Components.Add(sdk.NewInt64Coin(fmt.Sprintf("denom%d", i), 1))
+				}
+				return msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "timelock too short",
+			msg: func() types.MsgCreateBasket {
+				msg := baseMsg
+				msg.CompositionTimelock = time.Hour
+				return msg
+			},
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg().ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg().ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
+func TestMsgBasketMint(t *testing.T) {
+	// generate a private/public key pair and get the respective address
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address())
+
+	// make a proper basket mint message
+	baseMsg := *types.NewMsgBasketMint(addr1.String(), "factory/"+addr1.String()+"/index", sdkmath.NewInt(100))
+
+	// validate basket mint message was created as intended
+	require.Equal(t, baseMsg.Route(), types.RouterKey)
+	require.Equal(t, baseMsg.Type(), "basket_mint")
+	signers := baseMsg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1.String())
+
+	tests := []struct {
+		name       string
+		msg        func() types.MsgBasketMint
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: func() types.MsgBasketMint {
+				return baseMsg
+			},
+			expectPass: true,
+		},
+		{
+			name: "empty sender",
+			msg: func() types.MsgBasketMint {
+				msg := baseMsg
+				msg.Sender = ""
+				return msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "invalid denom",
+			msg: func() types.MsgBasketMint {
+				msg := baseMsg
+				msg.Denom = "index"
+				return msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "zero amount",
+			msg: func() types.MsgBasketMint {
+				msg := baseMsg
+				msg.Amount = sdkmath.ZeroInt()
+				return msg
+			},
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg().ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg().ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
+func TestMsgBasketRedeem(t *testing.T) {
+	// generate a private/public key pair and get the respective address
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address())
+
+	// make a proper basket redeem message
+	baseMsg := *types.NewMsgBasketRedeem(addr1.String(), "factory/"+addr1.String()+"/index", sdkmath.NewInt(100))
+
+	// validate basket redeem message was created as intended
+	require.Equal(t, baseMsg.Route(), types.RouterKey)
+	require.Equal(t, baseMsg.Type(), "basket_redeem")
+	signers := baseMsg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1.String())
+
+	tests := []struct {
+		name       string
+		msg        func() types.MsgBasketRedeem
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: func() types.MsgBasketRedeem {
+				return baseMsg
+			},
+			expectPass: true,
+		},
+		{
+			name: "empty sender",
+			msg: func() types.MsgBasketRedeem {
+				msg := baseMsg
+				msg.Sender = ""
+				return msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "invalid denom",
+			msg: func() types.MsgBasketRedeem {
+				msg := baseMsg
+				msg.Denom = "index"
+				return msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "zero amount",
+			msg: func() types.MsgBasketRedeem {
+				msg := baseMsg
+				msg.Amount = sdkmath.ZeroInt()
+				return msg
+			},
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg().ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg().ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
+func TestMsgSetBasketComposition(t *testing.T) {
+	// generate a private/public key pair and get the respective address
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address())
+
+	// make a proper set basket composition message
+	baseMsg := *types.NewMsgSetBasketComposition(addr1.String(), "factory/"+addr1.String()+"/index", sdk.NewCoins(sdk.NewInt64Coin("uatom", 10)))
+
+	// validate set basket composition message was created as intended
+	require.Equal(t, baseMsg.Route(), types.RouterKey)
+	require.Equal(t, baseMsg.Type(), "set_basket_composition")
+	signers := baseMsg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1.String())
+
+	tests := []struct {
+		name       string
+		msg        func() types.MsgSetBasketComposition
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: func() types.MsgSetBasketComposition {
+				return baseMsg
+			},
+			expectPass: true,
+		},
+		{
+			name: "cancel",
+			msg: func() types.MsgSetBasketComposition {
+				msg := baseMsg
+				msg.Components = nil
+				return msg
+			},
+			expectPass: true,
+		},
+		{
+			name: "empty sender",
+			msg: func() types.MsgSetBasketComposition {
+				msg := baseMsg
+				msg.Sender = ""
+				return msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "invalid denom",
+			msg: func() types.MsgSetBasketComposition {
+				msg := baseMsg
+				msg.Denom = "index"
+				return msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "composed of itself",
+			msg: func() types.MsgSetBasketComposition {
+				msg := baseMsg
+				msg.Components = sdk.NewCoins(sdk.NewInt64Coin(msg.Denom, 1))
+				return msg
+			},
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg().ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg().ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
+func TestMsgMintVesting(t *testing.T) {
+	// generate a private/public key pair and get the respective address
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address())
+	pk2 := ed25519.GenPrivKey().PubKey()
+	addr2 := sdk.AccAddress(pk2.Address())
+
+	// make a proper mint vesting message
+	baseMsg := *types.NewMsgMintVesting(
+		addr1.String(),
+		sdk.NewInt64Coin("factory/"+addr1.String()+"/team", 1000),
+		addr2.String(),
+		time.Time{},
+		time.Unix(1_000_000, 0).UTC(),
+		nil,
+	)
+
+	// validate mint vesting message was created as intended
+	require.Equal(t, baseMsg.Route(), types.RouterKey)
+	require.Equal(t, baseMsg.Type(), "mint_vesting")
+	signers := baseMsg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1.String())
+
+	periods := []types.VestingPeriod{
+		{Length: time.Hour, Amount: sdkmath.NewInt(400)},
+		{Length: 2 * time.Hour, Amount: sdkmath.NewInt(600)},
+	}
+
+	tests := []struct {
+		name       string
+		msg        func() types.MsgMintVesting
+		expectPass bool
+	}{
+		{
+			name: "proper continuous msg",
+			msg: func() types.MsgMintVesting {
+				return baseMsg
+			},
+			expectPass: true,
+		},
+		{
+			name: "proper periodic msg",
+			msg: func() types.MsgMintVesting {
+				msg := baseMsg
+				msg.EndTime = time.Time{}
+				msg.Periods = periods
+				return msg
+			},
+			expectPass: true,
+		},
+		{
+			name: "empty sender",
+			msg: func() types.MsgMintVesting {
+				msg := baseMsg
+				msg.Sender = ""
+				return msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "invalid recipient",
+			msg: func() types.MsgMintVesting {
+				msg := baseMsg
+				msg.Recipient = "team"
+				return msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "zero amount",
+			msg: func() types.MsgMintVesting {
+				msg := baseMsg
+				msg.Amount = sdk.NewInt64Coin(msg.Amount.Denom, 0)
+				return msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "not a factory denom",
+			msg: func() types.MsgMintVesting {
+				msg := baseMsg
+				msg.Amount = sdk.NewInt64Coin("uatom", 1000)
+				return msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "no end time and no periods",
+			msg: func() types.MsgMintVesting {
+				msg := baseMsg
+				msg.EndTime = time.Time{}
+				return msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "end time before start time",
+			msg: func() types.MsgMintVesting {
+				msg := baseMsg
+				msg.StartTime = time.Unix(2_000_000, 0).UTC()
+				return msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "end time and periods",
+			msg: func() types.MsgMintVesting {
+				msg := baseMsg
+				msg.Periods = periods
+				return msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "periods not adding up to the amount",
+			msg: func() types.MsgMintVesting {
+				msg := baseMsg
+				msg.EndTime = time.Time{}
+				msg.Periods = periods[:1]
+				return msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "zero period length",
+			msg: func() types.MsgMintVesting {
+				msg := baseMsg
+				msg.EndTime = time.Time{}
+				msg.Periods = []types.VestingPeriod{{Amount: sdkmath.NewInt(1000)}}
+				return msg
+			},
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg().ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg().ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
+func TestMsgClawbackVesting(t *testing.T) {
+	// generate a private/public key pair and get the respective address
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address())
+	pk2 := ed25519.GenPrivKey().PubKey()
+	addr2 := sdk.AccAddress(pk2.Address())
+
+	// make a proper clawback vesting message
+	baseMsg := *types.NewMsgClawbackVesting(addr1.String(), "factory/"+addr1.String()+"/team", addr2.String())
+
+	// validate clawback vesting message was created as intended
+	require.Equal(t, baseMsg.Route(), types.RouterKey)
+	require.Equal(t, baseMsg.Type(), "clawback_vesting")
+	signers := baseMsg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1.String())
+
+	tests := []struct {
+		name       string
+		msg        func() types.MsgClawbackVesting
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: func() types.MsgClawbackVesting {
+				return baseMsg
+			},
+			expectPass: true,
+		},
+		{
+			name: "empty sender",
+			msg: func() types.MsgClawbackVesting {
+				msg := baseMsg
+				msg.Sender = ""
+				return msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "invalid holder",
+			msg: func() types.MsgClawbackVesting {
+				msg := baseMsg
+				msg.Holder = "team"
+				return msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "invalid denom",
+			msg: func() types.MsgClawbackVesting {
+				msg := baseMsg
+				msg.Denom = "team"
+				return msg
+			},
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg().ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg().ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -17,6 +18,10 @@ func DefaultParams() Params {
 	return Params{
 		DenomCreationFee:        sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10_000_000)),
 		DenomCreationGasConsume: 2_000_000,
+		WasmQueryGasPerItem:     1_000,
+		DenomHookGasLimit:       500_000,
+		BeforeSendHookGasLimit:  500_000,
+		RedemptionTimeout:       7 * 24 * time.Hour,
 	}
 }
 
@@ -28,6 +33,31 @@ func (p Params) Validate() error {
 	}
 
 	err = validateDenomCreationFeeGasConsume(p.DenomCreationGasConsume)
+	if err != nil {
+		return err
+	}
+
+	err = validateWasmQueryGasPerItem(p.WasmQueryGasPerItem)
+	if err != nil {
+		return err
+	}
+
+	err = validateDenomHookGasLimit(p.DenomHookGasLimit)
+	if err != nil {
+		return err
+	}
+
+	err = validateBeforeSendHookGasLimit(p.BeforeSendHookGasLimit)
+	if err != nil {
+		return err
+	}
+
+	err = validateReferenceIDRetentionBlocks(p.ReferenceIdRetentionBlocks)
+	if err != nil {
+		return err
+	}
+
+	err = validateRedemptionTimeout(p.RedemptionTimeout)
 
 	return err
 }
@@ -53,3 +83,52 @@ func validateDenomCreationFeeGasConsume(i interface{}) error {
 
 	return nil
 }
+
+func validateWasmQueryGasPerItem(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateDenomHookGasLimit(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateBeforeSendHookGasLimit(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateReferenceIDRetentionBlocks(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateRedemptionTimeout(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v < 0 {
+		return fmt.Errorf("redemption timeout can not be negative: %s", v)
+	}
+
+	return nil
+}
@@ -10,15 +10,19 @@ import (
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -33,6 +37,22 @@ type Params struct {
 	// to the base cost.
 	// https://github.com/CosmWasm/token-factory/issues/11
 	DenomCreationGasConsume uint64 `protobuf:"varint,2,opt,name=denom_creation_gas_consume,json=denomCreationGasConsume,proto3" json:"denom_creation_gas_consume,omitempty" yaml:"denom_creation_gas_consume"`
+	// gas consumed for every item visited by the list-returning custom wasm
+	// queries, on top of the store reads, so that contracts pay for the size of
+	// the scan they request.
+	WasmQueryGasPerItem uint64 `protobuf:"varint,3,opt,name=wasm_query_gas_per_item,json=wasmQueryGasPerItem,proto3" json:"wasm_query_gas_per_item,omitempty" yaml:"wasm_query_gas_per_item"`
+	// gas limit of the sudo calls to the denom hook contracts.
+	DenomHookGasLimit uint64 `protobuf:"varint,4,opt,name=denom_hook_gas_limit,json=denomHookGasLimit,proto3" json:"denom_hook_gas_limit,omitempty" yaml:"denom_hook_gas_limit"`
+	// gas limit of the calls to the before send hook contracts, made on every
+	// transfer of a denom with a before send hook.
+	BeforeSendHookGasLimit uint64 `protobuf:"varint,5,opt,name=before_send_hook_gas_limit,json=beforeSendHookGasLimit,proto3" json:"before_send_hook_gas_limit,omitempty" yaml:"before_send_hook_gas_limit"`
+	// number of blocks the reference ids of MsgMint and MsgBurn are kept after
+	// their use, after which they are pruned and can be used again. The
+	// reference ids are never pruned when it is zero.
+	ReferenceIdRetentionBlocks uint64 `protobuf:"varint,6,opt,name=reference_id_retention_blocks,json=referenceIdRetentionBlocks,proto3" json:"reference_id_retention_blocks,omitempty" yaml:"reference_id_retention_blocks"`
+	// time after which an open redemption request is refunded to its holder.
+	// Redemption requests never time out when it is zero.
+	RedemptionTimeout time.Duration `protobuf:"bytes,7,opt,name=redemption_timeout,json=redemptionTimeout,proto3,stdduration" json:"redemption_timeout" yaml:"redemption_timeout"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -82,6 +102,41 @@ func (m *Params) GetDenomCreationGasConsume() uint64 {
 	return 0
 }
 
+func (m *Params) GetWasmQueryGasPerItem() uint64 {
+	if m != nil {
+		return m.WasmQueryGasPerItem
+	}
+	return 0
+}
+
+func (m *Params) GetDenomHookGasLimit() uint64 {
+	if m != nil {
+		return m.DenomHookGasLimit
+	}
+	return 0
+}
+
+func (m *Params) GetBeforeSendHookGasLimit() uint64 {
+	if m != nil {
+		return m.BeforeSendHookGasLimit
+	}
+	return 0
+}
+
+func (m *Params) GetReferenceIdRetentionBlocks() uint64 {
+	if m != nil {
+		return m.ReferenceIdRetentionBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetRedemptionTimeout() time.Duration {
+	if m != nil {
+		return m.RedemptionTimeout
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "osmosis.tokenfactory.v1beta1.Params")
 }
@@ -91,29 +146,43 @@ func init() {
 }
 
 var fileDescriptor_cc8299d306f3ff47 = []byte{
-	// 341 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x91, 0xb1, 0x4e, 0xc2, 0x40,
-	0x18, 0xc7, 0x7b, 0x68, 0x18, 0xea, 0x62, 0x1a, 0x13, 0x81, 0x98, 0x2b, 0x76, 0x82, 0xc1, 0x36,
-	0x88, 0x93, 0x23, 0x24, 0x3a, 0x91, 0x18, 0x46, 0x97, 0xe6, 0x6b, 0x39, 0x4a, 0x83, 0xed, 0x47,
-	0x7a, 0x87, 0xb1, 0x8f, 0xe0, 0xe6, 0xe4, 0x43, 0xf8, 0x24, 0x8c, 0x8c, 0x4e, 0xd5, 0xc0, 0x1b,
-	0xf0, 0x04, 0x86, 0xbb, 0xd3, 0x50, 0x31, 0x4e, 0xed, 0x97, 0xff, 0xff, 0xff, 0xbb, 0xff, 0x77,
-	0x67, 0xb6, 0x91, 0x27, 0xc8, 0x63, 0xee, 0x09, 0x9c, 0xb2, 0x74, 0x0c, 0xa1, 0xc0, 0x2c, 0xf7,
-	0x1e, 0x3b, 0x01, 0x13, 0xd0, 0xf1, 0x66, 0x90, 0x41, 0xc2, 0xdd, 0x59, 0x86, 0x02, 0xad, 0x33,
-	0x6d, 0x75, 0x77, 0xad, 0xae, 0xb6, 0x36, 0x4e, 0x22, 0x8c, 0x50, 0x1a, 0xbd, 0xed, 0x9f, 0xca,
-	0x34, 0xae, 0xfe, 0xc5, 0xc3, 0x5c, 0x4c, 0x30, 0x8b, 0x45, 0x3e, 0x60, 0x02, 0x46, 0x20, 0x40,
-	0xa7, 0xea, 0xa1, 0x8c, 0xf9, 0x0a, 0xa7, 0x06, 0x2d, 0x51, 0x35, 0x79, 0x01, 0x70, 0xf6, 0xc3,
-	0x09, 0x31, 0x4e, 0x95, 0xee, 0x3c, 0x57, 0xcc, 0xea, 0x9d, 0x6c, 0x6d, 0xbd, 0x12, 0xd3, 0x1a,
-	0xb1, 0x14, 0x13, 0x3f, 0xcc, 0x18, 0x88, 0x18, 0x53, 0x7f, 0xcc, 0x58, 0x8d, 0x34, 0x0f, 0x5a,
-	0x47, 0x97, 0x75, 0x57, 0x63, 0xb7, 0xa0, 0xef, 0x25, 0xdc, 0x3e, 0xc6, 0x69, 0x6f, 0xb0, 0x28,
-	0x6c, 0x63, 0x53, 0xd8, 0xf5, 0x1c, 0x92, 0x87, 0x6b, 0x67, 0x1f, 0xe1, 0xbc, 0x7d, 0xd8, 0xad,
-	0x28, 0x16, 0x93, 0x79, 0xe0, 0x86, 0x98, 0xe8, 0x82, 0xfa, 0x73, 0xc1, 0x47, 0x53, 0x4f, 0xe4,
-	0x33, 0xc6, 0x25, 0x8d, 0x0f, 0x8f, 0x25, 0xa0, 0xaf, 0xf3, 0x37, 0x8c, 0x59, 0x63, 0xb3, 0xf1,
-	0x0b, 0x1a, 0x01, 0xf7, 0x43, 0x4c, 0xf9, 0x3c, 0x61, 0xb5, 0x4a, 0x93, 0xb4, 0x0e, 0x7b, 0xed,
-	0x45, 0x61, 0x93, 0x4d, 0x61, 0x9f, 0xff, 0x59, 0x62, 0xc7, 0xef, 0x0c, 0x4f, 0x4b, 0x07, 0xdc,
-	0x02, 0xef, 0x2b, 0xa5, 0x37, 0x58, 0xac, 0x28, 0x59, 0xae, 0x28, 0xf9, 0x5c, 0x51, 0xf2, 0xb2,
-	0xa6, 0xc6, 0x72, 0x4d, 0x8d, 0xf7, 0x35, 0x35, 0xee, 0xbb, 0xfb, 0xed, 0x4b, 0x0f, 0xf4, 0x54,
-	0x1e, 0xe5, 0x3a, 0x41, 0x55, 0xde, 0x70, 0xf7, 0x2b, 0x00, 0x00, 0xff, 0xff, 0x32, 0x2d, 0xe6,
-	0xd9, 0x33, 0x02, 0x00, 0x00,
+	// 565 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x53, 0xcb, 0x6e, 0xd3, 0x4c,
+	0x14, 0x8e, 0xff, 0x5e, 0x7e, 0xc9, 0x6c, 0xa8, 0xa9, 0xa8, 0x1b, 0xc0, 0x0e, 0x16, 0x95, 0xd2,
+	0x05, 0xb6, 0x4a, 0x59, 0xb1, 0x4c, 0x10, 0xa1, 0x12, 0x91, 0x82, 0x61, 0x81, 0xd8, 0x58, 0x63,
+	0xfb, 0xd8, 0xb1, 0x9c, 0xf1, 0x09, 0x33, 0x63, 0x20, 0x6f, 0xc1, 0x0a, 0xf1, 0x0c, 0x3c, 0x49,
+	0x96, 0x5d, 0xb2, 0x72, 0x51, 0xf2, 0x06, 0x59, 0xb0, 0x46, 0x19, 0x3b, 0x6d, 0xdc, 0xd0, 0xae,
+	0x92, 0x33, 0xdf, 0xed, 0xcc, 0xe8, 0xb3, 0x7a, 0x8c, 0x9c, 0x22, 0x4f, 0xb8, 0x23, 0x30, 0x85,
+	0x2c, 0x22, 0x81, 0x40, 0x36, 0x71, 0x3e, 0x9f, 0xf8, 0x20, 0xc8, 0x89, 0x33, 0x26, 0x8c, 0x50,
+	0x6e, 0x8f, 0x19, 0x0a, 0xd4, 0x1e, 0x56, 0x54, 0x7b, 0x9d, 0x6a, 0x57, 0xd4, 0xe6, 0x7e, 0x8c,
+	0x31, 0x4a, 0xa2, 0xb3, 0xfc, 0x57, 0x6a, 0x9a, 0xcf, 0x6f, 0xb5, 0x27, 0xb9, 0x18, 0x22, 0x4b,
+	0xc4, 0xa4, 0x0f, 0x82, 0x84, 0x44, 0x90, 0x4a, 0x75, 0x18, 0x48, 0x99, 0x57, 0xda, 0x95, 0x43,
+	0x05, 0x19, 0xe5, 0xe4, 0xf8, 0x84, 0xc3, 0xa5, 0x4f, 0x80, 0x49, 0xb6, 0xc2, 0x63, 0xc4, 0x78,
+	0x04, 0x8e, 0x9c, 0xfc, 0x3c, 0x72, 0xc2, 0x9c, 0x11, 0x91, 0x60, 0x85, 0x5b, 0x7f, 0x76, 0xd4,
+	0xdd, 0x81, 0xbc, 0x95, 0xf6, 0x5d, 0x51, 0xb5, 0x10, 0x32, 0xa4, 0x5e, 0xc0, 0x40, 0x72, 0xbc,
+	0x08, 0x40, 0x57, 0x5a, 0x5b, 0xed, 0x3b, 0xcf, 0x0e, 0xed, 0x2a, 0x76, 0x19, 0xb4, 0xba, 0xa4,
+	0xdd, 0xc5, 0x24, 0xeb, 0xf4, 0xa7, 0x85, 0xd9, 0x58, 0x14, 0xe6, 0xe1, 0x84, 0xd0, 0xd1, 0x0b,
+	0x6b, 0xd3, 0xc2, 0xfa, 0x79, 0x61, 0xb6, 0xe3, 0x44, 0x0c, 0x73, 0xdf, 0x0e, 0x90, 0x56, 0x17,
+	0xa8, 0x7e, 0x9e, 0xf2, 0x30, 0x75, 0xc4, 0x64, 0x0c, 0x5c, 0xba, 0x71, 0xf7, 0xae, 0x34, 0xe8,
+	0x56, 0xfa, 0x57, 0x00, 0x5a, 0xa4, 0x36, 0xaf, 0x99, 0xc6, 0x84, 0x7b, 0x01, 0x66, 0x3c, 0xa7,
+	0xa0, 0xff, 0xd7, 0x52, 0xda, 0xdb, 0x9d, 0xe3, 0x69, 0x61, 0x2a, 0x8b, 0xc2, 0x7c, 0xfc, 0xcf,
+	0x25, 0xd6, 0xf8, 0x96, 0x7b, 0x50, 0x0b, 0xe8, 0x11, 0xde, 0x2d, 0x11, 0xed, 0x83, 0x7a, 0xf0,
+	0x85, 0x70, 0xea, 0x7d, 0xca, 0x81, 0x4d, 0xa4, 0x66, 0x0c, 0xcc, 0x4b, 0x04, 0x50, 0x7d, 0x4b,
+	0x86, 0x58, 0x8b, 0xc2, 0x34, 0xca, 0x80, 0x1b, 0x88, 0x96, 0x7b, 0x6f, 0x89, 0xbc, 0x5d, 0x02,
+	0x3d, 0xc2, 0x07, 0xc0, 0xce, 0x04, 0x50, 0x6d, 0xa0, 0xee, 0x97, 0x1b, 0x0d, 0x11, 0x53, 0x29,
+	0x18, 0x25, 0x34, 0x11, 0xfa, 0xb6, 0xb4, 0x35, 0x17, 0x85, 0xf9, 0x60, 0x7d, 0xef, 0x3a, 0xcb,
+	0x72, 0xf7, 0xe4, 0xf1, 0x6b, 0xc4, 0xb4, 0x47, 0xf8, 0x9b, 0xe5, 0x99, 0x46, 0xd4, 0xa6, 0x0f,
+	0x11, 0x32, 0xf0, 0x38, 0x64, 0xe1, 0x75, 0xdf, 0x1d, 0xe9, 0x7b, 0x74, 0xf5, 0x1e, 0x37, 0x73,
+	0x2d, 0xf7, 0x7e, 0x09, 0xbe, 0x83, 0x2c, 0xac, 0x45, 0xa4, 0xea, 0x23, 0x06, 0x11, 0x30, 0xc8,
+	0x02, 0xf0, 0x92, 0xd0, 0x63, 0x20, 0x20, 0x93, 0xcf, 0xe9, 0x8f, 0x30, 0x48, 0xb9, 0xbe, 0x2b,
+	0x53, 0xda, 0x8b, 0xc2, 0x7c, 0x52, 0xa6, 0xdc, 0x4a, 0xb7, 0xdc, 0xe6, 0x25, 0x7e, 0x16, 0xba,
+	0x2b, 0xb4, 0x23, 0x41, 0x0d, 0x55, 0x8d, 0x41, 0x08, 0x74, 0x2c, 0x15, 0x22, 0xa1, 0x80, 0xb9,
+	0xd0, 0xff, 0x6f, 0x29, 0xb2, 0x7b, 0x65, 0x89, 0xed, 0x55, 0x89, 0xed, 0x97, 0x55, 0x89, 0x3b,
+	0x47, 0xf5, 0xee, 0x6d, 0x5a, 0x58, 0x3f, 0x2e, 0x4c, 0xc5, 0xdd, 0xbb, 0x02, 0xde, 0x97, 0xe7,
+	0x9d, 0xfe, 0x74, 0x66, 0x28, 0xe7, 0x33, 0x43, 0xf9, 0x3d, 0x33, 0x94, 0x6f, 0x73, 0xa3, 0x71,
+	0x3e, 0x37, 0x1a, 0xbf, 0xe6, 0x46, 0xe3, 0xe3, 0xe9, 0x66, 0x55, 0x6b, 0x5f, 0xeb, 0xd7, 0xfa,
+	0x28, 0xbb, 0xeb, 0xef, 0xca, 0xdd, 0x4e, 0xff, 0x06, 0x00, 0x00, 0xff, 0xff, 0xf7, 0x61, 0xae,
+	0x84, 0x40, 0x04, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -136,6 +205,34 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.RedemptionTimeout, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.RedemptionTimeout):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintParams(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x3a
+	if m.ReferenceIdRetentionBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ReferenceIdRetentionBlocks))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.BeforeSendHookGasLimit != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.BeforeSendHookGasLimit))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.DenomHookGasLimit != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.DenomHookGasLimit))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.WasmQueryGasPerItem != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.WasmQueryGasPerItem))
+		i--
+		dAtA[i] = 0x18
+	}
 	if m.DenomCreationGasConsume != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.DenomCreationGasConsume))
 		i--
@@ -184,6 +281,20 @@ func (m *Params) Size() (n int) {
 	if m.DenomCreationGasConsume != 0 {
 		n += 1 + sovParams(uint64(m.DenomCreationGasConsume))
 	}
+	if m.WasmQueryGasPerItem != 0 {
+		n += 1 + sovParams(uint64(m.WasmQueryGasPerItem))
+	}
+	if m.DenomHookGasLimit != 0 {
+		n += 1 + sovParams(uint64(m.DenomHookGasLimit))
+	}
+	if m.BeforeSendHookGasLimit != 0 {
+		n += 1 + sovParams(uint64(m.BeforeSendHookGasLimit))
+	}
+	if m.ReferenceIdRetentionBlocks != 0 {
+		n += 1 + sovParams(uint64(m.ReferenceIdRetentionBlocks))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.RedemptionTimeout)
+	n += 1 + l + sovParams(uint64(l))
 	return n
 }
 
@@ -275,6 +386,115 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WasmQueryGasPerItem", wireType)
+			}
+			m.WasmQueryGasPerItem = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WasmQueryGasPerItem |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomHookGasLimit", wireType)
+			}
+			m.DenomHookGasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DenomHookGasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BeforeSendHookGasLimit", wireType)
+			}
+			m.BeforeSendHookGasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BeforeSendHookGasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReferenceIdRetentionBlocks", wireType)
+			}
+			m.ReferenceIdRetentionBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReferenceIdRetentionBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedemptionTimeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.RedemptionTimeout, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -0,0 +1,85 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// MaxRedemptionReferenceLength is the maximum length, in bytes, of the payout and settlement
+// references and of the reject reason of a redemption request
+const MaxRedemptionReferenceLength = 256
+
+// MaxRedemptionRefundsPerBlock is the maximum number of timed out redemption requests refunded
+// by the end blocker of a block, the others are refunded in the next blocks
+const MaxRedemptionRefundsPerBlock = 100
+
+// ValidateRedemptionReference returns an error if the payout or settlement reference of a
+// redemption request is empty or too long.
+func ValidateRedemptionReference(reference string) error {
+	if reference == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "redemption reference can not be empty")
+	}
+	return ValidateRejectReason(reference)
+}
+
+// ValidateRejectReason returns an error if the optional reject reason of a redemption request is
+// too long.
+func ValidateRejectReason(reason string) error {
+	if len(reason) > MaxRedemptionReferenceLength {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "redemption reference or reason too long, max length is %d bytes", MaxRedemptionReferenceLength)
+	}
+	return nil
+}
+
+// IsOpen returns whether the tokens of the request are still escrowed.
+func (r RedemptionRequest) IsOpen() bool {
+	return r.Status == REDEMPTION_STATUS_OPEN
+}
+
+// Validate does a stateless check of the redemption request fields.
+func (r RedemptionRequest) Validate() error {
+	if r.Id == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "redemption id must be positive")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(r.Holder); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid holder address (%s)", err)
+	}
+
+	if !r.Amount.IsValid() || r.Amount.IsZero() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, r.Amount.String())
+	}
+
+	if err := ValidateRedemptionReference(r.PayoutReference); err != nil {
+		return err
+	}
+
+	if r.Created.IsZero() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "creation time of the redemption must be set")
+	}
+
+	if r.Timeout != nil && r.Timeout.Before(r.Created) {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "timeout of the redemption is before its creation")
+	}
+
+	switch r.Status {
+	case REDEMPTION_STATUS_OPEN, REDEMPTION_STATUS_REJECTED, REDEMPTION_STATUS_TIMED_OUT:
+		if r.SettlementReference != "" {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "%s redemption has a settlement reference", r.Status)
+		}
+	case REDEMPTION_STATUS_FULFILLED:
+		if err := ValidateRedemptionReference(r.SettlementReference); err != nil {
+			return err
+		}
+	default:
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid redemption status %s", r.Status)
+	}
+
+	if r.RejectReason != "" && r.Status != REDEMPTION_STATUS_REJECTED {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "%s redemption has a reject reason", r.Status)
+	}
+
+	return ValidateRejectReason(r.RejectReason)
+}
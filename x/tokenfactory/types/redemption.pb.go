@@ -0,0 +1,850 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: osmosis/tokenfactory/v1beta1/redemption.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// RedemptionStatus is the status of a redemption request.
+type RedemptionStatus int32
+
+const (
+	// REDEMPTION_STATUS_UNSPECIFIED is never stored.
+	REDEMPTION_STATUS_UNSPECIFIED RedemptionStatus = 0
+	// REDEMPTION_STATUS_OPEN escrows the tokens until the admin of the denom
+	// fulfills or rejects the request, or it times out.
+	REDEMPTION_STATUS_OPEN RedemptionStatus = 1
+	// REDEMPTION_STATUS_FULFILLED was paid out, the tokens have been burned.
+	REDEMPTION_STATUS_FULFILLED RedemptionStatus = 2
+	// REDEMPTION_STATUS_REJECTED was rejected by the admin of the denom, the
+	// tokens have been returned.
+	REDEMPTION_STATUS_REJECTED RedemptionStatus = 3
+	// REDEMPTION_STATUS_TIMED_OUT reached its timeout, the tokens have been
+	// returned.
+	REDEMPTION_STATUS_TIMED_OUT RedemptionStatus = 4
+)
+
+var RedemptionStatus_name = map[int32]string{
+	0: "REDEMPTION_STATUS_UNSPECIFIED",
+	1: "REDEMPTION_STATUS_OPEN",
+	2: "REDEMPTION_STATUS_FULFILLED",
+	3: "REDEMPTION_STATUS_REJECTED",
+	4: "REDEMPTION_STATUS_TIMED_OUT",
+}
+
+var RedemptionStatus_value = map[string]int32{
+	"REDEMPTION_STATUS_UNSPECIFIED": 0,
+	"REDEMPTION_STATUS_OPEN":        1,
+	"REDEMPTION_STATUS_FULFILLED":   2,
+	"REDEMPTION_STATUS_REJECTED":    3,
+	"REDEMPTION_STATUS_TIMED_OUT":   4,
+}
+
+func (x RedemptionStatus) String() string {
+	return proto.EnumName(RedemptionStatus_name, int32(x))
+}
+
+func (RedemptionStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_3e2d3d27e7c83a7e, []int{0}
+}
+
+// RedemptionRequest is a request of a holder to redeem factory tokens
+// off-chain. The tokens are escrowed in the module account while the request
+// is open, then burned when the admin of the denom fulfills it, or returned to
+// the holder when it is rejected or times out.
+type RedemptionRequest struct {
+	// id is the sequence of the request in the requests of the denom, starting
+	// at 1.
+	Id     uint64     `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty" yaml:"id"`
+	Holder string     `protobuf:"bytes,2,opt,name=holder,proto3" json:"holder,omitempty" yaml:"holder"`
+	Amount types.Coin `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount" yaml:"amount"`
+	// payout_reference tells the issuer where to pay the redemption out
+	// off-chain, such as a bank account reference.
+	PayoutReference string    `protobuf:"bytes,4,opt,name=payout_reference,json=payoutReference,proto3" json:"payout_reference,omitempty" yaml:"payout_reference"`
+	Created         time.Time `protobuf:"bytes,5,opt,name=created,proto3,stdtime" json:"created" yaml:"created"`
+	// timeout is the optional time from which an open request is refunded to
+	// the holder.
+	Timeout *time.Time       `protobuf:"bytes,6,opt,name=timeout,proto3,stdtime" json:"timeout,omitempty" yaml:"timeout"`
+	Status  RedemptionStatus `protobuf:"varint,7,opt,name=status,proto3,enum=osmosis.tokenfactory.v1beta1.RedemptionStatus" json:"status,omitempty" yaml:"status"`
+	// settlement_reference is the off-chain reference of the payout, recorded
+	// when the request is fulfilled.
+	SettlementReference string `protobuf:"bytes,8,opt,name=settlement_reference,json=settlementReference,proto3" json:"settlement_reference,omitempty" yaml:"settlement_reference"`
+	// reject_reason is recorded when the request is rejected.
+	RejectReason string `protobuf:"bytes,9,opt,name=reject_reason,json=rejectReason,proto3" json:"reject_reason,omitempty" yaml:"reject_reason"`
+}
+
+func (m *RedemptionRequest) Reset()         { *m = RedemptionRequest{} }
+func (m *RedemptionRequest) String() string { return proto.CompactTextString(m) }
+func (*RedemptionRequest) ProtoMessage()    {}
+func (*RedemptionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e2d3d27e7c83a7e, []int{0}
+}
+func (m *RedemptionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RedemptionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RedemptionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RedemptionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RedemptionRequest.Merge(m, src)
+}
+func (m *RedemptionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RedemptionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RedemptionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RedemptionRequest proto.InternalMessageInfo
+
+func (m *RedemptionRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *RedemptionRequest) GetHolder() string {
+	if m != nil {
+		return m.Holder
+	}
+	return ""
+}
+
+func (m *RedemptionRequest) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+func (m *RedemptionRequest) GetPayoutReference() string {
+	if m != nil {
+		return m.PayoutReference
+	}
+	return ""
+}
+
+func (m *RedemptionRequest) GetCreated() time.Time {
+	if m != nil {
+		return m.Created
+	}
+	return time.Time{}
+}
+
+func (m *RedemptionRequest) GetTimeout() *time.Time {
+	if m != nil {
+		return m.Timeout
+	}
+	return nil
+}
+
+func (m *RedemptionRequest) GetStatus() RedemptionStatus {
+	if m != nil {
+		return m.Status
+	}
+	return REDEMPTION_STATUS_UNSPECIFIED
+}
+
+func (m *RedemptionRequest) GetSettlementReference() string {
+	if m != nil {
+		return m.SettlementReference
+	}
+	return ""
+}
+
+func (m *RedemptionRequest) GetRejectReason() string {
+	if m != nil {
+		return m.RejectReason
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterEnum("osmosis.tokenfactory.v1beta1.RedemptionStatus", RedemptionStatus_name, RedemptionStatus_value)
+	proto.RegisterType((*RedemptionRequest)(nil), "osmosis.tokenfactory.v1beta1.RedemptionRequest")
+}
+
+func init() {
+	proto.RegisterFile("osmosis/tokenfactory/v1beta1/redemption.proto", fileDescriptor_3e2d3d27e7c83a7e)
+}
+
+var fileDescriptor_3e2d3d27e7c83a7e = []byte{
+	// 659 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x54, 0xcf, 0x6e, 0xd3, 0x4c,
+	0x1c, 0x8c, 0xd3, 0x34, 0xfd, 0xba, 0x1f, 0x2d, 0xee, 0x12, 0x15, 0xd7, 0xa5, 0x76, 0xf0, 0x29,
+	0x54, 0xaa, 0xad, 0xb6, 0xb7, 0x4a, 0x1c, 0x9a, 0xc6, 0x91, 0x82, 0x92, 0xb6, 0xda, 0x38, 0x07,
+	0xb8, 0x44, 0x8e, 0xbd, 0x4d, 0x0d, 0xb1, 0x37, 0x78, 0xd7, 0x88, 0xbc, 0x01, 0xc7, 0x3e, 0x02,
+	0x12, 0x42, 0xe2, 0xc8, 0x81, 0x0b, 0x6f, 0xd0, 0x63, 0xc5, 0x89, 0x53, 0x40, 0xed, 0x01, 0xce,
+	0x79, 0x02, 0x14, 0xef, 0xa6, 0xe9, 0x3f, 0xc1, 0xc5, 0xf2, 0xfc, 0x76, 0x66, 0x3c, 0x3b, 0x5e,
+	0x1b, 0x6c, 0x10, 0x1a, 0x12, 0x1a, 0x50, 0x8b, 0x91, 0x57, 0x38, 0x3a, 0x72, 0x3d, 0x46, 0xe2,
+	0x81, 0xf5, 0x66, 0xb3, 0x83, 0x99, 0xbb, 0x69, 0xc5, 0xd8, 0xc7, 0x61, 0x9f, 0x05, 0x24, 0x32,
+	0xfb, 0x31, 0x61, 0x04, 0x3e, 0x12, 0x74, 0xf3, 0x2a, 0xdd, 0x14, 0x74, 0xb5, 0xd0, 0x25, 0x5d,
+	0x92, 0x12, 0xad, 0xf1, 0x1d, 0xd7, 0xa8, 0x4b, 0x6e, 0x18, 0x44, 0xc4, 0x4a, 0xaf, 0x62, 0xb4,
+	0xe2, 0xa5, 0x3e, 0x6d, 0xce, 0xe5, 0x40, 0x2c, 0x69, 0x1c, 0x59, 0x1d, 0x97, 0xe2, 0xcb, 0x1c,
+	0x1e, 0x09, 0x44, 0x02, 0x55, 0xef, 0x12, 0xd2, 0xed, 0x61, 0x2b, 0x45, 0x9d, 0xe4, 0xc8, 0x62,
+	0x41, 0x88, 0x29, 0x73, 0xc3, 0x3e, 0x27, 0x18, 0x1f, 0x67, 0xc1, 0x12, 0xba, 0xcc, 0x8d, 0xf0,
+	0xeb, 0x04, 0x53, 0x06, 0xd7, 0x40, 0x36, 0xf0, 0x15, 0xa9, 0x28, 0x95, 0x72, 0xe5, 0x85, 0xd1,
+	0x50, 0x9f, 0x1f, 0xb8, 0x61, 0x6f, 0xc7, 0x08, 0x7c, 0x03, 0x65, 0x03, 0x1f, 0xee, 0x82, 0xfc,
+	0x31, 0xe9, 0xf9, 0x38, 0x56, 0xb2, 0x45, 0xa9, 0x34, 0x5f, 0x7e, 0x32, 0x1a, 0xea, 0x0b, 0x9c,
+	0xc2, 0xe7, 0xc6, 0xb7, 0x2f, 0x1b, 0x05, 0x11, 0x74, 0xd7, 0xf7, 0x63, 0x4c, 0x69, 0x93, 0xc5,
+	0x41, 0xd4, 0x45, 0x42, 0x08, 0x1b, 0x20, 0xef, 0x86, 0x24, 0x89, 0x98, 0x32, 0x53, 0x94, 0x4a,
+	0xff, 0x6f, 0xad, 0x98, 0x82, 0x3e, 0xde, 0xc9, 0xa4, 0x22, 0x73, 0x8f, 0x04, 0x51, 0x59, 0x3d,
+	0x1d, 0xea, 0x99, 0xe9, 0x13, 0xb8, 0xcc, 0xf8, 0xf4, 0xeb, 0xf3, 0xba, 0x84, 0x84, 0x09, 0xac,
+	0x02, 0xb9, 0xef, 0x0e, 0x48, 0xc2, 0xda, 0x31, 0x3e, 0xc2, 0x31, 0x8e, 0x3c, 0xac, 0xe4, 0xd2,
+	0x6c, 0xab, 0xa3, 0xa1, 0xfe, 0x90, 0x2b, 0x6f, 0x32, 0x0c, 0x74, 0x9f, 0x8f, 0xd0, 0x64, 0x02,
+	0x5b, 0x60, 0xce, 0x8b, 0xb1, 0xcb, 0xb0, 0xaf, 0xcc, 0xa6, 0xb9, 0x54, 0x93, 0x37, 0x68, 0x4e,
+	0x1a, 0x34, 0x9d, 0x49, 0x83, 0x65, 0x5d, 0x04, 0x5b, 0xe4, 0xf6, 0x42, 0x68, 0x9c, 0xfc, 0xd0,
+	0x25, 0x9e, 0x6e, 0xe2, 0x05, 0xeb, 0x60, 0x6e, 0x5c, 0x3c, 0x49, 0x98, 0x92, 0xff, 0xa7, 0xed,
+	0xf2, 0xd4, 0x52, 0x88, 0x52, 0x4b, 0x34, 0xb1, 0x80, 0xcf, 0x41, 0x9e, 0x32, 0x97, 0x25, 0x54,
+	0x99, 0x2b, 0x4a, 0xa5, 0xc5, 0x2d, 0xd3, 0xfc, 0xdb, 0x39, 0x33, 0xa7, 0xaf, 0xb7, 0x99, 0xaa,
+	0xca, 0x4b, 0xd3, 0x32, 0xb9, 0x8f, 0x81, 0x84, 0x21, 0x44, 0xa0, 0x40, 0x31, 0x63, 0x3d, 0x1c,
+	0xe2, 0xe8, 0x6a, 0x97, 0xff, 0xa5, 0x5d, 0xea, 0xa3, 0xa1, 0xbe, 0x2a, 0x84, 0x77, 0xb0, 0x0c,
+	0xf4, 0x60, 0x3a, 0x9e, 0x76, 0xfa, 0x14, 0x2c, 0xc4, 0xf8, 0x25, 0xf6, 0xc6, 0x4c, 0x97, 0x92,
+	0x48, 0x99, 0x4f, 0xcd, 0x94, 0xd1, 0x50, 0x2f, 0x70, 0xb3, 0x6b, 0xcb, 0x06, 0xba, 0xc7, 0x31,
+	0x4a, 0xe1, 0x4e, 0xee, 0xf7, 0x7b, 0x5d, 0x5a, 0xff, 0x2a, 0x01, 0xf9, 0xe6, 0x46, 0xe0, 0x63,
+	0xb0, 0x86, 0xec, 0x8a, 0xdd, 0x38, 0x74, 0x6a, 0x07, 0xfb, 0xed, 0xa6, 0xb3, 0xeb, 0xb4, 0x9a,
+	0xed, 0xd6, 0x7e, 0xf3, 0xd0, 0xde, 0xab, 0x55, 0x6b, 0x76, 0x45, 0xce, 0x40, 0x15, 0x2c, 0xdf,
+	0xa6, 0x1c, 0x1c, 0xda, 0xfb, 0xb2, 0x04, 0x75, 0xb0, 0x7a, 0x7b, 0xad, 0xda, 0xaa, 0x57, 0x6b,
+	0xf5, 0xba, 0x5d, 0x91, 0xb3, 0x50, 0x03, 0xea, 0x6d, 0x02, 0xb2, 0x9f, 0xd9, 0x7b, 0x8e, 0x5d,
+	0x91, 0x67, 0xee, 0x36, 0x70, 0x6a, 0x0d, 0xbb, 0xd2, 0x3e, 0x68, 0x39, 0x72, 0x4e, 0xcd, 0xbd,
+	0xfb, 0xa0, 0x65, 0xca, 0x8d, 0xd3, 0x73, 0x4d, 0x3a, 0x3b, 0xd7, 0xa4, 0x9f, 0xe7, 0x9a, 0x74,
+	0x72, 0xa1, 0x65, 0xce, 0x2e, 0xb4, 0xcc, 0xf7, 0x0b, 0x2d, 0xf3, 0x62, 0xbb, 0x1b, 0xb0, 0xe3,
+	0xa4, 0x63, 0x7a, 0x24, 0x14, 0xdf, 0xf5, 0xf5, 0x3f, 0xcb, 0xdb, 0xeb, 0x90, 0x0d, 0xfa, 0x98,
+	0x76, 0xf2, 0xe9, 0x99, 0xd9, 0xfe, 0x13, 0x00, 0x00, 0xff, 0xff, 0xba, 0x7d, 0xc7, 0x70, 0x8d,
+	0x04, 0x00, 0x00,
+}
+
+func (this *RedemptionRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RedemptionRequest)
+	if !ok {
+		that2, ok := that.(RedemptionRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Id != that1.Id {
+		return false
+	}
+	if this.Holder != that1.Holder {
+		return false
+	}
+	if !this.Amount.Equal(&that1.Amount) {
+		return false
+	}
+	if this.PayoutReference != that1.PayoutReference {
+		return false
+	}
+	if !this.Created.Equal(that1.Created) {
+		return false
+	}
+	if that1.Timeout == nil {
+		if this.Timeout != nil {
+			return false
+		}
+	} else if !this.Timeout.Equal(*that1.Timeout) {
+		return false
+	}
+	if this.Status != that1.Status {
+		return false
+	}
+	if this.SettlementReference != that1.SettlementReference {
+		return false
+	}
+	if this.RejectReason != that1.RejectReason {
+		return false
+	}
+	return true
+}
+func (m *RedemptionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RedemptionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RedemptionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RejectReason) > 0 {
+		i -= len(m.RejectReason)
+		copy(dAtA[i:], m.RejectReason)
+		i = encodeVarintRedemption(dAtA, i, uint64(len(m.RejectReason)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.SettlementReference) > 0 {
+		i -= len(m.SettlementReference)
+		copy(dAtA[i:], m.SettlementReference)
+		i = encodeVarintRedemption(dAtA, i, uint64(len(m.SettlementReference)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.Status != 0 {
+		i = encodeVarintRedemption(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Timeout != nil {
+		n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Timeout, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Timeout):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintRedemption(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x32
+	}
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Created, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Created):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintRedemption(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x2a
+	if len(m.PayoutReference) > 0 {
+		i -= len(m.PayoutReference)
+		copy(dAtA[i:], m.PayoutReference)
+		i = encodeVarintRedemption(dAtA, i, uint64(len(m.PayoutReference)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintRedemption(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Holder) > 0 {
+		i -= len(m.Holder)
+		copy(dAtA[i:], m.Holder)
+		i = encodeVarintRedemption(dAtA, i, uint64(len(m.Holder)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintRedemption(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintRedemption(dAtA []byte, offset int, v uint64) int {
+	offset -= sovRedemption(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *RedemptionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovRedemption(uint64(m.Id))
+	}
+	l = len(m.Holder)
+	if l > 0 {
+		n += 1 + l + sovRedemption(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovRedemption(uint64(l))
+	l = len(m.PayoutReference)
+	if l > 0 {
+		n += 1 + l + sovRedemption(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Created)
+	n += 1 + l + sovRedemption(uint64(l))
+	if m.Timeout != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Timeout)
+		n += 1 + l + sovRedemption(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovRedemption(uint64(m.Status))
+	}
+	l = len(m.SettlementReference)
+	if l > 0 {
+		n += 1 + l + sovRedemption(uint64(l))
+	}
+	l = len(m.RejectReason)
+	if l > 0 {
+		n += 1 + l + sovRedemption(uint64(l))
+	}
+	return n
+}
+
+func sovRedemption(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozRedemption(x uint64) (n int) {
+	return sovRedemption(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *RedemptionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRedemption
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RedemptionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RedemptionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRedemption
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Holder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRedemption
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRedemption
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRedemption
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Holder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRedemption
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRedemption
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRedemption
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayoutReference", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRedemption
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRedemption
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRedemption
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PayoutReference = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRedemption
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRedemption
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRedemption
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Created, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRedemption
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRedemption
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRedemption
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Timeout == nil {
+				m.Timeout = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Timeout, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRedemption
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= RedemptionStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettlementReference", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRedemption
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRedemption
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRedemption
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SettlementReference = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RejectReason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRedemption
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRedemption
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRedemption
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RejectReason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRedemption(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRedemption
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipRedemption(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowRedemption
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRedemption
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRedemption
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthRedemption
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupRedemption
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthRedemption
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthRedemption        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowRedemption          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupRedemption = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,19 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// MaxReferenceIDLength is the maximum length, in bytes, of the reference id of a MsgMint or a MsgBurn
+const MaxReferenceIDLength = 128
+
+// ValidateReferenceID returns an error if the optional reference id of a MsgMint or a MsgBurn is
+// too long.
+func ValidateReferenceID(referenceID string) error {
+	if len(referenceID) > MaxReferenceIDLength {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "reference id too long, max length is %d bytes", MaxReferenceIDLength)
+	}
+	return nil
+}
@@ -0,0 +1,70 @@
+package types
+
+import (
+	"crypto/sha256"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// ReportHashLength is the length of the report hash of a reserve attestation, a sha256 hash
+const ReportHashLength = sha256.Size
+
+// ValidateReserveAttestor returns an error if the attestor is set and is not a valid address, or
+// if its max staleness is not positive. An empty attestor removes the reserve ceiling of a denom.
+func ValidateReserveAttestor(attestor string, maxStaleness time.Duration) error {
+	if attestor == "" {
+		return nil
+	}
+
+	if _, err := sdk.AccAddressFromBech32(attestor); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid attestor address (%s)", err)
+	}
+
+	if maxStaleness <= 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "max staleness of the reserve attestations must be positive")
+	}
+
+	return nil
+}
+
+// ValidateReportHash returns an error if the report hash is not a sha256 hash.
+func ValidateReportHash(reportHash []byte) error {
+	if len(reportHash) != ReportHashLength {
+		return errorsmod.Wrapf(ErrInvalidAttestation, "report hash must be %d bytes, got %d bytes", ReportHashLength, len(reportHash))
+	}
+	return nil
+}
+
+// Validate does a stateless check of the attestation fields.
+func (a ReserveAttestation) Validate() error {
+	if a.Id == 0 {
+		return errorsmod.Wrap(ErrInvalidAttestation, "id must be positive")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(a.Attestor); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid attestor address (%s)", err)
+	}
+
+	if a.Amount.IsNil() || a.Amount.IsNegative() {
+		return errorsmod.Wrap(ErrInvalidAttestation, "amount can not be negative")
+	}
+
+	if a.Timestamp.IsZero() {
+		return errorsmod.Wrap(ErrInvalidAttestation, "timestamp must be set")
+	}
+
+	if a.Height < 0 {
+		return errorsmod.Wrap(ErrInvalidAttestation, "height can not be negative")
+	}
+
+	return ValidateReportHash(a.ReportHash)
+}
+
+// IsStale returns whether the attestation is older than the max staleness at the block time.
+func (a ReserveAttestation) IsStale(blockTime time.Time, maxStaleness time.Duration) bool {
+	return blockTime.Sub(a.Timestamp) > maxStaleness
+}
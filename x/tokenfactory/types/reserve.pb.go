@@ -0,0 +1,869 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: osmosis/tokenfactory/v1beta1/reserve.proto
+
+package types
+
+import (
+	bytes "bytes"
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ReserveAttestor is the address attesting the reserves backing a denom. The
+// mints of a denom with a reserve attestor can not push its supply above the
+// latest attested reserve amount, and are rejected once the latest
+// attestation is older than max_staleness.
+type ReserveAttestor struct {
+	Attestor     string        `protobuf:"bytes,1,opt,name=attestor,proto3" json:"attestor,omitempty" yaml:"attestor"`
+	MaxStaleness time.Duration `protobuf:"bytes,2,opt,name=max_staleness,json=maxStaleness,proto3,stdduration" json:"max_staleness" yaml:"max_staleness"`
+}
+
+func (m *ReserveAttestor) Reset()         { *m = ReserveAttestor{} }
+func (m *ReserveAttestor) String() string { return proto.CompactTextString(m) }
+func (*ReserveAttestor) ProtoMessage()    {}
+func (*ReserveAttestor) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4f99585351547bc5, []int{0}
+}
+func (m *ReserveAttestor) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReserveAttestor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReserveAttestor.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReserveAttestor) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReserveAttestor.Merge(m, src)
+}
+func (m *ReserveAttestor) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReserveAttestor) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReserveAttestor.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReserveAttestor proto.InternalMessageInfo
+
+func (m *ReserveAttestor) GetAttestor() string {
+	if m != nil {
+		return m.Attestor
+	}
+	return ""
+}
+
+func (m *ReserveAttestor) GetMaxStaleness() time.Duration {
+	if m != nil {
+		return m.MaxStaleness
+	}
+	return 0
+}
+
+// ReserveAttestation is a reserve amount of a denom posted by its reserve
+// attestor.
+type ReserveAttestation struct {
+	// id is the sequence of the attestation in the history of the denom,
+	// starting at 1.
+	Id       uint64                `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty" yaml:"id"`
+	Attestor string                `protobuf:"bytes,2,opt,name=attestor,proto3" json:"attestor,omitempty" yaml:"attestor"`
+	Amount   cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount" yaml:"amount"`
+	// timestamp is the time of the reserve report, the staleness of the
+	// attestation is measured from it.
+	Timestamp time.Time `protobuf:"bytes,4,opt,name=timestamp,proto3,stdtime" json:"timestamp" yaml:"timestamp"`
+	// report_hash is the sha256 hash of the off-chain reserve report.
+	ReportHash []byte `protobuf:"bytes,5,opt,name=report_hash,json=reportHash,proto3" json:"report_hash,omitempty" yaml:"report_hash"`
+	// height is the height of the block the attestation was posted in.
+	Height int64 `protobuf:"varint,6,opt,name=height,proto3" json:"height,omitempty" yaml:"height"`
+}
+
+func (m *ReserveAttestation) Reset()         { *m = ReserveAttestation{} }
+func (m *ReserveAttestation) String() string { return proto.CompactTextString(m) }
+func (*ReserveAttestation) ProtoMessage()    {}
+func (*ReserveAttestation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4f99585351547bc5, []int{1}
+}
+func (m *ReserveAttestation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReserveAttestation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReserveAttestation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReserveAttestation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReserveAttestation.Merge(m, src)
+}
+func (m *ReserveAttestation) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReserveAttestation) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReserveAttestation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReserveAttestation proto.InternalMessageInfo
+
+func (m *ReserveAttestation) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *ReserveAttestation) GetAttestor() string {
+	if m != nil {
+		return m.Attestor
+	}
+	return ""
+}
+
+func (m *ReserveAttestation) GetTimestamp() time.Time {
+	if m != nil {
+		return m.Timestamp
+	}
+	return time.Time{}
+}
+
+func (m *ReserveAttestation) GetReportHash() []byte {
+	if m != nil {
+		return m.ReportHash
+	}
+	return nil
+}
+
+func (m *ReserveAttestation) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*ReserveAttestor)(nil), "osmosis.tokenfactory.v1beta1.ReserveAttestor")
+	proto.RegisterType((*ReserveAttestation)(nil), "osmosis.tokenfactory.v1beta1.ReserveAttestation")
+}
+
+func init() {
+	proto.RegisterFile("osmosis/tokenfactory/v1beta1/reserve.proto", fileDescriptor_4f99585351547bc5)
+}
+
+var fileDescriptor_4f99585351547bc5 = []byte{
+	// 527 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x53, 0x31, 0x6f, 0xd3, 0x40,
+	0x14, 0xce, 0xa5, 0x21, 0x22, 0xd7, 0x46, 0xa5, 0x56, 0x40, 0x6e, 0x04, 0x76, 0xb0, 0x84, 0x14,
+	0x8a, 0xb0, 0x55, 0x3a, 0x20, 0x75, 0x8b, 0x85, 0x04, 0x1d, 0x58, 0x5c, 0x16, 0x60, 0x88, 0x2e,
+	0xf1, 0xd5, 0x3e, 0x35, 0xf6, 0x45, 0x77, 0x2f, 0x55, 0xf2, 0x2f, 0x3a, 0x32, 0x21, 0x46, 0x46,
+	0x06, 0xfe, 0x03, 0x1d, 0x2b, 0x26, 0xc4, 0x60, 0x50, 0x32, 0xc0, 0x9c, 0x5f, 0x80, 0x72, 0x77,
+	0x49, 0x1b, 0x18, 0x59, 0xac, 0x7b, 0xef, 0x7d, 0xdf, 0xf7, 0xde, 0xf7, 0xce, 0x87, 0xf7, 0xb8,
+	0xcc, 0xb8, 0x64, 0x32, 0x00, 0x7e, 0x4a, 0xf3, 0x13, 0xd2, 0x07, 0x2e, 0x26, 0xc1, 0xd9, 0x7e,
+	0x8f, 0x02, 0xd9, 0x0f, 0x04, 0x95, 0x54, 0x9c, 0x51, 0x7f, 0x28, 0x38, 0x70, 0xeb, 0xae, 0xc1,
+	0xfa, 0xd7, 0xb1, 0xbe, 0xc1, 0x36, 0x1b, 0x09, 0x4f, 0xb8, 0x02, 0x06, 0x8b, 0x93, 0xe6, 0x34,
+	0x77, 0x48, 0xc6, 0x72, 0x1e, 0xa8, 0xaf, 0x49, 0xed, 0xf6, 0x95, 0x4e, 0x57, 0x63, 0x75, 0x60,
+	0x4a, 0x4e, 0xc2, 0x79, 0x32, 0xa0, 0x81, 0x8a, 0x7a, 0xa3, 0x93, 0x20, 0x1e, 0x09, 0x02, 0x8c,
+	0xe7, 0xa6, 0xee, 0xfe, 0x5d, 0x07, 0x96, 0x51, 0x09, 0x24, 0x1b, 0x6a, 0x80, 0xf7, 0x05, 0xe1,
+	0xed, 0x48, 0x0f, 0xdd, 0x01, 0xa0, 0x12, 0xb8, 0xb0, 0x9e, 0xe3, 0x9b, 0xc4, 0x9c, 0x6d, 0xd4,
+	0x42, 0xed, 0x5a, 0xf8, 0x68, 0x5e, 0xb8, 0xdb, 0x13, 0x92, 0x0d, 0x0e, 0xbd, 0x65, 0xc5, 0xfb,
+	0xfa, 0xf9, 0x71, 0xc3, 0xcc, 0xd2, 0x89, 0x63, 0x41, 0xa5, 0x3c, 0x06, 0xc1, 0xf2, 0x24, 0x5a,
+	0x91, 0x2d, 0x8a, 0xeb, 0x19, 0x19, 0x77, 0x25, 0x90, 0x01, 0xcd, 0xa9, 0x94, 0x76, 0xb9, 0x85,
+	0xda, 0x9b, 0x4f, 0x76, 0x7d, 0x3d, 0x95, 0xbf, 0x9c, 0xca, 0x7f, 0x66, 0xa6, 0x0e, 0x1f, 0x5c,
+	0x14, 0x6e, 0x69, 0x5e, 0xb8, 0x0d, 0xdd, 0x6c, 0x8d, 0xed, 0xbd, 0xfb, 0xe1, 0xa2, 0x8f, 0xbf,
+	0x3e, 0xed, 0xa1, 0x68, 0x2b, 0x23, 0xe3, 0xe3, 0x65, 0xfe, 0xb0, 0xf2, 0xfb, 0x83, 0x8b, 0xbc,
+	0xf7, 0x1b, 0xd8, 0x5a, 0x73, 0xa2, 0x14, 0xad, 0x7b, 0xb8, 0xcc, 0x62, 0x65, 0xa3, 0x12, 0xd6,
+	0xe7, 0x85, 0x5b, 0xd3, 0xca, 0x2c, 0xf6, 0xa2, 0x32, 0x8b, 0xd7, 0xbc, 0x96, 0xff, 0xc7, 0xeb,
+	0x6b, 0x5c, 0x25, 0x19, 0x1f, 0xe5, 0x60, 0x6f, 0x28, 0x99, 0xce, 0xc2, 0xc9, 0xf7, 0xc2, 0xbd,
+	0xad, 0x79, 0x32, 0x3e, 0xf5, 0x19, 0x0f, 0x32, 0x02, 0xa9, 0x7f, 0x94, 0xc3, 0xbc, 0x70, 0xeb,
+	0xa6, 0x87, 0x22, 0x2d, 0x3a, 0x60, 0xd3, 0xe1, 0x28, 0x07, 0xed, 0xd2, 0x08, 0x5a, 0x6f, 0x71,
+	0x6d, 0x75, 0x6d, 0x76, 0x45, 0xad, 0xb0, 0xf9, 0xcf, 0x0a, 0x5f, 0x2d, 0x11, 0xe1, 0x7d, 0xb3,
+	0xc3, 0x5b, 0xba, 0xc1, 0x8a, 0xea, 0x9d, 0xaf, 0xf6, 0x77, 0xa5, 0x67, 0x3d, 0xc5, 0x9b, 0x82,
+	0x0e, 0xb9, 0x80, 0x6e, 0x4a, 0x64, 0x6a, 0xdf, 0x68, 0xa1, 0xf6, 0x56, 0x78, 0x67, 0x5e, 0xb8,
+	0x96, 0xa6, 0x5f, 0x2b, 0x7a, 0x11, 0xd6, 0xd1, 0x0b, 0x22, 0x53, 0xeb, 0x21, 0xae, 0xa6, 0x94,
+	0x25, 0x29, 0xd8, 0xd5, 0x16, 0x6a, 0x6f, 0x84, 0x3b, 0x57, 0x9e, 0x74, 0xde, 0x8b, 0x0c, 0x40,
+	0x5f, 0x50, 0xf8, 0xf2, 0x62, 0xea, 0xa0, 0xcb, 0xa9, 0x83, 0x7e, 0x4e, 0x1d, 0x74, 0x3e, 0x73,
+	0x4a, 0x97, 0x33, 0xa7, 0xf4, 0x6d, 0xe6, 0x94, 0xde, 0x1c, 0x24, 0x0c, 0xd2, 0x51, 0xcf, 0xef,
+	0xf3, 0xcc, 0xfc, 0xde, 0xeb, 0xaf, 0x6b, 0xbc, 0x1e, 0xc2, 0x64, 0x48, 0x65, 0xaf, 0xaa, 0xac,
+	0x1f, 0xfc, 0x09, 0x00, 0x00, 0xff, 0xff, 0x34, 0x42, 0x08, 0xf9, 0x91, 0x03, 0x00, 0x00,
+}
+
+func (this *ReserveAttestor) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ReserveAttestor)
+	if !ok {
+		that2, ok := that.(ReserveAttestor)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Attestor != that1.Attestor {
+		return false
+	}
+	if this.MaxStaleness != that1.MaxStaleness {
+		return false
+	}
+	return true
+}
+func (this *ReserveAttestation) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ReserveAttestation)
+	if !ok {
+		that2, ok := that.(ReserveAttestation)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Id != that1.Id {
+		return false
+	}
+	if this.Attestor != that1.Attestor {
+		return false
+	}
+	if !this.Amount.Equal(that1.Amount) {
+		return false
+	}
+	if !this.Timestamp.Equal(that1.Timestamp) {
+		return false
+	}
+	if !bytes.Equal(this.ReportHash, that1.ReportHash) {
+		return false
+	}
+	if this.Height != that1.Height {
+		return false
+	}
+	return true
+}
+func (m *ReserveAttestor) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReserveAttestor) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReserveAttestor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MaxStaleness, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxStaleness):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintReserve(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Attestor) > 0 {
+		i -= len(m.Attestor)
+		copy(dAtA[i:], m.Attestor)
+		i = encodeVarintReserve(dAtA, i, uint64(len(m.Attestor)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReserveAttestation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReserveAttestation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReserveAttestation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintReserve(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.ReportHash) > 0 {
+		i -= len(m.ReportHash)
+		copy(dAtA[i:], m.ReportHash)
+		i = encodeVarintReserve(dAtA, i, uint64(len(m.ReportHash)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Timestamp):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintReserve(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintReserve(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Attestor) > 0 {
+		i -= len(m.Attestor)
+		copy(dAtA[i:], m.Attestor)
+		i = encodeVarintReserve(dAtA, i, uint64(len(m.Attestor)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintReserve(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintReserve(dAtA []byte, offset int, v uint64) int {
+	offset -= sovReserve(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ReserveAttestor) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Attestor)
+	if l > 0 {
+		n += 1 + l + sovReserve(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxStaleness)
+	n += 1 + l + sovReserve(uint64(l))
+	return n
+}
+
+func (m *ReserveAttestation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovReserve(uint64(m.Id))
+	}
+	l = len(m.Attestor)
+	if l > 0 {
+		n += 1 + l + sovReserve(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovReserve(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Timestamp)
+	n += 1 + l + sovReserve(uint64(l))
+	l = len(m.ReportHash)
+	if l > 0 {
+		n += 1 + l + sovReserve(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovReserve(uint64(m.Height))
+	}
+	return n
+}
+
+func sovReserve(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozReserve(x uint64) (n int) {
+	return sovReserve(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ReserveAttestor) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowReserve
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReserveAttestor: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReserveAttestor: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attestor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReserve
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReserve
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReserve
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attestor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxStaleness", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReserve
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthReserve
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthReserve
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.MaxStaleness, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipReserve(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthReserve
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReserveAttestation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowReserve
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReserveAttestation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReserveAttestation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReserve
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attestor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReserve
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReserve
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReserve
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attestor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReserve
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReserve
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReserve
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReserve
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthReserve
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthReserve
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Timestamp, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReportHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReserve
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthReserve
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReserve
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReportHash = append(m.ReportHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.ReportHash == nil {
+				m.ReportHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReserve
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipReserve(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthReserve
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipReserve(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowReserve
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowReserve
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowReserve
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthReserve
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupReserve
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthReserve
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthReserve        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowReserve          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupReserve = fmt.Errorf("proto: unexpected end of group")
+)
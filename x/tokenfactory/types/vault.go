@@ -0,0 +1,64 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+// MaxVaultRatio is the maximum amount of a vault denom minted for every unit of its backing denom,
+// enough to wrap a 6 decimals denom in an 24 decimals one
+var MaxVaultRatio = sdkmath.NewIntWithDecimal(1, 18)
+
+// VaultAddress returns the escrow account of the vault of a denom, derived from the module
+// account address and the denom
+func VaultAddress(denom string) sdk.AccAddress {
+	return address.Module(ModuleName, []byte(VaultPrefixKey), []byte(denom))
+}
+
+// ValidateVaultBacking returns an error if the backing denom of a vault is not a valid denom, or
+// if its ratio is not between 1 and MaxVaultRatio.
+func ValidateVaultBacking(backingDenom string, ratio sdkmath.Int) error {
+	if err := sdk.ValidateDenom(backingDenom); err != nil {
+		return errorsmod.Wrapf(ErrInvalidVault, "invalid backing denom: %s", err)
+	}
+
+	if ratio.IsNil() || !ratio.IsPositive() || ratio.GT(MaxVaultRatio) {
+		return errorsmod.Wrapf(ErrInvalidVault, "ratio must be between 1 and %s", MaxVaultRatio)
+	}
+
+	return nil
+}
+
+// Validate does a stateless check of the vault fields.
+func (v Vault) Validate() error {
+	if _, _, err := DeconstructDenom(v.Denom); err != nil {
+		return err
+	}
+
+	if err := ValidateVaultBacking(v.BackingDenom, v.Ratio); err != nil {
+		return err
+	}
+
+	if v.BackingDenom == v.Denom {
+		return errorsmod.Wrap(ErrInvalidVault, "a vault can not be backed by its own denom")
+	}
+
+	if v.Escrowed.IsNil() || v.Escrowed.IsNegative() {
+		return errorsmod.Wrap(ErrInvalidVault, "escrowed amount can not be negative")
+	}
+
+	return nil
+}
+
+// MintedAmount returns the amount of the vault denom minted for an amount of its backing denom,
+// and burned when it is withdrawn.
+func (v Vault) MintedAmount(backing sdkmath.Int) (sdkmath.Int, error) {
+	amount, err := backing.SafeMul(v.Ratio)
+	if err != nil {
+		return sdkmath.Int{}, errorsmod.Wrapf(ErrInvalidVault, "amount %s times ratio %s: %s", backing, v.Ratio, err)
+	}
+	return amount, nil
+}
@@ -0,0 +1,101 @@
+package types
+
+import (
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxVestingPeriods is the maximum number of periods of a periodic vesting schedule, bounding
+// the computation of its vested amount on every send of the holder
+const MaxVestingPeriods = 100
+
+// IsPeriodic returns true if the schedule vests by periods rather than continuously.
+func (s VestingSchedule) IsPeriodic() bool {
+	return len(s.Periods) != 0
+}
+
+// Validate does a stateless check of the vesting schedule fields.
+func (s VestingSchedule) Validate() error {
+	if _, _, err := DeconstructDenom(s.Denom); err != nil {
+		return err
+	}
+
+	if _, err := sdk.AccAddressFromBech32(s.Recipient); err != nil {
+		return errorsmod.Wrapf(ErrInvalidVesting, "invalid recipient address (%s)", err)
+	}
+
+	if s.Amount.IsNil() || !s.Amount.IsPositive() {
+		return errorsmod.Wrap(ErrInvalidVesting, "amount must be positive")
+	}
+
+	if !s.IsPeriodic() {
+		// the vested amount is computed in seconds, as for the x/auth vesting accounts
+		if s.EndTime.IsZero() || s.EndTime.Unix() <= s.StartTime.Unix() {
+			return errorsmod.Wrap(ErrInvalidVesting, "a continuous schedule must end at least a second after its start")
+		}
+		return nil
+	}
+
+	if !s.EndTime.IsZero() {
+		return errorsmod.Wrap(ErrInvalidVesting, "a periodic schedule can not have an end time")
+	}
+
+	if len(s.Periods) > MaxVestingPeriods {
+		return errorsmod.Wrapf(ErrInvalidVesting, "a periodic schedule can have at most %d periods", MaxVestingPeriods)
+	}
+
+	total := sdkmath.ZeroInt()
+	for i, period := range s.Periods {
+		if period.Length <= 0 {
+			return errorsmod.Wrapf(ErrInvalidVesting, "period %d: length must be positive", i)
+		}
+		if period.Amount.IsNil() || !period.Amount.IsPositive() {
+			return errorsmod.Wrapf(ErrInvalidVesting, "period %d: amount must be positive", i)
+		}
+		total = total.Add(period.Amount)
+	}
+
+	if !total.Equal(s.Amount) {
+		return errorsmod.Wrapf(ErrInvalidVesting, "the periods add up to %s, the schedule amount is %s", total, s.Amount)
+	}
+
+	return nil
+}
+
+// VestedAmount returns the amount of the schedule vested at a time. A continuous schedule vests
+// linearly, rounded down, and a periodic schedule vests the amount of each period at its end.
+func (s VestingSchedule) VestedAmount(blockTime time.Time) sdkmath.Int {
+	if !blockTime.After(s.StartTime) {
+		return sdkmath.ZeroInt()
+	}
+
+	if s.IsPeriodic() {
+		vested := sdkmath.ZeroInt()
+		periodEnd := s.StartTime
+		for _, period := range s.Periods {
+			periodEnd = periodEnd.Add(period.Length)
+			if blockTime.Before(periodEnd) {
+				break
+			}
+			vested = vested.Add(period.Amount)
+		}
+		return vested
+	}
+
+	if !blockTime.Before(s.EndTime) {
+		return s.Amount
+	}
+
+	elapsed := sdkmath.NewInt(blockTime.Unix() - s.StartTime.Unix())
+	duration := sdkmath.NewInt(s.EndTime.Unix() - s.StartTime.Unix())
+	return s.Amount.Mul(elapsed).Quo(duration)
+}
+
+// LockedAmount returns the amount of the schedule not vested at a time.
+func (s VestingSchedule) LockedAmount(blockTime time.Time) sdkmath.Int {
+	return s.Amount.Sub(s.VestedAmount(blockTime))
+}
@@ -0,0 +1,912 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: osmosis/tokenfactory/v1beta1/vesting.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// VestingSchedule is the vesting schedule of the tokens of a denom minted to a
+// recipient by the admin of the denom. The unvested tokens are locked in the
+// balance of the recipient, and can be clawed back by the admin.
+//
+// A continuous schedule vests linearly from its start time to its end time. A
+// periodic schedule has no end time, and vests the amount of each of its
+// periods at the end of the period.
+type VestingSchedule struct {
+	Denom     string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	Recipient string `protobuf:"bytes,2,opt,name=recipient,proto3" json:"recipient,omitempty" yaml:"recipient"`
+	// amount is the amount of the denom minted into the schedule.
+	Amount    cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount" yaml:"amount"`
+	StartTime time.Time             `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time" yaml:"start_time"`
+	// end_time is the end of a continuous schedule, zero for a periodic one.
+	EndTime time.Time `protobuf:"bytes,5,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time" yaml:"end_time"`
+	// periods are the consecutive periods of a periodic schedule, from its start
+	// time. Their amounts add up to the amount of the schedule.
+	Periods []VestingPeriod `protobuf:"bytes,6,rep,name=periods,proto3" json:"periods" yaml:"periods"`
+}
+
+func (m *VestingSchedule) Reset()         { *m = VestingSchedule{} }
+func (m *VestingSchedule) String() string { return proto.CompactTextString(m) }
+func (*VestingSchedule) ProtoMessage()    {}
+func (*VestingSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f657c321c1cf571d, []int{0}
+}
+func (m *VestingSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VestingSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VestingSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VestingSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VestingSchedule.Merge(m, src)
+}
+func (m *VestingSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *VestingSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_VestingSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VestingSchedule proto.InternalMessageInfo
+
+func (m *VestingSchedule) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *VestingSchedule) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+func (m *VestingSchedule) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return time.Time{}
+}
+
+func (m *VestingSchedule) GetEndTime() time.Time {
+	if m != nil {
+		return m.EndTime
+	}
+	return time.Time{}
+}
+
+func (m *VestingSchedule) GetPeriods() []VestingPeriod {
+	if m != nil {
+		return m.Periods
+	}
+	return nil
+}
+
+// VestingPeriod is a period of a periodic vesting schedule, whose amount vests
+// at the end of the period.
+type VestingPeriod struct {
+	Length time.Duration         `protobuf:"bytes,1,opt,name=length,proto3,stdduration" json:"length" yaml:"length"`
+	Amount cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount" yaml:"amount"`
+}
+
+func (m *VestingPeriod) Reset()         { *m = VestingPeriod{} }
+func (m *VestingPeriod) String() string { return proto.CompactTextString(m) }
+func (*VestingPeriod) ProtoMessage()    {}
+func (*VestingPeriod) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f657c321c1cf571d, []int{1}
+}
+func (m *VestingPeriod) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VestingPeriod) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VestingPeriod.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VestingPeriod) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VestingPeriod.Merge(m, src)
+}
+func (m *VestingPeriod) XXX_Size() int {
+	return m.Size()
+}
+func (m *VestingPeriod) XXX_DiscardUnknown() {
+	xxx_messageInfo_VestingPeriod.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VestingPeriod proto.InternalMessageInfo
+
+func (m *VestingPeriod) GetLength() time.Duration {
+	if m != nil {
+		return m.Length
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*VestingSchedule)(nil), "osmosis.tokenfactory.v1beta1.VestingSchedule")
+	proto.RegisterType((*VestingPeriod)(nil), "osmosis.tokenfactory.v1beta1.VestingPeriod")
+}
+
+func init() {
+	proto.RegisterFile("osmosis/tokenfactory/v1beta1/vesting.proto", fileDescriptor_f657c321c1cf571d)
+}
+
+var fileDescriptor_f657c321c1cf571d = []byte{
+	// 539 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x93, 0xb1, 0x6f, 0xd3, 0x40,
+	0x14, 0xc6, 0x73, 0x4d, 0x9b, 0x92, 0x0b, 0xa5, 0xad, 0x55, 0x50, 0x1a, 0x21, 0x3b, 0xf2, 0x80,
+	0xa2, 0x02, 0x67, 0x35, 0xdd, 0xba, 0x35, 0x62, 0x29, 0x12, 0x12, 0xa4, 0x08, 0x04, 0x52, 0x55,
+	0x39, 0xf6, 0xd5, 0x39, 0x35, 0xbe, 0xb3, 0x7c, 0xcf, 0x15, 0xf9, 0x2f, 0x3a, 0x32, 0x32, 0x32,
+	0x32, 0xf0, 0x1f, 0xb0, 0x74, 0xac, 0x98, 0x10, 0x83, 0x41, 0xc9, 0x00, 0x73, 0x76, 0x24, 0xe4,
+	0xbb, 0x4b, 0x9b, 0x80, 0x04, 0x4b, 0x97, 0x28, 0x77, 0xef, 0xfb, 0x7e, 0x9f, 0xdf, 0xbd, 0x3b,
+	0xbc, 0x25, 0x64, 0x2c, 0x24, 0x93, 0x1e, 0x88, 0x13, 0xca, 0x8f, 0xfd, 0x00, 0x44, 0x3a, 0xf4,
+	0x4e, 0xb7, 0x7b, 0x14, 0xfc, 0x6d, 0xef, 0x94, 0x4a, 0x60, 0x3c, 0x22, 0x49, 0x2a, 0x40, 0x58,
+	0x77, 0x8d, 0x96, 0xcc, 0x6a, 0x89, 0xd1, 0x36, 0x36, 0x22, 0x11, 0x09, 0x25, 0xf4, 0x8a, 0x7f,
+	0xda, 0xd3, 0x58, 0xf7, 0x63, 0xc6, 0x85, 0xa7, 0x7e, 0xcd, 0xd6, 0x66, 0xa0, 0x38, 0x47, 0x5a,
+	0xab, 0x17, 0xa6, 0x64, 0x47, 0x42, 0x44, 0x03, 0xea, 0xa9, 0x55, 0x2f, 0x3b, 0xf6, 0xc2, 0x2c,
+	0xf5, 0x81, 0x09, 0x6e, 0xea, 0xce, 0x9f, 0x75, 0x60, 0x31, 0x95, 0xe0, 0xc7, 0x89, 0x16, 0xb8,
+	0xbf, 0xca, 0x78, 0xf5, 0x85, 0xfe, 0xe8, 0x83, 0xa0, 0x4f, 0xc3, 0x6c, 0x40, 0xad, 0x7b, 0x78,
+	0x29, 0xa4, 0x5c, 0xc4, 0x75, 0xd4, 0x44, 0xad, 0x6a, 0x67, 0x6d, 0x92, 0x3b, 0x37, 0x87, 0x7e,
+	0x3c, 0xd8, 0x75, 0xd5, 0xb6, 0xdb, 0xd5, 0x65, 0xeb, 0x31, 0xae, 0xa6, 0x34, 0x60, 0x09, 0xa3,
+	0x1c, 0xea, 0x0b, 0x4a, 0xfb, 0x60, 0x92, 0x3b, 0x6b, 0x5a, 0x7b, 0x59, 0x72, 0x3f, 0x7f, 0x7c,
+	0xb8, 0x61, 0xbe, 0x7a, 0x2f, 0x0c, 0x53, 0x2a, 0xe5, 0x01, 0xa4, 0x8c, 0x47, 0xdd, 0x2b, 0xbb,
+	0xf5, 0x0a, 0x57, 0xfc, 0x58, 0x64, 0x1c, 0xea, 0x65, 0x05, 0xda, 0x3b, 0xcf, 0x9d, 0xd2, 0xd7,
+	0xdc, 0xb9, 0xad, 0x8d, 0x32, 0x3c, 0x21, 0x4c, 0x78, 0xb1, 0x0f, 0x7d, 0xb2, 0xcf, 0x61, 0x92,
+	0x3b, 0x2b, 0x3a, 0x45, 0x9b, 0x8a, 0x08, 0x6c, 0x22, 0xf6, 0x39, 0xbc, 0xff, 0xf1, 0x61, 0x0b,
+	0x75, 0x0d, 0xd0, 0x3a, 0xc4, 0x58, 0x82, 0x9f, 0xc2, 0x51, 0xd1, 0x7b, 0x7d, 0xb1, 0x89, 0x5a,
+	0xb5, 0x76, 0x83, 0xe8, 0x83, 0x21, 0xd3, 0x83, 0x21, 0xcf, 0xa7, 0x07, 0xd3, 0x71, 0x8b, 0xe8,
+	0x49, 0xee, 0xac, 0xeb, 0x84, 0x2b, 0xaf, 0x7b, 0xf6, 0xcd, 0x41, 0x9a, 0x5d, 0x55, 0xbb, 0x85,
+	0xc7, 0x7a, 0x89, 0x6f, 0x50, 0x1e, 0x6a, 0xf8, 0xd2, 0x7f, 0xe1, 0x4d, 0x03, 0x5f, 0xd5, 0xf0,
+	0xa9, 0x73, 0x06, 0xbd, 0x4c, 0x79, 0xa8, 0xc0, 0x87, 0x78, 0x39, 0xa1, 0x29, 0x13, 0xa1, 0xac,
+	0x57, 0x9a, 0xe5, 0x56, 0xad, 0x7d, 0x9f, 0xfc, 0xeb, 0x3e, 0x11, 0x33, 0xc6, 0xa7, 0xca, 0xd3,
+	0xb9, 0x63, 0x82, 0x6e, 0xe9, 0x20, 0x43, 0x72, 0xbb, 0x53, 0xe6, 0xee, 0xe2, 0xcf, 0x77, 0x0e,
+	0x72, 0x3f, 0x21, 0xbc, 0x32, 0x67, 0xb4, 0x9e, 0xe1, 0xca, 0x80, 0xf2, 0x08, 0xfa, 0x6a, 0xfc,
+	0xb5, 0xf6, 0xe6, 0x5f, 0xdd, 0x3c, 0x32, 0x77, 0xac, 0x63, 0x9b, 0x0c, 0x33, 0x0b, 0x6d, 0x73,
+	0xdf, 0x5e, 0xb6, 0x62, 0x40, 0x33, 0xc3, 0x5d, 0xb8, 0xe6, 0xe1, 0xea, 0x2e, 0x3a, 0x4f, 0xce,
+	0x47, 0x36, 0xba, 0x18, 0xd9, 0xe8, 0xfb, 0xc8, 0x46, 0x67, 0x63, 0xbb, 0x74, 0x31, 0xb6, 0x4b,
+	0x5f, 0xc6, 0x76, 0xe9, 0xf5, 0x4e, 0xc4, 0xa0, 0x9f, 0xf5, 0x48, 0x20, 0x62, 0xf3, 0x72, 0xe6,
+	0x1f, 0xee, 0x9b, 0xf9, 0x25, 0x0c, 0x13, 0x2a, 0x7b, 0x15, 0xd5, 0xea, 0xce, 0xef, 0x00, 0x00,
+	0x00, 0xff, 0xff, 0xa0, 0x3e, 0x82, 0xb5, 0xec, 0x03, 0x00, 0x00,
+}
+
+func (this *VestingSchedule) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*VestingSchedule)
+	if !ok {
+		that2, ok := that.(VestingSchedule)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Denom != that1.Denom {
+		return false
+	}
+	if this.Recipient != that1.Recipient {
+		return false
+	}
+	if !this.Amount.Equal(that1.Amount) {
+		return false
+	}
+	if !this.StartTime.Equal(that1.StartTime) {
+		return false
+	}
+	if !this.EndTime.Equal(that1.EndTime) {
+		return false
+	}
+	if len(this.Periods) != len(that1.Periods) {
+		return false
+	}
+	for i := range this.Periods {
+		if !this.Periods[i].Equal(&that1.Periods[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *VestingPeriod) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*VestingPeriod)
+	if !ok {
+		that2, ok := that.(VestingPeriod)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Length != that1.Length {
+		return false
+	}
+	if !this.Amount.Equal(that1.Amount) {
+		return false
+	}
+	return true
+}
+func (m *VestingSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VestingSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VestingSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Periods) > 0 {
+		for iNdEx := len(m.Periods) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Periods[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintVesting(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintVesting(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x2a
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintVesting(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintVesting(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintVesting(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintVesting(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VestingPeriod) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VestingPeriod) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VestingPeriod) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintVesting(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	n3, err3 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Length, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Length):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintVesting(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintVesting(dAtA []byte, offset int, v uint64) int {
+	offset -= sovVesting(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *VestingSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovVesting(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovVesting(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovVesting(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovVesting(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovVesting(uint64(l))
+	if len(m.Periods) > 0 {
+		for _, e := range m.Periods {
+			l = e.Size()
+			n += 1 + l + sovVesting(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *VestingPeriod) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Length)
+	n += 1 + l + sovVesting(uint64(l))
+	l = m.Amount.Size()
+	n += 1 + l + sovVesting(uint64(l))
+	return n
+}
+
+func sovVesting(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozVesting(x uint64) (n int) {
+	return sovVesting(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *VestingSchedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVesting
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VestingSchedule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VestingSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Periods", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Periods = append(m.Periods, VestingPeriod{})
+			if err := m.Periods[len(m.Periods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVesting(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VestingPeriod) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVesting
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VestingPeriod: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VestingPeriod: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Length", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Length, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVesting(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipVesting(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowVesting
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthVesting
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupVesting
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthVesting
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthVesting        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowVesting          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupVesting = fmt.Errorf("proto: unexpected end of group")
+)
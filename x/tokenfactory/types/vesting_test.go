@@ -0,0 +1,53 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosmos/tokenfactory/x/tokenfactory/types"
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+)
+
+func TestVestingScheduleVestedAmount(t *testing.T) {
+	start := time.Unix(1_000, 0).UTC()
+	continuous := types.VestingSchedule{
+		Amount:    sdkmath.NewInt(1000),
+		StartTime: start,
+		EndTime:   start.Add(300 * time.Second),
+	}
+	periodic := types.VestingSchedule{
+		Amount:    sdkmath.NewInt(1000),
+		StartTime: start,
+		Periods: []types.VestingPeriod{
+			{Length: time.Hour, Amount: sdkmath.NewInt(400)},
+			{Length: 2 * time.Hour, Amount: sdkmath.NewInt(600)},
+		},
+	}
+
+	for _, tc := range []struct {
+		desc     string
+		schedule types.VestingSchedule
+		time     time.Time
+		vested   int64
+	}{
+		{"continuous before start", continuous, start.Add(-time.Second), 0},
+		{"continuous at start", continuous, start, 0},
+		{"continuous a third", continuous, start.Add(100 * time.Second), 333},
+		{"continuous half", continuous, start.Add(150 * time.Second), 500},
+		{"continuous at end", continuous, start.Add(300 * time.Second), 1000},
+		{"continuous after end", continuous, start.Add(time.Hour), 1000},
+		{"periodic before start", periodic, start.Add(-time.Second), 0},
+		{"periodic first period", periodic, start.Add(59 * time.Minute), 0},
+		{"periodic end of first period", periodic, start.Add(time.Hour), 400},
+		{"periodic second period", periodic, start.Add(2 * time.Hour), 400},
+		{"periodic end of second period", periodic, start.Add(3 * time.Hour), 1000},
+		{"periodic after end", periodic, start.Add(24 * time.Hour), 1000},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			require.Equal(t, tc.vested, tc.schedule.VestedAmount(tc.time).Int64())
+			require.Equal(t, 1000-tc.vested, tc.schedule.LockedAmount(tc.time).Int64())
+		})
+	}
+}
@@ -0,0 +1,82 @@
+package types
+
+import (
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// voucherSignPrefix separates the sign bytes of mint vouchers from any other payload signed by
+// the same key.
+var voucherSignPrefix = []byte("osmosis/tokenfactory/mint-voucher")
+
+// ValidateVoucherSigner returns an error if the public key is not empty and not a compressed
+// secp256k1 public key.
+func ValidateVoucherSigner(pubKey []byte) error {
+	if len(pubKey) != 0 && len(pubKey) != secp256k1.PubKeySize {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidPubKey, "voucher signer must be a %d bytes compressed secp256k1 public key, got %d bytes", secp256k1.PubKeySize, len(pubKey))
+	}
+	return nil
+}
+
+// Validate does a stateless check of the voucher fields.
+func (v MintVoucher) Validate() error {
+	if _, _, err := DeconstructDenom(v.Denom); err != nil {
+		return err
+	}
+
+	if v.Amount.IsNil() || !v.Amount.IsPositive() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, "voucher amount must be positive")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(v.Recipient); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid recipient address (%s)", err)
+	}
+
+	if v.Expiry.IsZero() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "voucher expiry must be set")
+	}
+
+	return nil
+}
+
+// IsExpired returns whether the voucher can no longer be redeemed at the block time.
+func (v MintVoucher) IsExpired(blockTime time.Time) bool {
+	return !blockTime.Before(v.Expiry)
+}
+
+// VoucherSignBytes returns the bytes signed by the voucher signer: the domain prefix, the chain
+// id and the protobuf encoded voucher, separated by 0x00. The chain id makes a voucher
+// redeemable only on the chain it has been issued for.
+func VoucherSignBytes(chainID string, voucher MintVoucher) ([]byte, error) {
+	bz, err := voucher.Marshal()
+	if err != nil {
+		return nil, err
+	}
+
+	signBytes := make([]byte, 0, len(voucherSignPrefix)+len(chainID)+len(bz)+2)
+	signBytes = append(signBytes, voucherSignPrefix...)
+	signBytes = append(signBytes, 0x00)
+	signBytes = append(signBytes, chainID...)
+	signBytes = append(signBytes, 0x00)
+	return append(signBytes, bz...), nil
+}
+
+// VerifyVoucherSignature returns whether the signature of the voucher sign bytes has been made
+// by the compressed secp256k1 public key.
+func VerifyVoucherSignature(pubKey []byte, chainID string, voucher MintVoucher, signature []byte) bool {
+	if len(pubKey) != secp256k1.PubKeySize {
+		return false
+	}
+
+	signBytes, err := VoucherSignBytes(chainID, voucher)
+	if err != nil {
+		return false
+	}
+
+	return (&secp256k1.PubKey{Key: pubKey}).VerifySignature(signBytes, signature)
+}
@@ -0,0 +1,567 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: osmosis/tokenfactory/v1beta1/voucher.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MintVoucher is a mint authorized off-chain by the voucher signer of a
+// denom. The recipient, or anyone holding the voucher and its signature,
+// redeems it on chain with MsgRedeemMintVoucher.
+type MintVoucher struct {
+	Denom     string                `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	Amount    cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount" yaml:"amount"`
+	Recipient string                `protobuf:"bytes,3,opt,name=recipient,proto3" json:"recipient,omitempty" yaml:"recipient"`
+	// nonce identifies the voucher, every nonce of a denom can only be redeemed
+	// once.
+	Nonce uint64 `protobuf:"varint,4,opt,name=nonce,proto3" json:"nonce,omitempty" yaml:"nonce"`
+	// expiry is the time from which the voucher is rejected.
+	Expiry time.Time `protobuf:"bytes,5,opt,name=expiry,proto3,stdtime" json:"expiry" yaml:"expiry"`
+}
+
+func (m *MintVoucher) Reset()         { *m = MintVoucher{} }
+func (m *MintVoucher) String() string { return proto.CompactTextString(m) }
+func (*MintVoucher) ProtoMessage()    {}
+func (*MintVoucher) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0a287ebb36828c9, []int{0}
+}
+func (m *MintVoucher) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MintVoucher) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MintVoucher.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MintVoucher) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MintVoucher.Merge(m, src)
+}
+func (m *MintVoucher) XXX_Size() int {
+	return m.Size()
+}
+func (m *MintVoucher) XXX_DiscardUnknown() {
+	xxx_messageInfo_MintVoucher.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MintVoucher proto.InternalMessageInfo
+
+func (m *MintVoucher) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *MintVoucher) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+func (m *MintVoucher) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+func (m *MintVoucher) GetExpiry() time.Time {
+	if m != nil {
+		return m.Expiry
+	}
+	return time.Time{}
+}
+
+func init() {
+	proto.RegisterType((*MintVoucher)(nil), "osmosis.tokenfactory.v1beta1.MintVoucher")
+}
+
+func init() {
+	proto.RegisterFile("osmosis/tokenfactory/v1beta1/voucher.proto", fileDescriptor_e0a287ebb36828c9)
+}
+
+var fileDescriptor_e0a287ebb36828c9 = []byte{
+	// 419 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x52, 0x3f, 0x6f, 0xd4, 0x30,
+	0x14, 0x8f, 0xcb, 0xf5, 0xa4, 0xa6, 0x20, 0x95, 0xa8, 0x48, 0xe1, 0x84, 0xe2, 0x53, 0x06, 0x74,
+	0xaa, 0xc0, 0x56, 0xe9, 0xd6, 0xad, 0xb7, 0x15, 0xa9, 0x4b, 0x40, 0x48, 0xb0, 0xa0, 0xfc, 0x71,
+	0x73, 0x56, 0x6b, 0xbf, 0x28, 0x76, 0xaa, 0xe6, 0x5b, 0xf4, 0x23, 0x30, 0x32, 0x32, 0xf0, 0x21,
+	0x3a, 0x56, 0x4c, 0x88, 0x21, 0xa0, 0xbb, 0x01, 0xe6, 0xfb, 0x04, 0x28, 0xb6, 0xdb, 0xbb, 0x5b,
+	0xa2, 0xbc, 0xf7, 0xfb, 0xe3, 0xf7, 0x7b, 0xb6, 0x7f, 0x00, 0x4a, 0x80, 0xe2, 0x8a, 0x6a, 0xb8,
+	0x60, 0xf2, 0x3c, 0xcd, 0x35, 0xd4, 0x2d, 0xbd, 0x3a, 0xcc, 0x98, 0x4e, 0x0f, 0xe9, 0x15, 0x34,
+	0xf9, 0x8c, 0xd5, 0xa4, 0xaa, 0x41, 0x43, 0xf0, 0xc2, 0x71, 0xc9, 0x3a, 0x97, 0x38, 0xee, 0x68,
+	0xbf, 0x84, 0x12, 0x0c, 0x91, 0xf6, 0x7f, 0x56, 0x33, 0x7a, 0x9a, 0x0a, 0x2e, 0x81, 0x9a, 0xaf,
+	0x6b, 0x3d, 0xcf, 0x8d, 0xcf, 0x67, 0xcb, 0xb5, 0x85, 0x83, 0x70, 0x09, 0x50, 0x5e, 0x32, 0x6a,
+	0xaa, 0xac, 0x39, 0xa7, 0x9a, 0x0b, 0xa6, 0x74, 0x2a, 0x2a, 0x4b, 0x88, 0xbb, 0x2d, 0x7f, 0xf7,
+	0x8c, 0x4b, 0xfd, 0xc1, 0x0e, 0x16, 0xbc, 0xf4, 0xb7, 0x0b, 0x26, 0x41, 0x84, 0x68, 0x8c, 0x26,
+	0x3b, 0xd3, 0xbd, 0x65, 0x87, 0x1f, 0xb7, 0xa9, 0xb8, 0x3c, 0x8e, 0x4d, 0x3b, 0x4e, 0x2c, 0x1c,
+	0x7c, 0xf4, 0x87, 0xa9, 0x80, 0x46, 0xea, 0x70, 0xcb, 0x10, 0x4f, 0x6e, 0x3b, 0xec, 0xfd, 0xea,
+	0xf0, 0x33, 0x7b, 0xbc, 0x2a, 0x2e, 0x08, 0x07, 0x2a, 0x52, 0x3d, 0x23, 0xa7, 0x52, 0x2f, 0x3b,
+	0xfc, 0xc4, 0xba, 0x58, 0x51, 0xfc, 0xe3, 0xfb, 0x6b, 0xdf, 0x0d, 0x7a, 0x2a, 0xf5, 0xd7, 0xbf,
+	0xdf, 0x0e, 0x50, 0xe2, 0x0c, 0x83, 0xb7, 0xfe, 0x4e, 0xcd, 0x72, 0x5e, 0x71, 0x26, 0x75, 0xf8,
+	0xc8, 0xb8, 0xbf, 0x5a, 0x76, 0x78, 0xcf, 0x1a, 0x3c, 0x40, 0xbd, 0xc7, 0xbe, 0xf3, 0x38, 0x29,
+	0x8a, 0x9a, 0x29, 0xf5, 0x4e, 0xd7, 0x5c, 0x96, 0xc9, 0x4a, 0xde, 0xc7, 0x91, 0x20, 0x73, 0x16,
+	0x0e, 0xc6, 0x68, 0x32, 0x58, 0x8f, 0x63, 0xda, 0x71, 0x62, 0xe1, 0x20, 0xf1, 0x87, 0xec, 0xba,
+	0xe2, 0x75, 0x1b, 0x6e, 0x8f, 0xd1, 0x64, 0xf7, 0xcd, 0x88, 0xd8, 0xc5, 0x91, 0xfb, 0xc5, 0x91,
+	0xf7, 0xf7, 0x8b, 0x9b, 0x46, 0x7d, 0xd4, 0x55, 0x22, 0xab, 0x8b, 0x6f, 0x7e, 0x63, 0xe4, 0x72,
+	0xd8, 0xce, 0xf1, 0xe0, 0xdf, 0x17, 0x8c, 0xa6, 0x67, 0xb7, 0xf3, 0x08, 0xdd, 0xcd, 0x23, 0xf4,
+	0x67, 0x1e, 0xa1, 0x9b, 0x45, 0xe4, 0xdd, 0x2d, 0x22, 0xef, 0xe7, 0x22, 0xf2, 0x3e, 0x1d, 0x95,
+	0x5c, 0xcf, 0x9a, 0x8c, 0xe4, 0x20, 0xdc, 0xa5, 0x6d, 0xbe, 0x99, 0xeb, 0xcd, 0x52, 0xb7, 0x15,
+	0x53, 0xd9, 0xd0, 0x0c, 0x74, 0xf4, 0x3f, 0x00, 0x00, 0xff, 0xff, 0x27, 0xf4, 0xc8, 0x12, 0x67,
+	0x02, 0x00, 0x00,
+}
+
+func (this *MintVoucher) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MintVoucher)
+	if !ok {
+		that2, ok := that.(MintVoucher)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Denom != that1.Denom {
+		return false
+	}
+	if !this.Amount.Equal(that1.Amount) {
+		return false
+	}
+	if this.Recipient != that1.Recipient {
+		return false
+	}
+	if this.Nonce != that1.Nonce {
+		return false
+	}
+	if !this.Expiry.Equal(that1.Expiry) {
+		return false
+	}
+	return true
+}
+func (m *MintVoucher) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MintVoucher) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MintVoucher) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Expiry, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Expiry):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintVoucher(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x2a
+	if m.Nonce != 0 {
+		i = encodeVarintVoucher(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintVoucher(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintVoucher(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintVoucher(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintVoucher(dAtA []byte, offset int, v uint64) int {
+	offset -= sovVoucher(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MintVoucher) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovVoucher(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovVoucher(uint64(l))
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovVoucher(uint64(l))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovVoucher(uint64(m.Nonce))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Expiry)
+	n += 1 + l + sovVoucher(uint64(l))
+	return n
+}
+
+func sovVoucher(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozVoucher(x uint64) (n int) {
+	return sovVoucher(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MintVoucher) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVoucher
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MintVoucher: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MintVoucher: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVoucher
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVoucher
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVoucher
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVoucher
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVoucher
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVoucher
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVoucher
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVoucher
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVoucher
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVoucher
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVoucher
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVoucher
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVoucher
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Expiry, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVoucher(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVoucher
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipVoucher(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowVoucher
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowVoucher
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowVoucher
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthVoucher
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupVoucher
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthVoucher
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthVoucher        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowVoucher          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupVoucher = fmt.Errorf("proto: unexpected end of group")
+)
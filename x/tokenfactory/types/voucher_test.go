@@ -0,0 +1,61 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosmos/tokenfactory/x/tokenfactory/types"
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestVerifyVoucherSignature(t *testing.T) {
+	signer := secp256k1.GenPrivKey()
+	recipient := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+	voucher := types.MintVoucher{
+		Denom:     "factory/cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8/bitcoin",
+		Amount:    sdkmath.NewInt(100),
+		Recipient: recipient,
+		Nonce:     1,
+		Expiry:    time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+	require.NoError(t, voucher.Validate())
+
+	signBytes, err := types.VoucherSignBytes("chain-1", voucher)
+	require.NoError(t, err)
+	signature, err := signer.Sign(signBytes)
+	require.NoError(t, err)
+
+	pubKey := signer.PubKey().Bytes()
+	require.True(t, types.VerifyVoucherSignature(pubKey, "chain-1", voucher, signature))
+
+	// the signature is bound to the chain, the signer and every field of the voucher
+	require.False(t, types.VerifyVoucherSignature(pubKey, "chain-2", voucher, signature))
+	require.False(t, types.VerifyVoucherSignature(secp256k1.GenPrivKey().PubKey().Bytes(), "chain-1", voucher, signature))
+	require.False(t, types.VerifyVoucherSignature(pubKey[1:], "chain-1", voucher, signature))
+
+	for _, modify := range []func(v *types.MintVoucher){
+		func(v *types.MintVoucher) { v.Denom += "2" },
+		func(v *types.MintVoucher) { v.Amount = v.Amount.AddRaw(1) },
+		func(v *types.MintVoucher) { v.Recipient = sdk.AccAddress(pubKey[:20]).String() },
+		func(v *types.MintVoucher) { v.Nonce++ },
+		func(v *types.MintVoucher) { v.Expiry = v.Expiry.Add(time.Second) },
+	} {
+		modified := voucher
+		modify(&modified)
+		require.False(t, types.VerifyVoucherSignature(pubKey, "chain-1", modified, signature))
+	}
+}
+
+func TestMintVoucherIsExpired(t *testing.T) {
+	expiry := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	voucher := types.MintVoucher{Expiry: expiry}
+
+	require.False(t, voucher.IsExpired(expiry.Add(-time.Second)))
+	require.True(t, voucher.IsExpired(expiry))
+	require.True(t, voucher.IsExpired(expiry.Add(time.Second)))
+}